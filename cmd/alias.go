@@ -0,0 +1,374 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os/exec"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/duboisf/linear/internal/config"
+	"github.com/duboisf/linear/internal/format"
+)
+
+var (
+	_aliasNameRe   = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+	_placeholderRe = regexp.MustCompile(`\$(\d+)`)
+)
+
+// newAliasCmd creates the "alias" command for managing command aliases in
+// the config file.
+func newAliasCmd(opts Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alias",
+		Short: "Manage command aliases",
+		Long: `Aliases are shortcuts for longer commands, stored in the aliases: section of
+the config file. "$1", "$2", ... in an expansion are replaced by positional
+arguments and any remaining arguments are appended. Expansions starting with
+"!" are run by sh, with the arguments available as "$@".`,
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+	cmd.AddCommand(
+		newAliasSetCmd(opts),
+		newAliasListCmd(opts),
+		newAliasDeleteCmd(opts),
+	)
+	return cmd
+}
+
+func newAliasSetCmd(opts Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set <name> <expansion>",
+		Short: "Create or replace an alias",
+		Example: `  linear alias set bugs "issue list --label bug --status '!completed' --cycle all"
+  linear alias set mine 'issue list --user $1'
+  linear alias set branch '!linear issue get "$1" --template "{{.BranchName}}"'`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, expansion := args[0], args[1]
+			if err := validateAliasName(cmd.Root(), name); err != nil {
+				return err
+			}
+			if err := validateAliasExpansion(cmd.Root(), name, expansion); err != nil {
+				return err
+			}
+			path := config.FilePath()
+			if path == "" {
+				return fmt.Errorf("could not determine config directory")
+			}
+			if err := config.SetAlias(path, name, expansion); err != nil {
+				return err
+			}
+			fmt.Fprintf(opts.Stderr, "Alias %q now expands to %q\n", name, expansion)
+			return nil
+		},
+		ValidArgsFunction: cobra.NoFileCompletions,
+	}
+}
+
+func newAliasListCmd(opts Options) *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List aliases",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Config == nil || len(opts.Config.Aliases) == 0 {
+				fmt.Fprintln(opts.Stderr, "No aliases configured. Add one with 'linear alias set'.")
+				return nil
+			}
+			cols := []format.TableColumn{{Header: "ALIAS"}, {Header: "EXPANSION", Flexible: true}}
+			var rows [][]format.TableCell
+			for _, name := range slices.Sorted(maps.Keys(opts.Config.Aliases)) {
+				rows = append(rows, []format.TableCell{{Text: name}, {Text: opts.Config.Aliases[name]}})
+			}
+			color := format.ColorEnabled(cmd.OutOrStdout())
+			fmt.Fprint(opts.Stdout, format.RenderTable(cols, rows, color, true, tableWidth(opts, cmd.OutOrStdout(), false)))
+			return nil
+		},
+		ValidArgsFunction: cobra.NoFileCompletions,
+	}
+}
+
+func newAliasDeleteCmd(opts Options) *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete <name>",
+		Aliases: []string{"rm"},
+		Short:   "Delete an alias",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := config.FilePath()
+			if path == "" {
+				return fmt.Errorf("could not determine config directory")
+			}
+			found, err := config.DeleteAlias(path, args[0])
+			if err != nil {
+				return err
+			}
+			if !found {
+				return fmt.Errorf("no such alias %q", args[0])
+			}
+			fmt.Fprintf(opts.Stderr, "Deleted alias %q\n", args[0])
+			return nil
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 || opts.Config == nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return slices.Sorted(maps.Keys(opts.Config.Aliases)), cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+}
+
+// validateAliasName checks that name can be used as a top-level command.
+// Built-in commands can't be shadowed; existing aliases may be replaced.
+func validateAliasName(root *cobra.Command, name string) error {
+	if !_aliasNameRe.MatchString(name) {
+		return fmt.Errorf("alias %q: name must match %s", name, _aliasNameRe)
+	}
+	if existing := findSubcommand(root, name); existing != nil && existing.Annotations["alias"] == "" {
+		return fmt.Errorf("alias %q: conflicts with the %q command", name, existing.Name())
+	}
+	return nil
+}
+
+// validateAliasExpansion checks that a non-shell expansion starts with an
+// existing command (possibly another alias).
+func validateAliasExpansion(root *cobra.Command, name, expansion string) error {
+	if strings.TrimSpace(strings.TrimPrefix(expansion, "!")) == "" {
+		return fmt.Errorf("alias %q: expansion is empty", name)
+	}
+	if strings.HasPrefix(expansion, "!") {
+		return nil
+	}
+	words, err := splitWords(expansion)
+	if err != nil {
+		return fmt.Errorf("alias %q: %w", name, err)
+	}
+	if target, _, err := root.Find(words); err != nil || target == root {
+		return fmt.Errorf("alias %q: expansion must start with a linear command, got %q", name, words[0])
+	}
+	return nil
+}
+
+// findSubcommand returns root's direct subcommand named or aliased name.
+func findSubcommand(root *cobra.Command, name string) *cobra.Command {
+	for _, c := range root.Commands() {
+		if c.Name() == name || c.HasAlias(name) {
+			return c
+		}
+	}
+	return nil
+}
+
+// addAliasCommands adds a top-level command for each configured alias, so
+// aliases show up in help and shell completion, and shell aliases run like
+// built-in commands. Other aliases are rewritten by expandAliases before the
+// command line is parsed. Invalid aliases are skipped and reported together.
+func addAliasCommands(root *cobra.Command, opts Options) error {
+	if opts.Config == nil || len(opts.Config.Aliases) == 0 {
+		return nil
+	}
+	root.AddGroup(&cobra.Group{ID: "aliases", Title: "Aliases:"})
+
+	var (
+		errs  []error
+		added []string
+	)
+	for _, name := range slices.Sorted(maps.Keys(opts.Config.Aliases)) {
+		expansion := opts.Config.Aliases[name]
+		if err := validateAliasName(root, name); err != nil {
+			errs = append(errs, fmt.Errorf("config aliases: %w", err))
+			continue
+		}
+		added = append(added, name)
+		root.AddCommand(&cobra.Command{
+			Use:                name,
+			Short:              fmt.Sprintf("Alias for %q", expansion),
+			GroupID:            "aliases",
+			Annotations:        map[string]string{"alias": expansion},
+			DisableFlagParsing: true,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if shell, ok := strings.CutPrefix(expansion, "!"); ok {
+					return runShellAlias(cmd, opts, name, shell, args)
+				}
+				return fmt.Errorf("alias %q: aliases are only expanded by Execute and ExecuteArgs", name)
+			},
+		})
+	}
+	// Check expansions once all aliases exist, as they may refer to each other.
+	for _, name := range added {
+		if err := validateAliasExpansion(root, name, opts.Config.Aliases[name]); err != nil {
+			errs = append(errs, fmt.Errorf("config aliases: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// expandAliases rewrites args when they start with an alias, as gh does
+// before running a command: the alias is replaced by its expansion, then
+// again while the result starts with an alias. Leading flags of the root
+// command, like --refresh, are skipped and kept in front. Shell aliases are
+// left for their command to run, and other args are returned unchanged.
+// Shell alias commands don't parse flags, so root flags in front of them are
+// parsed into root right away.
+func expandAliases(root *cobra.Command, args []string) ([]string, error) {
+	n := rootFlagArgs(root, args)
+	flags, args := args[:n:n], args[n:]
+	var chain []string
+	for len(args) > 0 {
+		name := args[0]
+		c := findSubcommand(root, name)
+		if c == nil || c.Annotations["alias"] == "" {
+			break
+		}
+		if strings.HasPrefix(c.Annotations["alias"], "!") {
+			if err := root.PersistentFlags().Parse(flags); err != nil {
+				return nil, err
+			}
+			return args, nil
+		}
+		chain = append(chain, name)
+		if slices.Contains(chain[:len(chain)-1], name) {
+			return nil, fmt.Errorf("alias %q: aliases expand to each other (%s)", chain[0], strings.Join(chain, " → "))
+		}
+		expanded, err := expandAlias(c.Annotations["alias"], args[1:])
+		if err != nil {
+			return nil, fmt.Errorf("alias %q: %w", name, err)
+		}
+		args = expanded
+	}
+	return append(flags, args...), nil
+}
+
+// rootFlagArgs returns the number of leading args that are persistent flags
+// of root, with their values.
+func rootFlagArgs(root *cobra.Command, args []string) int {
+	i := 0
+	for i < len(args) {
+		var f *pflag.Flag
+		name, _, hasValue := strings.Cut(args[i], "=")
+		switch {
+		case name == "--" || !strings.HasPrefix(name, "-"):
+			return i
+		case strings.HasPrefix(name, "--"):
+			f = root.PersistentFlags().Lookup(name[2:])
+		case len(name) == 2:
+			f = root.PersistentFlags().ShorthandLookup(name[1:])
+		}
+		if f == nil {
+			return i
+		}
+		i++
+		if f.NoOptDefVal == "" && !hasValue {
+			i++ // the flag's value is the next arg
+		}
+	}
+	return min(i, len(args))
+}
+
+// runShellAlias runs a "!" alias with sh, passing args as "$@".
+func runShellAlias(cmd *cobra.Command, opts Options, name, script string, args []string) error {
+	sh := exec.CommandContext(cmd.Context(), "sh", append([]string{"-c", script, name}, args...)...)
+	sh.Stdin = opts.Stdin
+	sh.Stdout = opts.Stdout
+	sh.Stderr = opts.Stderr
+	if err := sh.Run(); err != nil {
+		return fmt.Errorf("alias %q: %w", name, err)
+	}
+	return nil
+}
+
+// expandAlias splits expansion into arguments, replaces "$N" placeholders
+// with args[N-1] and appends the arguments no placeholder used.
+func expandAlias(expansion string, args []string) ([]string, error) {
+	words, err := splitWords(expansion)
+	if err != nil {
+		return nil, err
+	}
+	used := 0
+	var missing int
+	for i, w := range words {
+		words[i] = _placeholderRe.ReplaceAllStringFunc(w, func(m string) string {
+			n, _ := strconv.Atoi(m[1:])
+			if n == 0 {
+				return m
+			}
+			used = max(used, n)
+			if n > len(args) {
+				missing = max(missing, n)
+				return m
+			}
+			return args[n-1]
+		})
+	}
+	if missing > 0 {
+		return nil, fmt.Errorf("expects at least %d argument(s), got %d", missing, len(args))
+	}
+	return append(words, args[used:]...), nil
+}
+
+// splitWords splits s into words like a POSIX shell does, honoring single
+// quotes, double quotes and backslash escapes. No other expansion happens.
+func splitWords(s string) ([]string, error) {
+	var (
+		words   []string
+		cur     strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range s {
+		switch {
+		case escaped:
+			cur.WriteRune(r)
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				cur.WriteRune(r)
+			}
+		case quote == '"':
+			switch r {
+			case '"':
+				quote = 0
+			case '\\':
+				escaped = true
+			default:
+				cur.WriteRune(r)
+			}
+		case r == '\\':
+			escaped, inWord = true, true
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, cur.String())
+				cur.Reset()
+				inWord = false
+			}
+		default:
+			cur.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote in %q", quote, s)
+	}
+	if escaped {
+		return nil, fmt.Errorf("trailing backslash in %q", s)
+	}
+	if inWord {
+		words = append(words, cur.String())
+	}
+	return words, nil
+}
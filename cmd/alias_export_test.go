@@ -0,0 +1,6 @@
+package cmd
+
+// ExpandAlias is an exported wrapper for testing.
+func ExpandAlias(expansion string, args []string) ([]string, error) {
+	return expandAlias(expansion, args)
+}
@@ -0,0 +1,320 @@
+package cmd_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/duboisf/linear/cmd"
+	"github.com/duboisf/linear/internal/cache"
+	"github.com/duboisf/linear/internal/config"
+)
+
+func TestExpandAlias(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		expansion string
+		args      []string
+		want      []string
+		wantErr   string
+	}{
+		{
+			name:      "quoted words",
+			expansion: `issue list --label bug --status '!completed' --cycle all`,
+			want:      []string{"issue", "list", "--label", "bug", "--status", "!completed", "--cycle", "all"},
+		},
+		{
+			name:      "extra args appended",
+			expansion: "issue list",
+			args:      []string{"--limit", "3"},
+			want:      []string{"issue", "list", "--limit", "3"},
+		},
+		{
+			name:      "placeholders",
+			expansion: `issue list --user $1 --label "$2,ops"`,
+			args:      []string{"jane", "bug", "-o", "csv"},
+			want:      []string{"issue", "list", "--user", "jane", "--label", "bug,ops", "-o", "csv"},
+		},
+		{
+			name:      "escapes",
+			expansion: `issue list --label a\ b "x\"y"`,
+			want:      []string{"issue", "list", "--label", "a b", `x"y`},
+		},
+		{
+			name:      "missing argument",
+			expansion: "issue get $2",
+			args:      []string{"ENG-1"},
+			wantErr:   "expects at least 2 argument(s), got 1",
+		},
+		{
+			name:      "unterminated quote",
+			expansion: "issue list --label 'bug",
+			wantErr:   "unterminated ' quote",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := cmd.ExpandAlias(tt.expansion, tt.args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expandAlias() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlias_Expands(t *testing.T) {
+	t.Parallel()
+
+	server, rec := newRecordingGraphQLServer(t, map[string]string{
+		"ListMyIssues": listMyIssuesResponse,
+	})
+
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	opts.Config = &config.Config{Aliases: map[string]string{
+		"bugs":  "issue list --label bug --status '!completed' --cycle all",
+		"bugs5": "bugs --limit 5",
+	}}
+	if err := cmd.ExecuteArgs(context.Background(), opts, []string{"bugs5", "-o", "csv", "--column", "id"}); err != nil {
+		t.Fatalf("alias returned error: %v", err)
+	}
+
+	vars := listIssuesVars(t, rec)
+	if vars["first"] != float64(5) {
+		t.Errorf("first = %v, want 5", vars["first"])
+	}
+	filter, _ := json.Marshal(vars["filter"])
+	for _, want := range []string{`"nin":["completed"]`, `"bug"`} {
+		if !strings.Contains(string(filter), want) {
+			t.Errorf("filter %s should contain %s", filter, want)
+		}
+	}
+	if got, want := stdout.String(), "id\nENG-101\nENG-102\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestAlias_Shell(t *testing.T) {
+	t.Parallel()
+
+	opts, stdout, _ := testOptionsWithBuffers(t, nil)
+	opts.Config = &config.Config{Aliases: map[string]string{
+		"hello": `!echo "hello $1 ($#)"`,
+	}}
+	if err := cmd.ExecuteArgs(context.Background(), opts, []string{"hello", "world", "--flag"}); err != nil {
+		t.Fatalf("shell alias returned error: %v", err)
+	}
+	if got, want := stdout.String(), "hello world (2)\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestAlias_Cycle(t *testing.T) {
+	t.Parallel()
+
+	opts, _, _ := testOptionsWithBuffers(t, nil)
+	opts.Config = &config.Config{Aliases: map[string]string{
+		"ping": "pong",
+		"pong": "ping",
+	}}
+	err := cmd.ExecuteArgs(context.Background(), opts, []string{"ping", "x"})
+	if err == nil || !strings.Contains(err.Error(), `alias "ping": aliases expand to each other (ping → pong → ping)`) {
+		t.Fatalf("expected a cycle error, got %v", err)
+	}
+}
+
+func TestAlias_OnlyExpandsFirstArg(t *testing.T) {
+	t.Parallel()
+
+	opts, stdout, _ := testOptionsWithBuffers(t, nil)
+	opts.Config = &config.Config{Aliases: map[string]string{
+		"hello": `!echo "hello $1"`,
+		"world": "version",
+	}}
+
+	if err := cmd.ExecuteArgs(context.Background(), opts, []string{"hello", "world"}); err != nil {
+		t.Fatalf("shell alias returned error: %v", err)
+	}
+	if got, want := stdout.String(), "hello world\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestAlias_LeadingRootFlags(t *testing.T) {
+	t.Parallel()
+
+	for _, flag := range []string{"--refresh", "-r", "--refresh=true"} {
+		t.Run(flag, func(t *testing.T) {
+			t.Parallel()
+
+			server, rec := newRecordingGraphQLServer(t, map[string]string{
+				"ListMyIssues": listMyIssuesResponse,
+			})
+			opts, stdout, _ := testOptionsWithBuffers(t, server)
+			opts.Cache = cache.New(t.TempDir(), time.Hour)
+			if err := opts.Cache.Set("stale", "x"); err != nil {
+				t.Fatal(err)
+			}
+			opts.Config = &config.Config{Aliases: map[string]string{
+				"bugs": "issue list --label bug --cycle all",
+			}}
+			if err := cmd.ExecuteArgs(context.Background(), opts, []string{flag, "bugs", "-o", "csv", "--column", "id"}); err != nil {
+				t.Fatalf("alias returned error: %v", err)
+			}
+
+			if len(rec.calls("ListMyIssues")) != 1 {
+				t.Errorf("expected the alias to list issues")
+			}
+			if got, want := stdout.String(), "id\nENG-101\nENG-102\n"; got != want {
+				t.Errorf("output = %q, want %q", got, want)
+			}
+			if _, ok := opts.Cache.Get("stale"); ok {
+				t.Errorf("%s should have cleared the cache", flag)
+			}
+		})
+	}
+}
+
+func TestAlias_ShellLeadingRootFlags(t *testing.T) {
+	t.Parallel()
+
+	opts, stdout, _ := testOptionsWithBuffers(t, nil)
+	opts.Cache = cache.New(t.TempDir(), time.Hour)
+	if err := opts.Cache.Set("stale", "x"); err != nil {
+		t.Fatal(err)
+	}
+	opts.Config = &config.Config{Aliases: map[string]string{
+		"hello": `!echo "hello $@ ($#)"`,
+	}}
+	if err := cmd.ExecuteArgs(context.Background(), opts, []string{"-r", "hello", "world", "-r"}); err != nil {
+		t.Fatalf("shell alias returned error: %v", err)
+	}
+	if got, want := stdout.String(), "hello world -r (2)\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+	if _, ok := opts.Cache.Get("stale"); ok {
+		t.Error("-r should have cleared the cache")
+	}
+}
+
+func TestAlias_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		aliases map[string]string
+		want    string
+	}{
+		{name: "shadows built-in", aliases: map[string]string{"issue": "user list"}, want: `alias "issue": conflicts with the "issue" command`},
+		{name: "bad name", aliases: map[string]string{"-x": "issue list"}, want: `alias "-x": name must match`},
+		{name: "unknown command", aliases: map[string]string{"x": "nope list"}, want: `alias "x": expansion must start with a linear command, got "nope"`},
+		{name: "empty", aliases: map[string]string{"x": "!"}, want: `alias "x": expansion is empty`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, _, _ := testOptionsWithBuffers(t, nil)
+			opts.Config = &config.Config{Aliases: tt.aliases}
+			root := cmd.NewRootCmd(opts)
+			root.SetArgs([]string{"user", "list"})
+
+			err := root.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestAlias_Completion(t *testing.T) {
+	t.Parallel()
+
+	opts, stdout, _ := testOptionsWithBuffers(t, nil)
+	opts.Config = &config.Config{Aliases: map[string]string{"bugs": "issue list --label bug"}}
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"__complete", "bu"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("completion returned error: %v", err)
+	}
+	if !strings.Contains(stdout.String(), "bugs") {
+		t.Errorf("completions %q should contain the alias", stdout.String())
+	}
+}
+
+func TestAliasCommands(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+	t.Setenv("EDITOR", "true")
+	path := filepath.Join(tmpDir, "linear", "config.yaml")
+
+	run := func(args ...string) (string, string, error) {
+		t.Helper()
+		opts, stdout, stderr := testOptionsWithBuffers(t, nil)
+		cfg, err := config.Load(nil)
+		if err != nil {
+			t.Fatalf("loading config: %v", err)
+		}
+		opts.Config = cfg
+		root := cmd.NewRootCmd(opts)
+		root.SetArgs(args)
+		err = root.Execute()
+		return stdout.String(), stderr.String(), err
+	}
+
+	if _, stderr, err := run("alias", "list"); err != nil || !strings.Contains(stderr, "No aliases configured") {
+		t.Fatalf("alias list on empty config: err=%v stderr=%q", err, stderr)
+	}
+	if _, _, err := run("alias", "set", "bugs", "issue list --label bug"); err != nil {
+		t.Fatalf("alias set returned error: %v", err)
+	}
+	if _, _, err := run("alias", "set", "mine", "issue list --user $1"); err != nil {
+		t.Fatalf("alias set returned error: %v", err)
+	}
+	if _, _, err := run("alias", "set", "user", "issue list"); err == nil {
+		t.Fatal("alias set should refuse to shadow a built-in command")
+	}
+
+	stdout, _, err := run("alias", "list")
+	if err != nil {
+		t.Fatalf("alias list returned error: %v", err)
+	}
+	want := "ALIAS  EXPANSION\nbugs   issue list --label bug\nmine   issue list --user $1\n"
+	if stdout != want {
+		t.Errorf("alias list =\n%s\nwant:\n%s", stdout, want)
+	}
+
+	if _, _, err := run("alias", "delete", "bugs"); err != nil {
+		t.Fatalf("alias delete returned error: %v", err)
+	}
+	if _, _, err := run("alias", "rm", "bugs"); err == nil || !strings.Contains(err.Error(), `no such alias "bugs"`) {
+		t.Errorf("expected an error deleting a missing alias, got %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(data); got != "aliases:\n  mine: issue list --user $1\n" {
+		t.Errorf("config file = %q", got)
+	}
+}
@@ -300,6 +300,69 @@ func labelsCached(ctx context.Context, client graphql.Client, c *cache.Cache) (*
 	return resp, nil
 }
 
+const (
+	_teamsCacheKey = "teams/list"
+	_teamsCacheTTL = 24 * time.Hour
+)
+
+// teamsCached returns team data, serving from cache when available.
+func teamsCached(ctx context.Context, client graphql.Client, c *cache.Cache) (*api.ListTeamsResponse, error) {
+	if c != nil {
+		if data, ok := c.GetWithTTL(_teamsCacheKey, _teamsCacheTTL); ok {
+			var resp api.ListTeamsResponse
+			if err := json.Unmarshal([]byte(data), &resp); err == nil {
+				return &resp, nil
+			}
+		}
+	}
+
+	resp, err := api.ListTeams(ctx, client, 250)
+	if err != nil {
+		return nil, err
+	}
+
+	if c != nil {
+		if data, err := json.Marshal(resp); err == nil {
+			_ = c.Set(_teamsCacheKey, string(data))
+		}
+	}
+
+	return resp, nil
+}
+
+// teamKeys returns the set of team keys (e.g. "AIS") of the workspace.
+func teamKeys(ctx context.Context, client graphql.Client, c *cache.Cache) (map[string]bool, error) {
+	teams, err := teamsCached(ctx, client, c)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]bool)
+	if teams.Teams != nil {
+		for _, t := range teams.Teams.Nodes {
+			keys[t.Key] = true
+		}
+	}
+	return keys, nil
+}
+
+// completeTeamKeys returns shell completions for the --team flag: team
+// keys described by their names.
+func completeTeamKeys(cmd *cobra.Command, opts Options) ([]string, cobra.ShellCompDirective) {
+	client, err := resolveClient(cmd, opts)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	resp, err := teamsCached(cmd.Context(), client, opts.Cache)
+	if err != nil || resp.Teams == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	comps := make([]string, len(resp.Teams.Nodes))
+	for i, t := range resp.Teams.Nodes {
+		comps[i] = t.Key + "\t" + t.Name
+	}
+	return comps, cobra.ShellCompDirectiveNoFileComp
+}
+
 // completeLabelNames returns shell completions for the --label flag.
 // Supports comma-separated multi-value input.
 func completeLabelNames(cmd *cobra.Command, opts Options, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -10,6 +10,10 @@ func newConfigCmd(opts Options) *cobra.Command {
 		Use:   "config",
 		Short: "Manage CLI configuration",
 	}
-	cmd.AddCommand(newConfigEditCmd(opts))
+	cmd.AddCommand(
+		newConfigEditCmd(opts),
+		newConfigValidateCmd(opts),
+		newConfigSchemaCmd(opts),
+	)
 	return cmd
 }
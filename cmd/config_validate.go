@@ -0,0 +1,240 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/duboisf/linear/internal/config"
+	"github.com/duboisf/linear/internal/prompt"
+)
+
+// _sampleIssueData fills every template field so that a dry run of a
+// template fails only on template errors, not on missing data.
+var _sampleIssueData = prompt.IssueData{
+	Identifier:    "ENG-123",
+	Title:         "Sample issue",
+	Description:   "Sample description",
+	URL:           "https://linear.app/team/issue/ENG-123/sample-issue",
+	BranchName:    "eng-123-sample-issue",
+	State:         "In Progress",
+	Priority:      "High",
+	Estimate:      "3",
+	Assignee:      "Jane Doe",
+	AssigneeEmail: "jane@example.com",
+	Team:          "Engineering",
+	TeamKey:       "ENG",
+	Cycle:         "Cycle 1",
+	CycleNumber:   1,
+	CycleStartsAt: "2024-01-15T00:00:00.000Z",
+	CycleEndsAt:   "2024-01-29T00:00:00.000Z",
+	Project:       "Sample project",
+	Labels:        []string{"bug"},
+	DueDate:       "2024-01-31",
+	CreatedAt:     "2024-01-10T09:00:00.000Z",
+	UpdatedAt:     "2024-01-12T16:30:00.000Z",
+	Parent:        "ENG-100",
+	ParentTitle:   "Sample parent",
+	Children:      []string{"ENG-124"},
+	CommentCount:  2,
+	RepoRoot:      "/home/jane/src/repo",
+	WorktreePath:  "/home/jane/src/repo-ENG-123",
+}
+
+// _sampleContextData fills every field of prompt templates, like
+// _sampleIssueData.
+var _sampleContextData = prompt.ContextData{
+	IssueData:          _sampleIssueData,
+	SubIssues:          []prompt.ContextIssue{{Identifier: "ENG-124", Title: "Sample sub-issue", State: "Todo"}},
+	Comments:           []prompt.ContextComment{{Author: "Jane Doe", CreatedAt: "2024-01-11T10:00:00.000Z", Body: "Sample comment"}},
+	AcceptanceCriteria: []string{"Sample criterion"},
+}
+
+// newConfigValidateCmd creates the "config validate" subcommand.
+func newConfigValidateCmd(opts Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate [file]",
+		Short: "Check the config file for errors",
+		Long: `Check the config file (or the given file) for unknown fields, values of the
+wrong type, duplicate names, templates that fail to render, invalid columns,
+views, defaults and aliases. Problems are printed as file:line:column: message.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := config.FilePath()
+			if len(args) > 0 {
+				path = args[0]
+			}
+			if path == "" {
+				return fmt.Errorf("could not determine config directory")
+			}
+			data, err := os.ReadFile(path)
+			if errors.Is(err, os.ErrNotExist) && len(args) == 0 {
+				fmt.Fprintf(opts.Stderr, "No config file at %s; the defaults are used.\n", path)
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("reading config file: %w", err)
+			}
+
+			diags := validateConfig(opts, data)
+			for _, d := range diags {
+				fmt.Fprintf(opts.Stdout, "%s:%s\n", path, d)
+			}
+			if len(diags) > 0 {
+				return fmt.Errorf("%s: %d problem(s) found", path, len(diags))
+			}
+			fmt.Fprintf(opts.Stderr, "%s is valid\n", path)
+			return nil
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return []string{"yaml", "yml"}, cobra.ShellCompDirectiveFilterFileExt
+		},
+	}
+}
+
+// newConfigSchemaCmd creates the "config schema" subcommand.
+func newConfigSchemaCmd(opts Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema",
+		Short: "Print a JSON Schema for the config file",
+		Long: `Print a JSON Schema describing config.yaml, for completion and validation in
+editors. With the YAML language server, add this first line to config.yaml:
+
+  # yaml-language-server: $schema=/path/to/linear-config.schema.json`,
+		Example: `  linear config schema > ~/.config/linear/config.schema.json`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			schema, err := config.Schema()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(opts.Stdout, "%s\n", schema)
+			return nil
+		},
+		ValidArgsFunction: cobra.NoFileCompletions,
+	}
+}
+
+// validateConfig returns every problem found in config file data, sorted by
+// position. Beyond config.Parse's structural checks, it dry-runs templates
+// and checks columns, views, defaults and aliases like the commands using
+// them would.
+func validateConfig(opts Options, data []byte) []config.Diagnostic {
+	f, diags := config.Parse(data)
+	if f == nil {
+		return diags
+	}
+	cfg := f.Config
+	report := func(path string, err error) {
+		diags = append(diags, f.Errorf(path, "%s: %v", path, err))
+	}
+
+	for i, c := range cfg.Interactive.Commands {
+		render := prompt.Render
+		if c.Multi {
+			render = func(tmpl string, d prompt.IssueData) (string, error) {
+				return prompt.RenderList(tmpl, []prompt.IssueData{d, d})
+			}
+		}
+		if _, err := render(c.Command, _sampleIssueData); err != nil {
+			report(fmt.Sprintf("interactive.commands[%d].command", i), err)
+		}
+	}
+	if _, err := resolveFzfBindings(cfg, true); err != nil {
+		for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+			if be, ok := err.(*bindingError); ok {
+				diags = append(diags, f.Errorf(be.path, "%v", be))
+			}
+		}
+	}
+	if cfg.Worktree.Path != "" {
+		data := worktreePathData{IssueData: _sampleIssueData, RepoRoot: "/src/repo", RepoName: "repo", RepoParent: "/src"}
+		if _, err := prompt.RenderRaw(cfg.Worktree.Path, data); err != nil {
+			report("worktree.path", err)
+		}
+	}
+	for i, h := range cfg.Worktree.PostCreate {
+		if _, err := prompt.Render(h.Command, _sampleIssueData); err != nil {
+			report(fmt.Sprintf("worktree.post_create[%d].command", i), err)
+		}
+	}
+	for path, tmpl := range map[string]string{"pr.title": cfg.PR.Title, "pr.body": cfg.PR.Body} {
+		if _, err := prompt.RenderRaw(tmpl, _sampleIssueData); err != nil {
+			report(path, err)
+		}
+	}
+
+	for i, p := range cfg.Prompts {
+		if _, err := prompt.RenderRaw(p.Template, _sampleContextData); err != nil {
+			report(fmt.Sprintf("prompts[%d].template", i), err)
+		}
+	}
+
+	columnsOK := true
+	for i, c := range cfg.Columns {
+		col := prompt.Column{Name: c.Name, Header: c.Header, Template: c.Template, Color: c.Color, Width: c.Width}
+		if err := prompt.ValidateColumn(col, _sampleIssueData); err != nil {
+			report(fmt.Sprintf("columns[%d]", i), err)
+			columnsOK = false
+		}
+	}
+
+	// Check the rest against a fresh command tree holding only the file's
+	// aliases, so the config loaded at startup doesn't interfere.
+	vopts := opts
+	vopts.Config = cfg
+	root := NewRootCmd(Options{Config: &config.Config{Aliases: cfg.Aliases}})
+
+	for i, v := range cfg.Views {
+		for _, vf := range viewFlags(v) {
+			validate, ok := _flagValidators[vf.name]
+			if !ok || vf.name == "column" && !columnsOK {
+				continue
+			}
+			if err := validate(vopts, vf.value); err != nil {
+				key := vf.name
+				if key == "column" {
+					key = "columns"
+				}
+				report(fmt.Sprintf("views[%d].%s", i, key), err)
+			}
+		}
+	}
+
+	for _, path := range slices.Sorted(maps.Keys(cfg.Defaults)) {
+		target, rest, err := root.Find(strings.Fields(path))
+		if err != nil || len(rest) > 0 || target == root {
+			diags = append(diags, f.Errorf("defaults."+path, "defaults: unknown command %q", path))
+			continue
+		}
+		for _, name := range slices.Sorted(maps.Keys(cfg.Defaults[path])) {
+			if name == "column" && !columnsOK {
+				continue
+			}
+			if err := setFlagDefault(target, vopts, name, cfg.Defaults[path][name]); err != nil {
+				diags = append(diags, f.Errorf("defaults."+path+"."+name, "defaults: %s --%s: %v", path, name, err))
+			}
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(cfg.Aliases)) {
+		err := validateAliasName(root, name)
+		if err == nil {
+			err = validateAliasExpansion(root, name, cfg.Aliases[name])
+		}
+		if err != nil {
+			diags = append(diags, f.Errorf("aliases."+name, "aliases: %v", err))
+		}
+	}
+
+	config.SortDiagnostics(diags)
+	return diags
+}
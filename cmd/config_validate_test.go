@@ -0,0 +1,214 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/cmd"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestConfigValidate_Valid(t *testing.T) {
+	t.Parallel()
+
+	path := writeConfigFile(t, `interactive:
+  commands:
+    - name: Open
+      command: "xdg-open {{.Raw.URL}}"
+columns:
+  - name: age
+    template: "{{daysSince .UpdatedAt}}d"
+views:
+  - name: triage
+    status: backlog
+    columns: id,age
+defaults:
+  issue list:
+    sort: priority
+aliases:
+  bugs: issue list --label bug
+  bugs5: bugs --limit 5
+`)
+
+	opts, stdout, stderr := testOptionsWithBuffers(t, nil)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"config", "validate", path})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("config validate returned error: %v\n%s", err, stdout.String())
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("expected no diagnostics, got %q", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "is valid") {
+		t.Errorf("expected a confirmation on stderr, got %q", stderr.String())
+	}
+}
+
+func TestConfigValidate_Problems(t *testing.T) {
+	t.Parallel()
+
+	path := writeConfigFile(t, `interactive:
+  commands:
+    - name: Claude
+      command: "claude {{.Titel}}"
+    - name: Claude
+      command: "x {{.Title"
+  preview: true
+pr:
+  title: "{{.Identifier"
+columns:
+  - name: title
+    template: x
+views:
+  - name: triage
+    status: open
+    sort: name
+defaults:
+  issue list:
+    limit: many
+    nope: x
+  issue frobnicate:
+    x: y
+aliases:
+  issue: user list
+  bugs: nope list
+prompts:
+  - name: claude
+    template: "{{range .Comments}}{{.Autor}}{{end}}"
+`)
+
+	opts, stdout, _ := testOptionsWithBuffers(t, nil)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"config", "validate", path})
+
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "14 problem(s) found") {
+		t.Fatalf("expected 14 problems, got %v\n%s", err, stdout.String())
+	}
+
+	want := []string{
+		`:4:16: interactive.commands[0].command: template: prompt:1:9: executing "prompt" at <.Titel>: can't evaluate field Titel`,
+		`:5:13: duplicate command name "Claude" (first used in interactive.commands[0])`,
+		`:6:16: interactive.commands[1].command: template: prompt:1: unclosed action`,
+		`:7:3: unknown field "preview" in interactive (valid: commands, bindings, backend)`,
+		`:9:10: pr.title: template: raw:1: unclosed action`,
+		`:11:5: columns[0]: column "title": conflicts with a built-in column`,
+		`:15:13: views[0].status: unknown status "open"`,
+		`:16:11: views[0].sort: unknown sort "name"`,
+		`:19:12: defaults: issue list --limit: `,
+		`:20:11: defaults: issue list --nope: unknown flag`,
+		`:22:5: defaults: unknown command "issue frobnicate"`,
+		`:24:10: aliases: alias "issue": conflicts with the "issue" command`,
+		`:25:9: aliases: alias "bugs": expansion must start with a linear command, got "nope"`,
+		`:28:15: prompts[0].template: template: raw:1:21: executing "raw" at <.Autor>: can't evaluate field Autor`,
+	}
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	for _, w := range want {
+		found := false
+		for _, line := range lines {
+			if strings.HasPrefix(line, path) && strings.Contains(line, w) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("missing diagnostic %q in:\n%s", w, stdout.String())
+		}
+	}
+}
+
+func TestConfigValidate_Bindings(t *testing.T) {
+	t.Parallel()
+
+	path := writeConfigFile(t, `interactive:
+  commands:
+    - name: Claude
+      command: claude
+  bindings:
+    enter: edit
+    alt-o: opne
+    alt-c: command:Nope
+    Alt-O: open
+    alt-x: command:Claude
+    ctrl-e: none
+`)
+
+	opts, stdout, _ := testOptionsWithBuffers(t, nil)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"config", "validate", path})
+
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "3 problem(s) found") {
+		t.Fatalf("expected 3 problems, got %v\n%s", err, stdout.String())
+	}
+	want := path + `:6:12: interactive.bindings.enter: key is reserved for printing the selection
+` + path + `:7:12: interactive.bindings.alt-o: alt-o is already bound by interactive.bindings.Alt-O
+` + path + `:8:12: interactive.bindings.alt-c: no command named "Nope" in interactive.commands
+`
+	if stdout.String() != want {
+		t.Errorf("stdout = %q, want %q", stdout.String(), want)
+	}
+}
+
+func TestConfigValidate_SyntaxError(t *testing.T) {
+	t.Parallel()
+
+	path := writeConfigFile(t, "pr:\n  title: x\n\tbody: y\n")
+
+	opts, stdout, _ := testOptionsWithBuffers(t, nil)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"config", "validate", path})
+
+	if err := root.Execute(); err == nil {
+		t.Fatal("expected an error for invalid YAML")
+	}
+	if want := path + ":2: found a tab character"; !strings.HasPrefix(stdout.String(), want) {
+		t.Errorf("output = %q, want prefix %q", stdout.String(), want)
+	}
+}
+
+func TestConfigValidate_MissingDefaultFile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	opts, _, stderr := testOptionsWithBuffers(t, nil)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"config", "validate"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("config validate returned error: %v", err)
+	}
+	if !strings.Contains(stderr.String(), "No config file") {
+		t.Errorf("expected a note on stderr, got %q", stderr.String())
+	}
+}
+
+func TestConfigSchema(t *testing.T) {
+	t.Parallel()
+
+	opts, stdout, _ := testOptionsWithBuffers(t, nil)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"config", "schema"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("config schema returned error: %v", err)
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(stdout.Bytes(), &schema); err != nil {
+		t.Fatalf("output is not JSON: %v", err)
+	}
+	if schema["$schema"] == nil || schema["properties"] == nil {
+		t.Errorf("unexpected schema: %v", schema)
+	}
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/duboisf/linear/internal/format"
+)
+
+// _flagValidators check config default values for flags that are otherwise
+// only parsed inside RunE, so a bad default is reported as a config error
+// rather than as if it had been typed on the command line.
+var _flagValidators = map[string]func(opts Options, value string) error{
+	"column": func(opts Options, value string) error {
+		colSet, err := configColumns(opts)
+		if err != nil {
+			return err
+		}
+		_, err = format.ParseColumns(colSet, value)
+		return err
+	},
+	"status": func(_ Options, value string) error { return validateStatusFilter(value) },
+	"label":  func(_ Options, value string) error { return validateLabelFilter(value) },
+	"sort": func(_ Options, value string) error {
+		if !slices.Contains([]string{"status", "priority", "identifier", "title"}, strings.ToLower(value)) {
+			return fmt.Errorf("unknown sort %q (valid: status, priority, identifier, title)", value)
+		}
+		return nil
+	},
+	"output": func(_ Options, value string) error { return format.ValidateOutputFormat(value) },
+}
+
+// _inheritedDefaults maps commands built from another command to the path of
+// that command, whose defaults they take unless they set their own: "view"
+// runs "issue list" with a saved view.
+var _inheritedDefaults = map[string]string{"view": "issue list"}
+
+// applyConfigDefaults replaces flag defaults with the values from the
+// config's defaults: section. The flags are not marked as changed, so values
+// given on the command line and saved views still take precedence, and
+// --help shows the configured defaults. All problems are returned together.
+func applyConfigDefaults(root *cobra.Command, opts Options) error {
+	if opts.Config == nil || len(opts.Config.Defaults) == 0 {
+		return nil
+	}
+	var errs []error
+	for _, path := range slices.Sorted(maps.Keys(opts.Config.Defaults)) {
+		cmd, rest, err := root.Find(strings.Fields(path))
+		if err != nil || len(rest) > 0 || cmd == root {
+			errs = append(errs, fmt.Errorf("config defaults: unknown command %q", path))
+			continue
+		}
+		flags := opts.Config.Defaults[path]
+		for _, name := range slices.Sorted(maps.Keys(flags)) {
+			if err := setFlagDefault(cmd, opts, name, flags[name]); err != nil {
+				errs = append(errs, fmt.Errorf("config defaults: %s --%s: %w", path, name, err))
+			}
+		}
+	}
+	for path, from := range _inheritedDefaults {
+		cmd, _, err := root.Find(strings.Fields(path))
+		if err != nil || cmd == root {
+			continue
+		}
+		own := opts.Config.Defaults[path]
+		for name, value := range opts.Config.Defaults[from] {
+			if _, ok := own[name]; !ok {
+				// Problems were reported for the command they come from.
+				_ = setFlagDefault(cmd, opts, name, value)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// setFlagDefault validates value and makes it the default of cmd's flag.
+func setFlagDefault(cmd *cobra.Command, opts Options, name, value string) error {
+	flag := cmd.Flags().Lookup(name)
+	if flag == nil || flag.Hidden {
+		return fmt.Errorf("unknown flag")
+	}
+	if validate, ok := _flagValidators[name]; ok {
+		if err := validate(opts, value); err != nil {
+			return err
+		}
+	}
+	if err := flag.Value.Set(value); err != nil {
+		return err
+	}
+	flag.DefValue = flag.Value.String()
+	return nil
+}
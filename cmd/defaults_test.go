@@ -0,0 +1,174 @@
+package cmd_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/cmd"
+	"github.com/duboisf/linear/internal/config"
+)
+
+func TestConfigDefaults_Applied(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		args      []string
+		wantFirst float64
+	}{
+		{name: "config default", args: nil, wantFirst: 7},
+		{name: "flag overrides default", args: []string{"--limit", "3"}, wantFirst: 3},
+		{name: "view overrides default", args: []string{"--view", "small"}, wantFirst: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, rec := newRecordingGraphQLServer(t, map[string]string{
+				"ListMyIssues": listMyIssuesResponse,
+			})
+
+			opts, stdout, _ := testOptionsWithBuffers(t, server)
+			opts.Config = &config.Config{
+				Defaults: map[string]map[string]string{
+					"issue list": {"limit": "7", "column": "id,title", "cycle": "all", "output": "csv"},
+				},
+				Views: []config.ViewConfig{{Name: "small", Limit: 2}},
+			}
+			root := cmd.NewRootCmd(opts)
+			root.SetArgs(append([]string{"issue", "list"}, tt.args...))
+
+			if err := root.Execute(); err != nil {
+				t.Fatalf("issue list returned error: %v", err)
+			}
+
+			if vars := listIssuesVars(t, rec); vars["first"] != tt.wantFirst {
+				t.Errorf("first = %v, want %v", vars["first"], tt.wantFirst)
+			}
+			if got := stdout.String(); !strings.HasPrefix(got, "id,title\n") {
+				t.Errorf("expected csv with the default columns, got %q", got)
+			}
+		})
+	}
+}
+
+func TestConfigDefaults_View(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		own       map[string]string
+		args      []string
+		wantFirst float64
+	}{
+		{name: "issue list defaults", args: []string{"view", "plain"}, wantFirst: 7},
+		{name: "view overrides them", args: []string{"view", "small"}, wantFirst: 2},
+		{name: "own defaults override them", own: map[string]string{"limit": "4"}, args: []string{"view", "plain"}, wantFirst: 4},
+		{name: "flag overrides all", own: map[string]string{"limit": "4"}, args: []string{"view", "small", "--limit", "3"}, wantFirst: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, rec := newRecordingGraphQLServer(t, map[string]string{
+				"ListMyIssues": listMyIssuesResponse,
+			})
+
+			opts, stdout, _ := testOptionsWithBuffers(t, server)
+			opts.Config = &config.Config{
+				Defaults: map[string]map[string]string{
+					"issue list": {"limit": "7", "column": "id,title", "cycle": "all", "output": "csv"},
+				},
+				Views: []config.ViewConfig{{Name: "small", Limit: 2}, {Name: "plain"}},
+			}
+			if tt.own != nil {
+				opts.Config.Defaults["view"] = tt.own
+			}
+			root := cmd.NewRootCmd(opts)
+			root.SetArgs(tt.args)
+
+			if err := root.Execute(); err != nil {
+				t.Fatalf("view returned error: %v", err)
+			}
+
+			if vars := listIssuesVars(t, rec); vars["first"] != tt.wantFirst {
+				t.Errorf("first = %v, want %v", vars["first"], tt.wantFirst)
+			}
+			if got := stdout.String(); !strings.HasPrefix(got, "id,title\n") {
+				t.Errorf("expected csv with the default columns, got %q", got)
+			}
+		})
+	}
+}
+
+func TestConfigDefaults_ShownInHelp(t *testing.T) {
+	t.Parallel()
+
+	opts, stdout, _ := testOptionsWithBuffers(t, nil)
+	opts.Config = &config.Config{Defaults: map[string]map[string]string{
+		"issue list": {"sort": "priority"},
+	}}
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "list", "--help"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue list --help returned error: %v", err)
+	}
+	if !strings.Contains(stdout.String(), `(default "priority")`) {
+		t.Errorf("help should show the configured default, got:\n%s", stdout.String())
+	}
+}
+
+func TestConfigDefaults_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		defaults map[string]map[string]string
+		want     string
+	}{
+		{name: "unknown command", defaults: map[string]map[string]string{"issue lst": {"sort": "priority"}}, want: `unknown command "issue lst"`},
+		{name: "unknown flag", defaults: map[string]map[string]string{"issue list": {"sorting": "priority"}}, want: "issue list --sorting: unknown flag"},
+		{name: "hidden flag", defaults: map[string]map[string]string{"issue list": {"fzf-data": "true"}}, want: "issue list --fzf-data: unknown flag"},
+		{name: "bad sort", defaults: map[string]map[string]string{"issue list": {"sort": "age"}}, want: `unknown sort "age"`},
+		{name: "bad status", defaults: map[string]map[string]string{"issue list": {"status": "started,!done"}}, want: `unknown status "done"`},
+		{name: "bad label", defaults: map[string]map[string]string{"issue list": {"label": "bug+"}}, want: "empty label name"},
+		{name: "bad column", defaults: map[string]map[string]string{"issue list": {"column": "+nope"}}, want: "nope"},
+		{name: "bad int", defaults: map[string]map[string]string{"user list": {"limit": "lots"}}, want: "user list --limit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, _, _ := testOptionsWithBuffers(t, nil)
+			opts.Config = &config.Config{Defaults: tt.defaults}
+			root := cmd.NewRootCmd(opts)
+			root.SetArgs([]string{"issue", "list"})
+
+			err := root.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected error containing %q, got %v", tt.want, err)
+			}
+			if !strings.HasPrefix(err.Error(), "config defaults: ") {
+				t.Errorf("error %q should start with 'config defaults: '", err)
+			}
+		})
+	}
+}
+
+func TestConfigDefaults_InvalidAllowsConfigEdit(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("EDITOR", "true")
+
+	opts, _, _ := testOptionsWithBuffers(t, nil)
+	opts.Config = &config.Config{Defaults: map[string]map[string]string{"nope": {"x": "y"}}}
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"config", "edit"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("config edit should work despite bad defaults, got %v", err)
+	}
+}
@@ -0,0 +1,397 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/duboisf/linear/internal/cache"
+	"github.com/duboisf/linear/internal/config"
+)
+
+// Actions of the issue browser, bound to keys with interactive.bindings.
+const (
+	actionEdit        = "edit"
+	actionSwitchCycle = "switch-cycle"
+	actionSwitchView  = "switch-view"
+	actionCommand     = "command"
+	actionOpen        = "open"
+	actionCopyID      = "copy-id"
+	actionComment     = "comment"
+	actionAssignMe    = "assign-me"
+	actionStatus      = "status"
+	actionNextPreview = "next-preview"
+	actionPrevPreview = "prev-preview"
+	// actionNone removes a default binding.
+	actionNone = "none"
+	// _commandActionPrefix binds a key to a named custom command, e.g.
+	// "command:Claude".
+	_commandActionPrefix = "command:"
+)
+
+// _fzfActionHelp is the header help of each built-in action.
+var _fzfActionHelp = map[string]string{
+	actionEdit:        "edit",
+	actionSwitchCycle: "switch cycle",
+	actionSwitchView:  "switch view",
+	actionCommand:     "command",
+	actionOpen:        "open",
+	actionCopyID:      "copy id",
+	actionComment:     "comment",
+	actionAssignMe:    "assign to me",
+	actionStatus:      "status",
+	actionNextPreview: "next preview",
+	actionPrevPreview: "prev preview",
+}
+
+// _defaultFzfBindings are the bindings used when interactive.bindings
+// doesn't override them.
+var _defaultFzfBindings = []fzfBinding{
+	{key: "ctrl-e", action: actionEdit},
+	{key: "ctrl-y", action: actionSwitchCycle},
+	{key: "ctrl-v", action: actionSwitchView},
+	{key: "ctrl-o", action: actionCommand},
+	{key: "shift-right", action: actionNextPreview},
+	{key: "shift-left", action: actionPrevPreview},
+}
+
+// _reservedFzfKeys are keys the issue browser uses itself.
+var _reservedFzfKeys = map[string]string{
+	"enter":      "printing the selection",
+	"ctrl-m":     "printing the selection",
+	"esc":        "cancelling",
+	"ctrl-c":     "cancelling",
+	"ctrl-g":     "cancelling",
+	"tab":        "multi-select",
+	"ctrl-i":     "multi-select",
+	"btab":       "multi-select",
+	"up":         "moving the cursor",
+	"down":       "moving the cursor",
+	"ctrl-j":     "moving the cursor",
+	"ctrl-k":     "moving the cursor",
+	"ctrl-n":     "moving the cursor",
+	"ctrl-p":     "moving the cursor",
+	"ctrl-d":     "scrolling the preview",
+	"ctrl-u":     "scrolling the preview",
+	"shift-up":   "scrolling the preview",
+	"shift-down": "scrolling the preview",
+}
+
+// _fzfKeyRe matches the fzf key names that can be bound. Keys without a
+// modifier that type a character are left out, since binding them would
+// prevent typing them in the query.
+var _fzfKeyRe = regexp.MustCompile(`^(` +
+	`(ctrl-|alt-|ctrl-alt-)[a-z]|alt-[0-9]|f[1-9]|f1[0-2]|` +
+	`(alt-|shift-)?(up|down|left|right)|alt-(enter|space|bspace)|` +
+	`del|home|end|insert|pgup|pgdn|ctrl-space)$`)
+
+// fzfBinding is a key bound to an action in the issue browser.
+type fzfBinding struct {
+	key string
+	// action is one of the action constants, or _commandActionPrefix
+	// followed by a command name.
+	action string
+}
+
+// help returns the binding as shown in the header, e.g. "ctrl-e: edit".
+func (b fzfBinding) help() string {
+	if name, ok := strings.CutPrefix(b.action, _commandActionPrefix); ok {
+		return b.key + ": " + name
+	}
+	return b.key + ": " + _fzfActionHelp[b.action]
+}
+
+// bindingError is a problem with a key bound in the config file.
+type bindingError struct {
+	// path locates the key in the config file, e.g.
+	// "interactive.bindings.alt-o" or "interactive.commands[0].key".
+	path string
+	key  string
+	err  error
+}
+
+func (e *bindingError) Error() string {
+	return fmt.Sprintf("%s: %v", e.path, e.err)
+}
+
+// resolveFzfBindings returns the issue browser's bindings: the defaults
+// overridden by interactive.bindings, then by the commands' key fields. The
+// switch-view action is left out when there are no views. Each problem in
+// the config is reported as a *bindingError, joined into the returned error.
+func resolveFzfBindings(cfg *config.Config, hasViews bool) ([]fzfBinding, error) {
+	bindings := slices.Clone(_defaultFzfBindings)
+	bind := func(key, action string) {
+		i := slices.IndexFunc(bindings, func(b fzfBinding) bool { return b.key == key })
+		switch {
+		case action == actionNone || action == "":
+			if i >= 0 {
+				bindings = slices.Delete(bindings, i, i+1)
+			}
+		case i >= 0:
+			bindings[i].action = action
+		default:
+			bindings = append(bindings, fzfBinding{key: key, action: action})
+		}
+	}
+
+	var errs []error
+	if cfg != nil {
+		commands := cfg.Interactive.Commands
+		// seen maps each bound key to where it was first bound.
+		seen := make(map[string]string)
+		for _, raw := range slices.Sorted(maps.Keys(cfg.Interactive.Bindings)) {
+			action := strings.TrimSpace(cfg.Interactive.Bindings[raw])
+			path := "interactive.bindings." + raw
+			key, err := normalizeFzfKey(raw)
+			if err == nil {
+				if first, ok := seen[key]; ok {
+					err = fmt.Errorf("%s is already bound by %s", key, first)
+				}
+				seen[key] = path
+			}
+			if err == nil {
+				err = validateFzfAction(action, commands)
+			}
+			if err != nil {
+				errs = append(errs, &bindingError{path: path, key: raw, err: err})
+				continue
+			}
+			bind(key, action)
+		}
+
+		for i, c := range commands {
+			if c.Key == "" {
+				continue
+			}
+			path := fmt.Sprintf("interactive.commands[%d].key", i)
+			action := _commandActionPrefix + c.Name
+			key, err := normalizeFzfKey(c.Key)
+			if err == nil {
+				if first, ok := seen[key]; ok {
+					err = fmt.Errorf("%s is already bound by %s", key, first)
+				}
+				seen[key] = path
+			}
+			if err == nil {
+				err = validateFzfAction(action, commands)
+			}
+			if err != nil {
+				errs = append(errs, &bindingError{path: path, key: c.Key, err: err})
+				continue
+			}
+			bind(key, action)
+		}
+	}
+	if !hasViews {
+		bindings = slices.DeleteFunc(bindings, func(b fzfBinding) bool { return b.action == actionSwitchView })
+	}
+	return bindings, errors.Join(errs...)
+}
+
+// normalizeFzfKey returns key as fzf names it, or an error when it can't be
+// bound in the issue browser.
+func normalizeFzfKey(key string) (string, error) {
+	k := strings.ToLower(strings.TrimSpace(key))
+	k = strings.NewReplacer("control-", "ctrl-", "ctrl+", "ctrl-", "alt+", "alt-", "shift+", "shift-").Replace(k)
+	if use, ok := _reservedFzfKeys[k]; ok {
+		return "", fmt.Errorf("key is reserved for %s", use)
+	}
+	if !_fzfKeyRe.MatchString(k) {
+		return "", fmt.Errorf("unsupported key (use e.g. ctrl-x, alt-x, ctrl-alt-x or f1-f12)")
+	}
+	return k, nil
+}
+
+// validateFzfAction checks that action is a built-in action, "none", or
+// names a configured command.
+func validateFzfAction(action string, commands []config.Command) error {
+	if name, ok := strings.CutPrefix(action, _commandActionPrefix); ok {
+		if !slices.ContainsFunc(commands, func(c config.Command) bool { return c.Name == name }) {
+			return fmt.Errorf("no command named %q in interactive.commands", name)
+		}
+		// fzf ends execute(...) at the first closing parenthesis.
+		if strings.ContainsAny(name, "()") {
+			return fmt.Errorf("command name %q can't contain parentheses when bound to a key", name)
+		}
+		return nil
+	}
+	if _, ok := _fzfActionHelp[action]; ok || action == actionNone || action == "" {
+		return nil
+	}
+	return fmt.Errorf("unknown action %q (valid: %s, %s, or %sNAME)",
+		action, strings.Join(fzfActionNames(), ", "), actionNone, _commandActionPrefix)
+}
+
+// fzfActionNames returns the built-in action names.
+func fzfActionNames() []string {
+	return []string{
+		actionEdit, actionSwitchCycle, actionSwitchView, actionCommand, actionOpen,
+		actionCopyID, actionComment, actionAssignMe, actionStatus, actionNextPreview,
+		actionPrevPreview,
+	}
+}
+
+// fzfBindContext holds what the issue browser's bindings refer to.
+type fzfBindContext struct {
+	// commands are the configured custom commands.
+	commands []config.Command
+	// self is the path of the running executable.
+	self string
+	// reload is "+reload(...)" refreshing the issue list, or empty.
+	reload string
+	// cycleStateFile is the file the cycle and view pickers write to.
+	cycleStateFile string
+	// helpLine is the header help, with its newline escaped for echo.
+	helpLine string
+	// execFile receives commands with exec: true (see run-command).
+	execFile string
+	// issueDataDir holds the prefetched issue data of commands.
+	issueDataDir string
+	// afterActionsFile receives the actions to run after a command returns
+	// (see run-command); afterActionsFile + ".reload" holds the reload
+	// action.
+	afterActionsFile string
+	// previewModeFile holds the preview mode (see issue preview).
+	previewModeFile string
+}
+
+// newFzfBindContext returns the context of the issue browser's bindings,
+// preparing the files they use in the session directory. reloadCmd prints
+// the issue list, or is empty when it can't be reloaded.
+func newFzfBindContext(session *browseSession, c *cache.Cache, self, reloadCmd, helpLine string, commands []config.Command) (fzfBindContext, error) {
+	reloadAction := ""
+	if reloadCmd != "" {
+		reloadAction = "+reload(" + reloadCmd + ")"
+	}
+	afterActionsFile := session.path("after-actions")
+	if err := os.WriteFile(afterActionsFile+".reload", []byte(strings.TrimPrefix(reloadAction, "+")), 0o644); err != nil {
+		return fzfBindContext{}, fmt.Errorf("writing after-actions file: %w", err)
+	}
+	return fzfBindContext{
+		commands:       commands,
+		self:           self,
+		reload:         reloadAction,
+		cycleStateFile: session.cycleFile(),
+		helpLine:       helpLine,
+		execFile:       session.path("exec-command"),
+		// Issue data is pre-cached during prefetch at issue-data/<ID>
+		// for custom commands, and shared between sessions.
+		issueDataDir:     filepath.Join(c.Dir, "issue-data"),
+		afterActionsFile: afterActionsFile,
+		previewModeFile:  session.path("preview-mode"),
+	}, nil
+}
+
+// fzfAction returns the fzf action run by binding b. {+1} is the identifier
+// of every selected issue, or of the current one.
+func (c fzfBindContext) fzfAction(b fzfBinding) string {
+	// Actions changing issues refresh the list, clear the selection and
+	// re-render the preview from the updated cache.
+	afterEdit := c.reload + "+deselect-all+refresh-preview"
+
+	if name, ok := strings.CutPrefix(b.action, _commandActionPrefix); ok {
+		i := slices.IndexFunc(c.commands, func(cmd config.Command) bool { return cmd.Name == name })
+		// Commands that don't use the terminal run without fzf handing it
+		// over, so the screen doesn't flash.
+		execute := "execute"
+		if i >= 0 && (c.commands[i].Silent || c.commands[i].Output == config.OutputPreview) && !c.commands[i].Confirm {
+			execute = "execute-silent"
+		}
+		return c.commandAction(execute, "--command "+shellQuote(name))
+	}
+	switch b.action {
+	case actionEdit:
+		// execute() hands the terminal to the command, allowing nested fzf
+		// pickers.
+		return fmt.Sprintf(`execute(%s issue edit-interactive {+1})%s`, c.self, afterEdit)
+	case actionStatus:
+		return fmt.Sprintf(`execute(%s issue edit-interactive --field Status {+1})%s`, c.self, afterEdit)
+	case actionSwitchCycle:
+		// pick-cycle writes the cycle to the state file read by the reload
+		// command, and its header to the companion .header file.
+		return fmt.Sprintf(
+			`execute(%s issue pick-cycle --state-file '%s')%s+transform-header(cat '%s.header' 2>/dev/null; echo ""; echo "%s")`,
+			c.self, c.cycleStateFile, c.reload, c.cycleStateFile, c.helpLine,
+		)
+	case actionSwitchView:
+		// pick-view also writes the view name to <state-file>.view, read by
+		// the reload command, so it works like switch-cycle.
+		return fmt.Sprintf(
+			`execute(%s issue pick-view --state-file '%s')%s+transform-header(cat '%s.header' 2>/dev/null; echo ""; echo "%s")`,
+			c.self, c.cycleStateFile, c.reload, c.cycleStateFile, c.helpLine,
+		)
+	case actionCommand:
+		return c.commandAction("execute", "")
+	case actionOpen, actionCopyID:
+		return fmt.Sprintf(`execute-silent(%s issue action %s {+1})`, c.self, b.action)
+	case actionComment:
+		return fmt.Sprintf(`execute(%s issue action %s {+1})+deselect-all`, c.self, b.action)
+	case actionAssignMe:
+		return fmt.Sprintf(`execute(%s issue action %s {+1})%s`, c.self, b.action, afterEdit)
+	case actionNextPreview, actionPrevPreview:
+		step := 1
+		if b.action == actionPrevPreview {
+			step = -1
+		}
+		return fmt.Sprintf(`execute-silent(%s issue preview --mode-file '%s' --step %d)+refresh-preview`, c.self, c.previewModeFile, step)
+	}
+	return ""
+}
+
+// commandAction runs a custom command via the run-command hidden command,
+// with the given execute action (execute or execute-silent). run-command
+// shows a command picker unless flags name the command. Commands with
+// exec: true write to execFile instead of running inline; transform() then
+// aborts fzf so the browser can exec the command. Otherwise run-command
+// writes the actions to run next (refreshing the preview, reloading the
+// list or showing the output) to afterActionsFile; reading them from a file
+// avoids nesting reload(...) inside transform(...), whose parentheses
+// confuse fzf's parser.
+func (c fzfBindContext) commandAction(execute, flags string) string {
+	if flags != "" {
+		flags += " "
+	}
+	return fmt.Sprintf(
+		`%s(%s issue run-command %s--exec-file '%s' --issue-data-dir '%s' --after-actions-file '%s' -- {+1})+transform([ -f '%s' ] && echo abort || cat '%s' 2>/dev/null)`,
+		execute, c.self, flags, c.execFile, c.issueDataDir, c.afterActionsFile, c.execFile, c.afterActionsFile,
+	)
+}
+
+// browserHelp returns the help lines of the issue browser's header. The
+// preview tab bindings are shown with the fixed keys, on the second line.
+func browserHelp(bindings []fzfBinding) []string {
+	var actions, tabs []fzfBinding
+	for _, b := range bindings {
+		if b.action == actionNextPreview || b.action == actionPrevPreview {
+			tabs = append(tabs, b)
+		} else {
+			actions = append(actions, b)
+		}
+	}
+	keys := fzfBindingsHelp(actions)
+	if keys != "" {
+		keys += "  "
+	}
+	keys += "ctrl-d/u: scroll preview  shift-↑/↓: line by line"
+	nav := fzfBindingsHelp(tabs)
+	if nav != "" {
+		nav += "  "
+	}
+	nav += "tab: multi-select  enter: print selected  esc: cancel"
+	return []string{keys, nav}
+}
+
+// fzfBindingsHelp returns the header help of bindings, e.g.
+// "ctrl-e: edit  ctrl-o: command".
+func fzfBindingsHelp(bindings []fzfBinding) string {
+	help := make([]string, len(bindings))
+	for i, b := range bindings {
+		help[i] = b.help()
+	}
+	return strings.Join(help, "  ")
+}
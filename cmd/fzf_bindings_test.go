@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/internal/config"
+)
+
+func TestResolveFzfBindings_Defaults(t *testing.T) {
+	t.Parallel()
+
+	bindings, err := resolveFzfBindings(nil, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := fzfBindingsHelp(bindings), "ctrl-e: edit  ctrl-y: switch cycle  ctrl-o: command  shift-right: next preview  shift-left: prev preview"; got != want {
+		t.Errorf("help = %q, want %q", got, want)
+	}
+
+	bindings, _ = resolveFzfBindings(nil, true)
+	if got, want := fzfBindingsHelp(bindings), "ctrl-e: edit  ctrl-y: switch cycle  ctrl-v: switch view  ctrl-o: command  shift-right: next preview  shift-left: prev preview"; got != want {
+		t.Errorf("help with views = %q, want %q", got, want)
+	}
+}
+
+func TestResolveFzfBindings_Overrides(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{Interactive: config.InteractiveConfig{
+		Commands: []config.Command{{Name: "Claude", Command: "claude"}},
+		Bindings: map[string]string{
+			"ctrl-y": "none",
+			"ctrl-o": "status",
+			"Alt-O":  "open",
+			"alt-c":  "command:Claude",
+		},
+	}}
+	bindings, err := resolveFzfBindings(cfg, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "ctrl-e: edit  ctrl-o: status  shift-right: next preview  shift-left: prev preview  alt-o: open  alt-c: Claude"
+	if got := fzfBindingsHelp(bindings); got != want {
+		t.Errorf("help = %q, want %q", got, want)
+	}
+}
+
+func TestResolveFzfBindings_CommandKeys(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{Interactive: config.InteractiveConfig{
+		Commands: []config.Command{
+			{Name: "Claude", Command: "claude", Key: "ctrl-o"},
+			{Name: "Summary", Command: "summarize", Key: "Alt-S"},
+			{Name: "Again", Command: "again", Key: "alt-s"},
+			{Name: "Open", Command: "open", Key: "alt-o"},
+		},
+		Bindings: map[string]string{"alt-o": "open"},
+	}}
+	bindings, err := resolveFzfBindings(cfg, false)
+	want := "interactive.commands[2].key: alt-s is already bound by interactive.commands[1].key\n" +
+		"interactive.commands[3].key: alt-o is already bound by interactive.bindings.alt-o"
+	if err == nil || err.Error() != want {
+		t.Errorf("error = %v, want %q", err, want)
+	}
+	if got, want := fzfBindingsHelp(bindings), "ctrl-e: edit  ctrl-y: switch cycle  ctrl-o: Claude  shift-right: next preview  shift-left: prev preview  alt-o: open  alt-s: Summary"; got != want {
+		t.Errorf("help = %q, want %q", got, want)
+	}
+}
+
+func TestResolveFzfBindings_Errors(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{Interactive: config.InteractiveConfig{
+		Bindings: map[string]string{
+			"tab":    "edit",
+			"x":      "edit",
+			"ctrl-x": "frobnicate",
+			"alt-x":  "command:Nope",
+			"ctrl-E": "open",
+			"ctrl-e": "comment",
+		},
+	}}
+	_, err := resolveFzfBindings(cfg, true)
+	if err == nil {
+		t.Fatal("expected errors")
+	}
+	var keys []string
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var be *bindingError
+		if !errors.As(e, &be) {
+			t.Fatalf("error %v is not a *bindingError", e)
+		}
+		keys = append(keys, be.key)
+	}
+	if got, want := strings.Join(keys, " "), "alt-x ctrl-e ctrl-x tab x"; got != want {
+		t.Errorf("keys with errors = %q, want %q", got, want)
+	}
+	for _, want := range []string{
+		`interactive.bindings.alt-x: no command named "Nope"`,
+		`interactive.bindings.ctrl-e: ctrl-e is already bound by interactive.bindings.ctrl-E`,
+		`interactive.bindings.ctrl-x: unknown action "frobnicate"`,
+		`interactive.bindings.tab: key is reserved for multi-select`,
+		`interactive.bindings.x: unsupported key`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q should contain %q", err.Error(), want)
+		}
+	}
+}
+
+func TestFzfBindContext_FzfAction(t *testing.T) {
+	t.Parallel()
+
+	c := fzfBindContext{
+		commands: []config.Command{
+			{Name: "Claude Code", Command: "claude"},
+			{Name: "Summary", Command: "summarize", Output: config.OutputPreview},
+		},
+		self:             "/bin/linear",
+		reload:           "+reload(list)",
+		execFile:         "/cache/exec-command",
+		issueDataDir:     "/cache/issue-data",
+		afterActionsFile: "/cache/after-actions",
+		previewModeFile:  "/run/preview-mode",
+	}
+	tests := []struct {
+		action string
+		want   string
+	}{
+		{actionEdit, "execute(/bin/linear issue edit-interactive {+1})+reload(list)+deselect-all+refresh-preview"},
+		{actionStatus, "execute(/bin/linear issue edit-interactive --field Status {+1})+reload(list)+deselect-all+refresh-preview"},
+		{actionOpen, "execute-silent(/bin/linear issue action open {+1})"},
+		{actionAssignMe, "execute(/bin/linear issue action assign-me {+1})+reload(list)+deselect-all+refresh-preview"},
+		{actionPrevPreview, "execute-silent(/bin/linear issue preview --mode-file '/run/preview-mode' --step -1)+refresh-preview"},
+		{"command:Claude Code", "execute(/bin/linear issue run-command --command 'Claude Code' --exec-file '/cache/exec-command' --issue-data-dir '/cache/issue-data' --after-actions-file '/cache/after-actions' -- {+1})+transform([ -f '/cache/exec-command' ] && echo abort || cat '/cache/after-actions' 2>/dev/null)"},
+		{"command:Summary", "execute-silent(/bin/linear issue run-command --command 'Summary' --exec-file '/cache/exec-command' --issue-data-dir '/cache/issue-data' --after-actions-file '/cache/after-actions' -- {+1})+transform([ -f '/cache/exec-command' ] && echo abort || cat '/cache/after-actions' 2>/dev/null)"},
+	}
+	for _, tt := range tests {
+		if got := c.fzfAction(fzfBinding{key: "ctrl-x", action: tt.action}); got != tt.want {
+			t.Errorf("fzfAction(%s) =\n%s\nwant\n%s", tt.action, got, tt.want)
+		}
+	}
+}
+
+func TestBrowserHelp(t *testing.T) {
+	t.Parallel()
+
+	bindings, _ := resolveFzfBindings(nil, false)
+	want := []string{
+		"ctrl-e: edit  ctrl-y: switch cycle  ctrl-o: command  ctrl-d/u: scroll preview  shift-↑/↓: line by line",
+		"shift-right: next preview  shift-left: prev preview  tab: multi-select  enter: print selected  esc: cancel",
+	}
+	if got := browserHelp(bindings); !slices.Equal(got, want) {
+		t.Errorf("browserHelp() =\n%q\nwant\n%q", got, want)
+	}
+}
@@ -2,24 +2,76 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/Khan/genqlient/graphql"
 
 	"github.com/duboisf/linear/internal/api"
+	"github.com/duboisf/linear/internal/config"
+	"github.com/duboisf/linear/internal/prompt"
 )
 
 // GitWorktreeCreator abstracts git operations for creating worktrees.
 type GitWorktreeCreator interface {
 	RepoRootDir() (string, error)
+	// DefaultBranch returns the default branch of remote (e.g. "main"), or
+	// empty string if it cannot be determined.
+	DefaultBranch(remote string) (string, error)
 	BranchExists(branch string) (bool, error)
 	FetchBranch(remote, branch string) error
-	CreateWorktree(path, branch, startPoint string) error
-	PostCreate(dir string) error
+	// CreateWorktree adds a worktree at path. When startPoint is empty the
+	// existing branch is checked out; otherwise a new branch is created from
+	// startPoint. track selects --track/--no-track; nil uses git's default.
+	CreateWorktree(path, branch, startPoint string, track *bool) error
+	// RunHook runs a shell command in dir with extra environment variables,
+	// streaming its stdout and stderr to out.
+	RunHook(dir, command string, env []string, out io.Writer) error
+	// ListWorktrees returns all worktrees of the repository, main worktree first.
+	ListWorktrees() ([]GitWorktree, error)
+	// IsDirty reports whether the worktree at path has uncommitted changes.
+	IsDirty(path string) (bool, error)
+	// WorktreeStatus returns the colored short status of the worktree at
+	// path, starting with its branch line, followed by its latest commits.
+	WorktreeStatus(path string) (string, error)
+	// RemoveWorktree removes the worktree at path. force discards local changes.
+	RemoveWorktree(path string, force bool) error
+	// DeleteBranch deletes a local branch. force deletes it even if unmerged;
+	// otherwise an unmerged branch yields an error wrapping ErrBranchNotMerged.
+	DeleteBranch(branch string, force bool) error
+}
+
+// GitRunner abstracts the git commands used outside of worktree management.
+type GitRunner interface {
+	// PushBranch pushes branch to remote and sets it as the upstream.
+	PushBranch(remote, branch string) error
+	// CurrentBranch returns the branch checked out in the current directory,
+	// or empty string when HEAD is detached.
+	CurrentBranch() (string, error)
+	// HooksDir returns the absolute path of the repository's hooks directory,
+	// honoring core.hooksPath.
+	HooksDir() (string, error)
+}
+
+// ErrBranchNotMerged is returned by DeleteBranch when git refuses to delete a
+// branch that is not fully merged.
+var ErrBranchNotMerged = errors.New("branch is not fully merged")
+
+// GitWorktree describes one entry of "git worktree list --porcelain".
+type GitWorktree struct {
+	Path string
+	Head string
+	// Branch is the short branch name; empty for detached or bare worktrees.
+	Branch   string
+	Bare     bool
+	Detached bool
 }
 
 // execGitWorktreeCreator implements GitWorktreeCreator using os/exec.
@@ -35,6 +87,17 @@ func (g *execGitWorktreeCreator) RepoRootDir() (string, error) {
 	return strings.TrimSpace(string(out)), nil
 }
 
+func (g *execGitWorktreeCreator) DefaultBranch(remote string) (string, error) {
+	out, err := exec.CommandContext(g.ctx, "git", "symbolic-ref", "--short", "refs/remotes/"+remote+"/HEAD").Output()
+	if err != nil {
+		if _, ok := err.(*exec.ExitError); ok {
+			return "", nil // remote HEAD not set (e.g. never fetched with --set-head)
+		}
+		return "", fmt.Errorf("detecting default branch of %s: %w", remote, err)
+	}
+	return strings.TrimPrefix(strings.TrimSpace(string(out)), remote+"/"), nil
+}
+
 func (g *execGitWorktreeCreator) BranchExists(branch string) (bool, error) {
 	err := exec.CommandContext(g.ctx, "git", "rev-parse", "--verify", "refs/heads/"+branch).Run()
 	if err == nil {
@@ -53,12 +116,20 @@ func (g *execGitWorktreeCreator) FetchBranch(remote, branch string) error {
 	return nil
 }
 
-func (g *execGitWorktreeCreator) CreateWorktree(path, branch, startPoint string) error {
+func (g *execGitWorktreeCreator) CreateWorktree(path, branch, startPoint string, track *bool) error {
 	var args []string
 	if startPoint == "" {
 		args = []string{"worktree", "add", path, branch}
 	} else {
-		args = []string{"worktree", "add", "-b", branch, path, startPoint}
+		args = []string{"worktree", "add"}
+		if track != nil {
+			if *track {
+				args = append(args, "--track")
+			} else {
+				args = append(args, "--no-track")
+			}
+		}
+		args = append(args, "-b", branch, path, startPoint)
 	}
 	if err := exec.CommandContext(g.ctx, "git", args...).Run(); err != nil {
 		return fmt.Errorf("creating worktree: %w", err)
@@ -66,20 +137,274 @@ func (g *execGitWorktreeCreator) CreateWorktree(path, branch, startPoint string)
 	return nil
 }
 
-func (g *execGitWorktreeCreator) PostCreate(dir string) error {
-	if _, err := exec.LookPath("mise"); err != nil {
-		return nil
-	}
-	cmd := exec.CommandContext(g.ctx, "mise", "trust")
+func (g *execGitWorktreeCreator) RunHook(dir, command string, env []string, out io.Writer) error {
+	cmd := exec.CommandContext(g.ctx, "sh", "-c", command)
 	cmd.Dir = dir
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("running mise trust: %w", err)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	return cmd.Run()
+}
+
+func (g *execGitWorktreeCreator) ListWorktrees() ([]GitWorktree, error) {
+	out, err := exec.CommandContext(g.ctx, "git", "worktree", "list", "--porcelain").Output()
+	if err != nil {
+		return nil, fmt.Errorf("listing worktrees: %w", err)
+	}
+	return parseWorktreePorcelain(string(out)), nil
+}
+
+func (g *execGitWorktreeCreator) IsDirty(path string) (bool, error) {
+	out, err := exec.CommandContext(g.ctx, "git", "-C", path, "status", "--porcelain").Output()
+	if err != nil {
+		return false, fmt.Errorf("checking status of %s: %w", path, err)
+	}
+	return strings.TrimSpace(string(out)) != "", nil
+}
+
+func (g *execGitWorktreeCreator) WorktreeStatus(path string) (string, error) {
+	status, err := exec.CommandContext(g.ctx, "git", "-C", path, "-c", "color.status=always", "status", "--short", "--branch").Output()
+	if err != nil {
+		return "", fmt.Errorf("getting status of %s: %w", path, err)
+	}
+	log, err := exec.CommandContext(g.ctx, "git", "-C", path, "log", "--oneline", "--color=always", "-n", "5").Output()
+	if err != nil {
+		return "", fmt.Errorf("getting log of %s: %w", path, err)
+	}
+	return string(status) + "\n" + string(log), nil
+}
+
+func (g *execGitWorktreeCreator) RemoveWorktree(path string, force bool) error {
+	args := []string{"worktree", "remove"}
+	if force {
+		args = append(args, "--force")
+	}
+	args = append(args, path)
+	if err := exec.CommandContext(g.ctx, "git", args...).Run(); err != nil {
+		return fmt.Errorf("removing worktree %s: %w", path, err)
+	}
+	return nil
+}
+
+func (g *execGitWorktreeCreator) DeleteBranch(branch string, force bool) error {
+	flag := "-d"
+	if force {
+		flag = "-D"
+	}
+	out, err := exec.CommandContext(g.ctx, "git", "branch", flag, branch).CombinedOutput()
+	if err != nil {
+		if strings.Contains(string(out), "not fully merged") {
+			return fmt.Errorf("deleting branch %q: %w", branch, ErrBranchNotMerged)
+		}
+		return fmt.Errorf("deleting branch %q: %w: %s", branch, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// execGitRunner implements GitRunner using os/exec.
+type execGitRunner struct {
+	ctx context.Context
+}
+
+func (g *execGitRunner) PushBranch(remote, branch string) error {
+	out, err := exec.CommandContext(g.ctx, "git", "push", "--set-upstream", remote, branch).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("pushing %s to %s: %w: %s", branch, remote, err, strings.TrimSpace(string(out)))
 	}
 	return nil
 }
 
+func (g *execGitRunner) CurrentBranch() (string, error) {
+	if err := exec.CommandContext(g.ctx, "git", "rev-parse", "--show-toplevel").Run(); err != nil {
+		return "", fmt.Errorf("getting repo root: %w", err)
+	}
+	// -q makes symbolic-ref exit 1 silently on a detached HEAD.
+	out, err := exec.CommandContext(g.ctx, "git", "symbolic-ref", "--short", "-q", "HEAD").Output()
+	if err != nil {
+		return "", nil
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func (g *execGitRunner) HooksDir() (string, error) {
+	out, err := exec.CommandContext(g.ctx, "git", "rev-parse", "--git-path", "hooks").Output()
+	if err != nil {
+		return "", fmt.Errorf("getting hooks directory: %w", err)
+	}
+	return filepath.Abs(strings.TrimSpace(string(out)))
+}
+
+// parseWorktreePorcelain parses the output of "git worktree list --porcelain".
+// Entries are separated by blank lines; each starts with a "worktree" line.
+func parseWorktreePorcelain(out string) []GitWorktree {
+	var worktrees []GitWorktree
+	var cur *GitWorktree
+	for line := range strings.SplitSeq(out, "\n") {
+		key, value, _ := strings.Cut(strings.TrimRight(line, "\r"), " ")
+		switch key {
+		case "worktree":
+			worktrees = append(worktrees, GitWorktree{Path: value})
+			cur = &worktrees[len(worktrees)-1]
+		case "HEAD":
+			if cur != nil {
+				cur.Head = value
+			}
+		case "branch":
+			if cur != nil {
+				cur.Branch = strings.TrimPrefix(value, "refs/heads/")
+			}
+		case "bare":
+			if cur != nil {
+				cur.Bare = true
+			}
+		case "detached":
+			if cur != nil {
+				cur.Detached = true
+			}
+		}
+	}
+	return worktrees
+}
+
+// _branchIdentifierPattern matches an issue identifier such as "ais-42"
+// delimited by non-alphanumerics inside a branch name.
+var _branchIdentifierPattern = regexp.MustCompile(`(?i)(?:^|[^a-z0-9])([a-z][a-z0-9]*-[0-9]+)(?:[^0-9]|$)`)
+
+// identifierFromBranch extracts the first issue identifier from a branch
+// name (e.g. "fred/ais-42-fix-login" → "AIS-42"). Returns empty string if
+// the branch doesn't contain one.
+func identifierFromBranch(branch string) string {
+	ids := branchIdentifiers(branch)
+	if len(ids) == 0 {
+		return ""
+	}
+	return ids[0]
+}
+
+// branchIdentifiers returns every string of a branch name that looks like an
+// issue identifier, upper-cased and in order (e.g. "utf-8-ais-42" → "UTF-8",
+// "AIS-42"). Use teamIdentifier to keep those of known teams.
+func branchIdentifiers(branch string) []string {
+	var ids []string
+	for rest := branch; ; {
+		loc := _branchIdentifierPattern.FindStringSubmatchIndex(rest)
+		if loc == nil {
+			return ids
+		}
+		ids = append(ids, strings.ToUpper(rest[loc[2]:loc[3]]))
+		// Resume on the identifier's last digit, which can't start an
+		// identifier but lets the delimiter after it start the next one.
+		rest = rest[loc[3]-1:]
+	}
+}
+
+// findIssueWorktree returns the linked (non-main) worktree checked out on
+// branchName, or whose branch refers to identifier.
+func findIssueWorktree(worktrees []GitWorktree, identifier, branchName string) (GitWorktree, bool) {
+	for i, wt := range worktrees {
+		if i == 0 || wt.Branch == "" {
+			continue // never touch the main worktree
+		}
+		if (branchName != "" && wt.Branch == branchName) || slices.Contains(branchIdentifiers(wt.Branch), strings.ToUpper(identifier)) {
+			return wt, true
+		}
+	}
+	return GitWorktree{}, false
+}
+
+// worktreeOptions holds the resolved settings for creating a worktree.
+type worktreeOptions struct {
+	// PathTemplate is the Go template for the worktree directory.
+	PathTemplate string
+	// Remote is the remote the base branch is fetched from.
+	Remote string
+	// BaseBranch is the start point for new branches; empty means auto-detect.
+	BaseBranch string
+	// Track selects whether new branches track the base branch.
+	Track *bool
+	// PostCreate lists the hooks run after the worktree is created.
+	PostCreate []config.Hook
+	// Transition is the workflow state the issue moves to; empty skips it.
+	Transition string
+}
+
+// newWorktreeOptions merges the worktree config section with command-line
+// overrides. Empty flag values leave the config (or built-in default) in place.
+func newWorktreeOptions(cfg *config.Config, baseFlag, pathFlag string) worktreeOptions {
+	wo := worktreeOptions{
+		PathTemplate: config.DefaultWorktreePath,
+		Remote:       config.DefaultWorktreeRemote,
+		PostCreate:   config.DefaultPostCreateHooks,
+	}
+	if cfg != nil {
+		if cfg.Worktree.Path != "" {
+			wo.PathTemplate = cfg.Worktree.Path
+		}
+		if cfg.Worktree.Remote != "" {
+			wo.Remote = cfg.Worktree.Remote
+		}
+		wo.BaseBranch = cfg.Worktree.BaseBranch
+		wo.Track = cfg.Worktree.Track
+		if cfg.Worktree.PostCreate != nil {
+			wo.PostCreate = cfg.Worktree.PostCreate
+		}
+		wo.Transition = cfg.Workflow.Worktree
+	}
+	if baseFlag != "" {
+		wo.BaseBranch = baseFlag
+	}
+	if pathFlag != "" {
+		wo.PathTemplate = pathFlag
+	}
+	return wo
+}
+
+// worktreePathData is the template data for worktree path templates. It
+// exposes the issue fields alongside the location of the current repository.
+type worktreePathData struct {
+	prompt.IssueData
+	// RepoRoot is the absolute path of the current repository.
+	RepoRoot string
+	// RepoName is the base name of RepoRoot.
+	RepoName string
+	// RepoParent is the directory containing RepoRoot.
+	RepoParent string
+}
+
+// resolveWorktreePath renders the path template for issue. A leading "~" is
+// expanded to the home directory and relative results are resolved against
+// the repository root.
+func resolveWorktreePath(tmpl string, issue *api.GetIssueIssue, repoRoot string) (string, error) {
+	data := worktreePathData{
+		IssueData:  prompt.NewIssueData(issue),
+		RepoRoot:   repoRoot,
+		RepoName:   filepath.Base(repoRoot),
+		RepoParent: filepath.Dir(repoRoot),
+	}
+	path, err := prompt.RenderRaw(tmpl, data)
+	if err != nil {
+		return "", fmt.Errorf("rendering worktree path: %w", err)
+	}
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return "", fmt.Errorf("worktree path template %q rendered an empty path", tmpl)
+	}
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("expanding ~ in worktree path: %w", err)
+		}
+		path = filepath.Join(home, strings.TrimPrefix(path, "~"))
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(repoRoot, path)
+	}
+	return filepath.Clean(path), nil
+}
+
 // runWorktreeCreate fetches the issue's branch name and creates a git worktree.
-func runWorktreeCreate(ctx context.Context, client graphql.Client, identifier string, git GitWorktreeCreator, w io.Writer) error {
+// Hook output and warnings go to errw so that w ends with the worktree path.
+func runWorktreeCreate(ctx context.Context, client graphql.Client, identifier string, git GitWorktreeCreator, wo worktreeOptions, w, errw io.Writer) error {
 	resp, err := api.GetIssue(ctx, client, identifier)
 	if err != nil {
 		return fmt.Errorf("getting issue: %w", err)
@@ -99,7 +424,10 @@ func runWorktreeCreate(ctx context.Context, client graphql.Client, identifier st
 		return err
 	}
 
-	worktreePath := filepath.Join(filepath.Dir(repoRoot), strings.ToLower(identifier), filepath.Base(repoRoot))
+	worktreePath, err := resolveWorktreePath(wo.PathTemplate, resp.Issue, repoRoot)
+	if err != nil {
+		return err
+	}
 
 	exists, err := git.BranchExists(branchName)
 	if err != nil {
@@ -107,24 +435,123 @@ func runWorktreeCreate(ctx context.Context, client graphql.Client, identifier st
 	}
 
 	if exists {
-		if err := git.CreateWorktree(worktreePath, branchName, ""); err != nil {
+		if err := git.CreateWorktree(worktreePath, branchName, "", nil); err != nil {
 			return err
 		}
 		fmt.Fprintf(w, "Reusing existing branch %q\n", branchName)
 	} else {
-		if err := git.FetchBranch("origin", "main"); err != nil {
+		base := wo.BaseBranch
+		if base == "" {
+			base, err = git.DefaultBranch(wo.Remote)
+			if err != nil {
+				return err
+			}
+			if base == "" {
+				base = "main"
+			}
+		}
+		startPoint := wo.Remote + "/" + base
+		if err := git.FetchBranch(wo.Remote, base); err != nil {
 			return err
 		}
-		if err := git.CreateWorktree(worktreePath, branchName, "origin/main"); err != nil {
+		if err := git.CreateWorktree(worktreePath, branchName, startPoint, wo.Track); err != nil {
 			return err
 		}
-		fmt.Fprintf(w, "Created new branch %q from origin/main\n", branchName)
+		fmt.Fprintf(w, "Created new branch %q from %s\n", branchName, startPoint)
 	}
 
-	if err := git.PostCreate(worktreePath); err != nil {
+	if err := runPostCreateHooks(git, wo.PostCreate, resp.Issue, repoRoot, worktreePath, errw); err != nil {
 		return err
 	}
 
+	// The worktree exists at this point, so a failed transition only warns.
+	if err := transitionIssue(ctx, client, resp.Issue, wo.Transition, workflowEventWorktree, errw); err != nil {
+		fmt.Fprintf(errw, "Warning: %v\n", err)
+	}
+
 	fmt.Fprintln(w, worktreePath)
 	return nil
 }
+
+// runPostCreateHooks runs hooks in order inside worktreePath, applying each
+// hook's failure policy. Every hook is checked and rendered before the first
+// one runs, so a mistake in a later hook doesn't leave the worktree half set
+// up.
+func runPostCreateHooks(git GitWorktreeCreator, hooks []config.Hook, issue *api.GetIssueIssue, repoRoot, worktreePath string, errw io.Writer) error {
+	data := prompt.NewIssueData(issue)
+	data.RepoRoot, data.WorktreePath = repoRoot, worktreePath
+	env := []string{
+		"LINEAR_ISSUE=" + issue.Identifier,
+		"LINEAR_BRANCH=" + issue.BranchName,
+		"LINEAR_REPO_ROOT=" + repoRoot,
+		"LINEAR_WORKTREE=" + worktreePath,
+	}
+
+	type resolvedHook struct {
+		name, command, policy string
+		builtin               bool
+	}
+	resolved := make([]resolvedHook, len(hooks))
+	for i, hook := range hooks {
+		command, err := hookCommand(hook, data)
+		if err != nil {
+			return fmt.Errorf("post_create hook %d: %w", i+1, err)
+		}
+		name := hook.Name
+		if name == "" {
+			name = hook.Builtin
+		}
+		if name == "" {
+			name = command
+		}
+
+		policy := hook.OnFailure
+		switch policy {
+		case "":
+			policy = config.HookAbort
+		case config.HookAbort, config.HookWarn, config.HookIgnore:
+		default:
+			return fmt.Errorf("post_create hook %q: invalid on_failure %q (want abort, warn or ignore)", name, hook.OnFailure)
+		}
+		resolved[i] = resolvedHook{name: name, command: command, policy: policy, builtin: hook.Builtin != ""}
+	}
+
+	for _, hook := range resolved {
+		if !hook.builtin {
+			fmt.Fprintf(errw, "Running %s\n", hook.name)
+		}
+		err := git.RunHook(worktreePath, hook.command, env, errw)
+		if err == nil {
+			continue
+		}
+		switch hook.policy {
+		case config.HookAbort:
+			return fmt.Errorf("post_create hook %q failed (worktree left at %s): %w", hook.name, worktreePath, err)
+		case config.HookWarn:
+			fmt.Fprintf(errw, "Warning: post_create hook %q failed: %v\n", hook.name, err)
+		}
+	}
+	return nil
+}
+
+// hookCommand resolves the shell command for hook, rendering templates with
+// the issue fields.
+func hookCommand(hook config.Hook, data prompt.IssueData) (string, error) {
+	switch {
+	case hook.Builtin != "" && hook.Command != "":
+		return "", fmt.Errorf("set either builtin or command, not both")
+	case hook.Builtin != "":
+		command, ok := config.BuiltinHooks[hook.Builtin]
+		if !ok {
+			return "", fmt.Errorf("unknown builtin %q", hook.Builtin)
+		}
+		return command, nil
+	case hook.Command == "":
+		return "", fmt.Errorf("no command or builtin set")
+	}
+	command, err := prompt.Render(hook.Command, data)
+	if err != nil {
+		return "", fmt.Errorf("rendering command: %w", err)
+	}
+	return command, nil
+}
@@ -0,0 +1,16 @@
+package cmd
+
+// ParseWorktreePorcelain is an exported wrapper for testing.
+func ParseWorktreePorcelain(out string) []GitWorktree {
+	return parseWorktreePorcelain(out)
+}
+
+// IdentifierFromBranch is an exported wrapper for testing.
+func IdentifierFromBranch(branch string) string {
+	return identifierFromBranch(branch)
+}
+
+// BranchIdentifiers is an exported wrapper for testing.
+func BranchIdentifiers(branch string) []string {
+	return branchIdentifiers(branch)
+}
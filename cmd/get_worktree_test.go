@@ -0,0 +1,77 @@
+package cmd_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/duboisf/linear/cmd"
+)
+
+func TestParseWorktreePorcelain(t *testing.T) {
+	t.Parallel()
+
+	out := `worktree /home/me/git/app
+HEAD 1111111111111111111111111111111111111111
+branch refs/heads/main
+
+worktree /home/me/git/ais-42/app
+HEAD 2222222222222222222222222222222222222222
+branch refs/heads/fred/ais-42-fix-login
+
+worktree /home/me/git/detached
+HEAD 3333333333333333333333333333333333333333
+detached
+
+`
+	got := cmd.ParseWorktreePorcelain(out)
+	want := []cmd.GitWorktree{
+		{Path: "/home/me/git/app", Head: "1111111111111111111111111111111111111111", Branch: "main"},
+		{Path: "/home/me/git/ais-42/app", Head: "2222222222222222222222222222222222222222", Branch: "fred/ais-42-fix-login"},
+		{Path: "/home/me/git/detached", Head: "3333333333333333333333333333333333333333", Detached: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v\nwant %+v", got, want)
+	}
+}
+
+func TestIdentifierFromBranch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		branch string
+		want   string
+	}{
+		{"fred/ais-42-fix-login", "AIS-42"},
+		{"AIS-42", "AIS-42"},
+		{"feature/ENG-7", "ENG-7"},
+		{"eng-12_cleanup", "ENG-12"},
+		{"main", ""},
+		{"feat/implement-feature-x", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := cmd.IdentifierFromBranch(tt.branch); got != tt.want {
+			t.Errorf("IdentifierFromBranch(%q) = %q, want %q", tt.branch, got, tt.want)
+		}
+	}
+}
+
+func TestBranchIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		branch string
+		want   []string
+	}{
+		{"fred/utf-8-ais-42-encoding", []string{"UTF-8", "AIS-42"}},
+		{"eng-1-eng-2", []string{"ENG-1", "ENG-2"}},
+		{"release-2024", []string{"RELEASE-2024"}},
+		{"eng-42abc-7", []string{"ENG-42"}},
+		{"main", nil},
+	}
+	for _, tt := range tests {
+		if got := cmd.BranchIdentifiers(tt.branch); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("BranchIdentifiers(%q) = %q, want %q", tt.branch, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+// newGitCmd creates the parent "git" command that groups git integration
+// subcommands.
+func newGitCmd(opts Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "git",
+		Short: "Integrate git with Linear issues",
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+	cmd.AddCommand(
+		newGitCommitMsgCmd(opts),
+		newGitInstallHooksCmd(opts),
+		newGitPostCheckoutCmd(opts),
+	)
+	return cmd
+}
@@ -0,0 +1,329 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/spf13/cobra"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/duboisf/linear/internal/api"
+	"github.com/duboisf/linear/internal/config"
+)
+
+// _issueRefPattern matches upper-case issue identifiers such as "AIS-42" in
+// commit messages.
+var _issueRefPattern = regexp.MustCompile(`\b([A-Z][A-Z0-9]*-[0-9]+)\b`)
+
+// _scissorsLine starts the part of the message that git strips when
+// committing with --verbose.
+const _scissorsLine = "# ------------------------ >8 ------------------------"
+
+// commitMsgOptions holds the resolved git.commit_msg settings.
+type commitMsgOptions struct {
+	Mode      string
+	MagicWord string
+	Validate  bool
+}
+
+// newCommitMsgOptions resolves the git.commit_msg config section, applying
+// defaults for unset values.
+func newCommitMsgOptions(cfg *config.Config) (commitMsgOptions, error) {
+	co := commitMsgOptions{Mode: config.CommitMsgPrefix, Validate: true}
+	if cfg == nil {
+		return co, nil
+	}
+	cm := cfg.Git.CommitMsg
+	switch cm.Mode {
+	case "":
+	case config.CommitMsgPrefix, config.CommitMsgAppend, config.CommitMsgNone:
+		co.Mode = cm.Mode
+	default:
+		return co, fmt.Errorf("invalid git.commit_msg.mode %q (want prefix, append or none)", cm.Mode)
+	}
+	co.MagicWord = strings.TrimSpace(cm.MagicWord)
+	if cm.Validate != nil {
+		co.Validate = *cm.Validate
+	}
+	return co, nil
+}
+
+// newGitCommitMsgCmd creates the hidden "git commit-msg" subcommand called by
+// the commit-msg hook. It links the message in FILE to the branch's issue.
+func newGitCommitMsgCmd(opts Options) *cobra.Command {
+	return &cobra.Command{
+		Use:    "commit-msg FILE",
+		Short:  "Link a commit message to the branch's issue (used by the commit-msg hook)",
+		Hidden: true,
+		Args:   cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			co, err := newCommitMsgOptions(opts.Config)
+			if err != nil {
+				return err
+			}
+
+			data, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("reading commit message: %w", err)
+			}
+			msg := string(data)
+			if skipCommitMessage(msg) {
+				return nil
+			}
+
+			var branch string
+			if opts.GitRunner != nil {
+				branch, _ = opts.GitRunner.CurrentBranch()
+			}
+
+			var branchID string
+			if co.Validate {
+				branchID, err = validateCommitIssues(cmd, opts, msg, branch)
+				if err != nil {
+					return err
+				}
+			} else {
+				branchID = commitBranchIssue(cmd, opts, branch)
+			}
+			if branchID == "" {
+				return nil
+			}
+
+			linked := linkCommitMessage(msg, branchID, co)
+			if linked == msg {
+				return nil
+			}
+			if err := os.WriteFile(args[0], []byte(linked), 0o644); err != nil {
+				return fmt.Errorf("writing commit message: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+// commitBranchIssue returns the identifier of the branch's issue, filtered
+// by team key but not checked for existence, for when validation is off.
+// When the team keys can't be loaded it warns and returns empty string:
+// nothing is linked rather than a guess like "UTF-8".
+func commitBranchIssue(cmd *cobra.Command, opts Options, branch string) string {
+	client, err := resolveClient(cmd, opts)
+	if err == nil {
+		var id string
+		if id, err = branchIssue(cmd.Context(), client, opts.Cache, branch); err == nil {
+			return id
+		}
+	}
+	fmt.Fprintf(opts.Stderr, "linear: not linking the commit: %v\n", err)
+	return ""
+}
+
+// validateCommitIssues checks that every issue referenced in msg exists and
+// returns an error naming the ones that don't. Only identifiers whose prefix
+// is a known team key are considered, so strings like "UTF-8" are ignored.
+// It returns the identifier of the existing issue the branch refers to, or
+// empty string when there is none. Network or authentication problems only
+// produce a warning so that committing never depends on Linear being
+// reachable: the branch's issue is still linked when the team keys could be
+// loaded, unchecked, and nothing is linked when they couldn't.
+func validateCommitIssues(cmd *cobra.Command, opts Options, msg, branch string) (string, error) {
+	ctx := cmd.Context()
+	var branchID string
+	warn := func(format string, a ...any) (string, error) {
+		fmt.Fprintf(opts.Stderr, "linear: skipping issue validation: "+format+"\n", a...)
+		return branchID, nil
+	}
+
+	client, err := resolveClient(cmd, opts)
+	if err != nil {
+		return warn("%v", err)
+	}
+	keys, err := teamKeys(ctx, client, opts.Cache)
+	if err != nil {
+		return warn("listing teams: %v", err)
+	}
+
+	branchID = teamIdentifier(branchIdentifiers(branch), keys)
+	if branchID != "" {
+		exists, err := issueExists(ctx, client, branchID)
+		if err != nil {
+			return warn("%v", err)
+		}
+		if !exists {
+			fmt.Fprintf(opts.Stderr, "linear: branch issue %s doesn't exist; not linking\n", branchID)
+			branchID = ""
+		}
+	}
+
+	var missing []string
+	for _, id := range commitIssueRefs(msg, keys) {
+		if id == branchID {
+			continue
+		}
+		exists, err := issueExists(ctx, client, id)
+		if err != nil {
+			return warn("%v", err)
+		}
+		if !exists {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		return "", fmt.Errorf("commit message references unknown issue(s): %s", strings.Join(missing, ", "))
+	}
+	return branchID, nil
+}
+
+// issueExists reports whether the issue with the given identifier exists.
+func issueExists(ctx context.Context, client graphql.Client, identifier string) (bool, error) {
+	resp, err := api.GetIssue(ctx, client, identifier)
+	if err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("getting issue %s: %w", identifier, err)
+	}
+	return resp.Issue != nil, nil
+}
+
+// isNotFoundError reports whether err is Linear's "Entity not found" error:
+// a GraphQL error of the response with that message and, when it has one,
+// the INPUT_ERROR code. Transport and HTTP failures never match, whatever
+// their text.
+func isNotFoundError(err error) bool {
+	var errs gqlerror.List
+	if !errors.As(err, &errs) {
+		var httpErr *graphql.HTTPError
+		if !errors.As(err, &httpErr) {
+			return false
+		}
+		errs = httpErr.Response.Errors
+	}
+	for _, e := range errs {
+		if !strings.HasPrefix(e.Message, "Entity not found") {
+			continue
+		}
+		if code, ok := e.Extensions["code"]; !ok || code == "INPUT_ERROR" {
+			return true
+		}
+	}
+	return false
+}
+
+// teamIdentifier returns the first of ids whose prefix is one of the team
+// keys, or empty string when there is none.
+func teamIdentifier(ids []string, keys map[string]bool) string {
+	for _, id := range ids {
+		if keys[issueTeamKey(id)] {
+			return id
+		}
+	}
+	return ""
+}
+
+// issueTeamKey returns the team key of an identifier ("AIS-42" → "AIS").
+func issueTeamKey(identifier string) string {
+	key, _, _ := strings.Cut(identifier, "-")
+	return key
+}
+
+// commitMessageContent returns the lines of msg that end up in the commit:
+// everything before the scissors line, minus comment lines.
+func commitMessageContent(msg string) []string {
+	var content []string
+	for _, line := range strings.Split(msg, "\n") {
+		if line == _scissorsLine {
+			break
+		}
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		content = append(content, line)
+	}
+	return content
+}
+
+// commitIssueRefs returns the sorted, unique identifiers in msg whose team
+// key is in keys.
+func commitIssueRefs(msg string, keys map[string]bool) []string {
+	seen := make(map[string]bool)
+	var refs []string
+	for _, line := range commitMessageContent(msg) {
+		for _, m := range _issueRefPattern.FindAllStringSubmatch(line, -1) {
+			id := m[1]
+			if seen[id] || !keys[issueTeamKey(id)] {
+				continue
+			}
+			seen[id] = true
+			refs = append(refs, id)
+		}
+	}
+	sort.Strings(refs)
+	return refs
+}
+
+// skipCommitMessage reports whether msg is a merge, fixup, squash or amend
+// message, which are left untouched.
+func skipCommitMessage(msg string) bool {
+	for _, line := range commitMessageContent(msg) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		for _, prefix := range []string{"Merge ", "fixup! ", "squash! ", "amend! "} {
+			if strings.HasPrefix(line, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+	return true // empty message; git aborts the commit anyway
+}
+
+// linkCommitMessage adds identifier to msg according to co: to the subject
+// line when the message doesn't mention it yet, and as a magic word line
+// (e.g. "Fixes AIS-42") when configured. Comment lines are preserved.
+func linkCommitMessage(msg, identifier string, co commitMsgOptions) string {
+	lines := strings.Split(msg, "\n")
+	subject, last := -1, -1
+	for i, line := range lines {
+		if line == _scissorsLine {
+			break
+		}
+		if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+		if subject < 0 {
+			subject = i
+		}
+		last = i
+	}
+	if subject < 0 {
+		return msg
+	}
+
+	content := strings.Join(commitMessageContent(msg), "\n")
+	mentioned := regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(identifier) + `\b`).MatchString(content)
+	if !mentioned {
+		switch co.Mode {
+		case config.CommitMsgPrefix:
+			lines[subject] = identifier + ": " + lines[subject]
+		case config.CommitMsgAppend:
+			lines[subject] = strings.TrimRight(lines[subject], " \t") + " (" + identifier + ")"
+		}
+	}
+
+	if co.MagicWord != "" {
+		magic := regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(co.MagicWord) + `\s+` + regexp.QuoteMeta(identifier) + `\b`)
+		if !magic.MatchString(content) {
+			trailer := []string{"", co.MagicWord + " " + identifier}
+			lines = append(lines[:last+1], append(trailer, lines[last+1:]...)...)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
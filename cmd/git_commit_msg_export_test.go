@@ -0,0 +1,6 @@
+package cmd
+
+// IsNotFoundError is an exported wrapper for testing.
+func IsNotFoundError(err error) bool {
+	return isNotFoundError(err)
+}
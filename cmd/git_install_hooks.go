@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// _hookMarker identifies hooks written by "linear git install-hooks" so they
+// can be overwritten without --force.
+const _hookMarker = "Installed by linear git install-hooks"
+
+// _gitHooks maps hook names to their scripts. Each does nothing when linear
+// isn't on PATH so that git never breaks for other contributors.
+var _gitHooks = []struct {
+	name   string
+	script string
+}{
+	{"commit-msg", `#!/bin/sh
+# ` + _hookMarker + `.
+# Links commits to the Linear issue of the current branch.
+command -v linear >/dev/null 2>&1 || exit 0
+exec linear git commit-msg "$1"
+`},
+	{"post-checkout", `#!/bin/sh
+# ` + _hookMarker + `.
+# Applies the workflow.checkout transition when an issue branch is checked out.
+command -v linear >/dev/null 2>&1 || exit 0
+exec linear git post-checkout "$1" "$2" "$3"
+`},
+}
+
+// newGitInstallHooksCmd creates the "git install-hooks" subcommand that
+// installs git hooks calling back into linear.
+func newGitInstallHooksCmd(opts Options) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "install-hooks",
+		Short: "Install git hooks that link commits and branches to issues",
+		Long: `Install commit-msg and post-checkout hooks in the current repository.
+
+The commit-msg hook adds the issue identifier from the branch name to commit
+messages, checks that referenced issues exist, and optionally adds a magic
+word line such as "Fixes AIS-42" (see the git.commit_msg config section).
+
+The post-checkout hook applies the workflow.checkout state transition when an
+issue branch is checked out.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.GitRunner == nil {
+				return fmt.Errorf("git is not available")
+			}
+			dir, err := opts.GitRunner.HooksDir()
+			if err != nil {
+				return err
+			}
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return fmt.Errorf("creating hooks directory: %w", err)
+			}
+
+			// Check every hook first so nothing is written when one is refused.
+			for _, hook := range _gitHooks {
+				path := filepath.Join(dir, hook.name)
+				existing, err := os.ReadFile(path)
+				switch {
+				case errors.Is(err, os.ErrNotExist):
+				case err != nil:
+					return fmt.Errorf("reading existing hook: %w", err)
+				case !strings.Contains(string(existing), _hookMarker) && !force:
+					return fmt.Errorf("%s already exists; use --force to replace it (a backup is kept)", path)
+				}
+			}
+
+			for _, hook := range _gitHooks {
+				if err := installGitHook(filepath.Join(dir, hook.name), hook.script, opts); err != nil {
+					return err
+				}
+				fmt.Fprintf(opts.Stdout, "Installed %s hook at %s\n", hook.name, filepath.Join(dir, hook.name))
+			}
+			return nil
+		},
+		ValidArgsFunction: cobra.NoFileCompletions,
+	}
+
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Replace existing hooks, keeping a .bak copy")
+
+	return cmd
+}
+
+// installGitHook writes script to path, first moving a hook not written by
+// install-hooks to path.bak.
+func installGitHook(path, script string, opts Options) error {
+	existing, err := os.ReadFile(path)
+	if err == nil && !strings.Contains(string(existing), _hookMarker) {
+		backup := path + ".bak"
+		if err := os.Rename(path, backup); err != nil {
+			return fmt.Errorf("backing up existing hook: %w", err)
+		}
+		fmt.Fprintf(opts.Stderr, "Moved existing hook to %s\n", backup)
+	}
+
+	if err := os.WriteFile(path, []byte(script), 0o755); err != nil {
+		return fmt.Errorf("writing hook: %w", err)
+	}
+	// WriteFile keeps the mode of an existing file.
+	if err := os.Chmod(path, 0o755); err != nil {
+		return fmt.Errorf("making hook executable: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/duboisf/linear/internal/api"
+)
+
+// newGitPostCheckoutCmd creates the hidden "git post-checkout" subcommand
+// called by the post-checkout hook. On a branch checkout it applies the
+// workflow.checkout transition to the branch's issue, staying silent when the
+// branch doesn't name an existing issue of a known team. It never fails, since
+// a failing post-checkout hook makes git report an error for a checkout that
+// already happened.
+func newGitPostCheckoutCmd(opts Options) *cobra.Command {
+	return &cobra.Command{
+		Use:    "post-checkout PREV NEW FLAG",
+		Short:  "Apply the checkout workflow transition (used by the post-checkout hook)",
+		Hidden: true,
+		Args:   cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// FLAG is 1 for branch checkouts and 0 for file checkouts.
+			if args[2] != "1" || opts.Config == nil || opts.Config.Workflow.Checkout == "" {
+				return nil
+			}
+			if opts.GitRunner == nil {
+				return nil
+			}
+			branch, err := opts.GitRunner.CurrentBranch()
+			if err != nil {
+				return nil
+			}
+			if identifierFromBranch(branch) == "" {
+				return nil
+			}
+
+			warn := func(err error) error {
+				fmt.Fprintf(opts.Stderr, "linear: %v\n", err)
+				return nil
+			}
+			client, err := resolveClient(cmd, opts)
+			if err != nil {
+				return warn(err)
+			}
+			identifier, err := branchIssue(cmd.Context(), client, opts.Cache, branch)
+			if err != nil {
+				return warn(err)
+			}
+			if identifier == "" {
+				return nil
+			}
+			resp, err := api.GetIssue(cmd.Context(), client, identifier)
+			if err != nil {
+				if isNotFoundError(err) {
+					return nil // e.g. a stale branch of a deleted issue
+				}
+				return warn(fmt.Errorf("getting issue: %w", err))
+			}
+			if resp.Issue == nil {
+				return nil
+			}
+			if err := transitionIssue(cmd.Context(), client, resp.Issue, opts.Config.Workflow.Checkout, workflowEventCheckout, opts.Stderr); err != nil {
+				return warn(err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,378 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/duboisf/linear/cmd"
+	"github.com/duboisf/linear/internal/config"
+)
+
+const listTeamsResponse = `{
+	"data": {
+		"teams": {
+			"nodes": [
+				{"id": "team-1", "key": "ENG", "name": "Engineering"}
+			]
+		}
+	}
+}`
+
+// newIssueLookupServer serves ListTeams and answers GetIssue only for the
+// given identifiers; others get Linear's "Entity not found" error.
+func newIssueLookupServer(t *testing.T, existing ...string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req graphqlRequest
+		if err := json.Unmarshal(body, &req); err != nil {
+			http.Error(w, "parsing json", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch req.OperationName {
+		case "ListTeams":
+			_, _ = w.Write([]byte(listTeamsResponse))
+		case "GetIssue":
+			var vars struct{ ID string }
+			_ = json.Unmarshal(req.Variables, &vars)
+			for _, id := range existing {
+				if vars.ID == id {
+					_, _ = w.Write([]byte(`{"data":{"issue":{"id":"1","identifier":"` + id + `","title":"t"}}}`))
+					return
+				}
+			}
+			_, _ = w.Write([]byte(`{"data":null,"errors":[{"message":"Entity not found: Issue","extensions":{"code":"INPUT_ERROR","type":"invalid input"}}]}`))
+		default:
+			http.Error(w, "unknown operation: "+req.OperationName, http.StatusBadRequest)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGitInstallHooks(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "hooks")
+	opts, stdout, _ := testOptionsWithBuffers(t, nil)
+	opts.GitRunner = &mockGitRunner{hooksDir: dir}
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"git", "install-hooks"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("git install-hooks returned error: %v", err)
+	}
+
+	path := filepath.Join(dir, "commit-msg")
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("hook not written: %v", err)
+	}
+	if info.Mode().Perm()&0o111 == 0 {
+		t.Errorf("hook mode = %v, want executable", info.Mode())
+	}
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), `linear git commit-msg "$1"`) {
+		t.Errorf("hook content = %q", data)
+	}
+	for _, hook := range []string{"commit-msg", "post-checkout"} {
+		if !strings.Contains(stdout.String(), "Installed "+hook+" hook") {
+			t.Errorf("output = %q, want %s installed", stdout.String(), hook)
+		}
+	}
+	data, _ = os.ReadFile(filepath.Join(dir, "post-checkout"))
+	if !strings.Contains(string(data), `linear git post-checkout "$1" "$2" "$3"`) {
+		t.Errorf("post-checkout content = %q", data)
+	}
+
+	// Reinstalling over our own hook needs no --force.
+	root = cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"git", "install-hooks"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("reinstall returned error: %v", err)
+	}
+}
+
+func TestGitInstallHooks_ExistingHook(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "commit-msg")
+	if err := os.WriteFile(path, []byte("#!/bin/sh\necho custom\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	opts, _, stderr := testOptionsWithBuffers(t, nil)
+	opts.GitRunner = &mockGitRunner{hooksDir: dir}
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"git", "install-hooks"})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--force") {
+		t.Fatalf("error = %v, want refusal mentioning --force", err)
+	}
+
+	root = cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"git", "install-hooks", "--force"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("install-hooks --force returned error: %v", err)
+	}
+	backup, err := os.ReadFile(path + ".bak")
+	if err != nil || !strings.Contains(string(backup), "echo custom") {
+		t.Errorf("backup = %q, %v; want the original hook", backup, err)
+	}
+	if !strings.Contains(stderr.String(), "commit-msg.bak") {
+		t.Errorf("stderr = %q, want backup notice", stderr.String())
+	}
+}
+
+func TestGitCommitMsg(t *testing.T) {
+	t.Parallel()
+
+	const verbose = "\n# Please enter the commit message.\n" +
+		"# ------------------------ >8 ------------------------\n" +
+		"diff --git a/x b/x\n"
+	noValidate := false
+
+	tests := []struct {
+		name   string
+		branch string
+		msg    string
+		cfg    config.CommitMsgConfig
+		want   string
+	}{
+		{
+			name:   "prefix by default",
+			branch: "fred/eng-42-feature",
+			msg:    "Add feature\n",
+			want:   "ENG-42: Add feature\n",
+		},
+		{
+			name:   "append",
+			branch: "fred/eng-42-feature",
+			msg:    "Add feature\n\nBody text.\n",
+			cfg:    config.CommitMsgConfig{Mode: config.CommitMsgAppend},
+			want:   "Add feature (ENG-42)\n\nBody text.\n",
+		},
+		{
+			name:   "already mentioned",
+			branch: "fred/eng-42-feature",
+			msg:    "Add feature\n\nPart of eng-42.\n",
+			want:   "Add feature\n\nPart of eng-42.\n",
+		},
+		{
+			name:   "magic word before comments",
+			branch: "eng-42",
+			msg:    "Add feature\n" + verbose,
+			cfg:    config.CommitMsgConfig{MagicWord: "Fixes"},
+			want:   "ENG-42: Add feature\n\nFixes ENG-42\n" + verbose,
+		},
+		{
+			name:   "magic word already present",
+			branch: "eng-42",
+			msg:    "ENG-42: Add feature\n\nfixes ENG-42\n",
+			cfg:    config.CommitMsgConfig{MagicWord: "Fixes"},
+			want:   "ENG-42: Add feature\n\nfixes ENG-42\n",
+		},
+		{
+			name:   "non-team identifiers ignored",
+			branch: "eng-42",
+			msg:    "Switch to UTF-8 everywhere\n",
+			want:   "ENG-42: Switch to UTF-8 everywhere\n",
+		},
+		{
+			name:   "branch issue unknown",
+			branch: "eng-999-stale",
+			msg:    "Add feature\n",
+			want:   "Add feature\n",
+		},
+		{
+			name:   "branch without issue",
+			branch: "main",
+			msg:    "Add feature\n",
+			want:   "Add feature\n",
+		},
+		{
+			name:   "unvalidated",
+			branch: "fred/eng-42-feature",
+			msg:    "Add feature\n",
+			cfg:    config.CommitMsgConfig{Validate: &noValidate},
+			want:   "ENG-42: Add feature\n",
+		},
+		{
+			name:   "unvalidated non-team branch",
+			branch: "release-2024-hotfix",
+			msg:    "Add feature\n",
+			cfg:    config.CommitMsgConfig{Validate: &noValidate},
+			want:   "Add feature\n",
+		},
+		{
+			name:   "fixup untouched",
+			branch: "eng-42",
+			msg:    "fixup! Add feature\n",
+			want:   "fixup! Add feature\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newIssueLookupServer(t, "ENG-42")
+			opts, _, _ := testOptionsWithBuffers(t, server)
+			opts.GitRunner = &mockGitRunner{currentBranch: tt.branch}
+			opts.Config = &config.Config{Git: config.GitConfig{CommitMsg: tt.cfg}}
+
+			file := filepath.Join(t.TempDir(), "COMMIT_EDITMSG")
+			if err := os.WriteFile(file, []byte(tt.msg), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			root := cmd.NewRootCmd(opts)
+			root.SetArgs([]string{"git", "commit-msg", file})
+			if err := root.Execute(); err != nil {
+				t.Fatalf("git commit-msg returned error: %v", err)
+			}
+
+			got, _ := os.ReadFile(file)
+			if string(got) != tt.want {
+				t.Errorf("message = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGitCommitMsg_UnknownIssue(t *testing.T) {
+	t.Parallel()
+
+	server := newIssueLookupServer(t, "ENG-42")
+	opts, _, _ := testOptionsWithBuffers(t, server)
+	opts.GitRunner = &mockGitRunner{currentBranch: "eng-42"}
+
+	file := filepath.Join(t.TempDir(), "COMMIT_EDITMSG")
+	msg := "Add feature\n\nSee ENG-7 and ENG-8.\n"
+	if err := os.WriteFile(file, []byte(msg), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"git", "commit-msg", file})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "unknown issue(s): ENG-7, ENG-8") {
+		t.Fatalf("error = %v, want unknown ENG-7, ENG-8", err)
+	}
+	if got, _ := os.ReadFile(file); string(got) != msg {
+		t.Errorf("rejected message was modified: %q", got)
+	}
+}
+
+func TestGitCommitMsg_Unauthenticated(t *testing.T) {
+	t.Parallel()
+
+	opts, _, stderr := testOptionsKeyringError(t)
+	opts.GitRunner = &mockGitRunner{currentBranch: "fred/eng-42-feature"}
+
+	file := filepath.Join(t.TempDir(), "COMMIT_EDITMSG")
+	if err := os.WriteFile(file, []byte("Add feature\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"git", "commit-msg", file})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("git commit-msg must not block commits when offline: %v", err)
+	}
+	// Without the team keys, "ENG-42" can't be told from "UTF-8".
+	if got, _ := os.ReadFile(file); string(got) != "Add feature\n" {
+		t.Errorf("message = %q, want it unlinked", got)
+	}
+	if !strings.Contains(stderr.String(), "skipping issue validation") {
+		t.Errorf("stderr = %q, want warning", stderr.String())
+	}
+}
+
+func TestIsNotFoundError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "entity not found",
+			err:  gqlerror.List{{Message: "Entity not found: Issue", Extensions: map[string]any{"code": "INPUT_ERROR"}}},
+			want: true,
+		},
+		{
+			name: "without extensions",
+			err:  gqlerror.List{{Message: "Entity not found"}},
+			want: true,
+		},
+		{
+			name: "other code",
+			err:  gqlerror.List{{Message: "Entity not found: Issue", Extensions: map[string]any{"code": "AUTHENTICATION_ERROR"}}},
+		},
+		{
+			name: "http error",
+			err: &graphql.HTTPError{StatusCode: 400, Response: graphql.Response{
+				Errors: gqlerror.List{{Message: "Entity not found: Issue", Extensions: map[string]any{"code": "INPUT_ERROR"}}},
+			}},
+			want: true,
+		},
+		{
+			name: "transport error",
+			err:  fmt.Errorf("dial tcp: lookup api.linear.app: not found"),
+		},
+		{
+			name: "http 404",
+			err:  &graphql.HTTPError{StatusCode: 404, Response: graphql.Response{Errors: gqlerror.List{{Message: "404 page not found"}}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := cmd.IsNotFoundError(tt.err); got != tt.want {
+				t.Errorf("IsNotFoundError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGitCommitMsg_InvalidMode(t *testing.T) {
+	t.Parallel()
+
+	opts, _, _ := testOptionsWithBuffers(t, nil)
+	opts.Config = &config.Config{Git: config.GitConfig{CommitMsg: config.CommitMsgConfig{Mode: "sideways"}}}
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"git", "commit-msg", filepath.Join(t.TempDir(), "missing")})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), `invalid git.commit_msg.mode "sideways"`) {
+		t.Fatalf("error = %v, want invalid mode", err)
+	}
+}
+
+func TestGitCommitMsg_IsHidden(t *testing.T) {
+	t.Parallel()
+
+	opts, _, _ := testOptionsWithBuffers(t, nil)
+	root := cmd.NewRootCmd(opts)
+	c, _, err := root.Find([]string{"git", "commit-msg"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.Hidden {
+		t.Error("git commit-msg should be hidden")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 
 	"github.com/Khan/genqlient/graphql"
@@ -107,6 +108,52 @@ func newMockGraphQLServer(t *testing.T, handlers map[string]string) *httptest.Se
 	return server
 }
 
+// graphqlRecorder records the requests received by a mock GraphQL server.
+type graphqlRecorder struct {
+	mu       sync.Mutex
+	requests []graphqlRequest
+}
+
+// calls returns the recorded requests for the given operation.
+func (r *graphqlRecorder) calls(operation string) []graphqlRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []graphqlRequest
+	for _, req := range r.requests {
+		if req.OperationName == operation {
+			out = append(out, req)
+		}
+	}
+	return out
+}
+
+// newRecordingGraphQLServer is like newMockGraphQLServer but also records
+// every request so tests can assert on mutations and their variables.
+func newRecordingGraphQLServer(t *testing.T, handlers map[string]string) (*httptest.Server, *graphqlRecorder) {
+	t.Helper()
+	rec := &graphqlRecorder{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphqlRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "parsing json", http.StatusBadRequest)
+			return
+		}
+		rec.mu.Lock()
+		rec.requests = append(rec.requests, req)
+		rec.mu.Unlock()
+
+		response, ok := handlers[req.OperationName]
+		if !ok {
+			http.Error(w, "unknown operation: "+req.OperationName, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	return server, rec
+}
+
 // newErrorGraphQLServer creates an httptest.Server that always returns an error.
 func newErrorGraphQLServer(t *testing.T) *httptest.Server {
 	t.Helper()
@@ -212,37 +259,79 @@ func (p *recordingProvider) DeleteAPIKey() error {
 	return p.deleteErr
 }
 
+// --- Mock PullRequestCreator ---
+
+type mockPullRequestCreator struct {
+	url   string
+	err   error
+	calls []cmd.PullRequest
+}
+
+func (m *mockPullRequestCreator) CreatePullRequest(pr cmd.PullRequest) (string, error) {
+	m.calls = append(m.calls, pr)
+	return m.url, m.err
+}
+
 // --- Mock GitWorktreeCreator ---
 
 type fetchCall struct {
 	remote, branch string
 }
 
+type pushCall struct {
+	remote, branch string
+}
+
 type createCall struct {
 	path, branch, startPoint string
+	track                    *bool
+}
+
+type hookCall struct {
+	dir, command string
+	env          []string
+}
+
+type removeCall struct {
+	path  string
+	force bool
 }
 
-type postCreateCall struct {
-	dir string
+type deleteBranchCall struct {
+	branch string
+	force  bool
 }
 
 type mockGitWorktreeCreator struct {
 	repoRoot        string
 	repoRootErr     error
+	defaultBranch   string
 	branchExists    bool
 	branchExistsErr error
 	fetchErr        error
 	createErr       error
-	postCreateErr   error
+	hookErrs        map[string]error
+	worktrees       []cmd.GitWorktree
+	listErr         error
+	dirty           map[string]bool
+	status          map[string]string
+	removeErr       error
+	deleteErr       error
 	fetchCalls      []fetchCall
 	createCalls     []createCall
-	postCreateCalls []postCreateCall
+	hookCalls       []hookCall
+	removeCalls     []removeCall
+	deleteCalls     []deleteBranchCall
 }
 
 func (m *mockGitWorktreeCreator) RepoRootDir() (string, error) {
 	return m.repoRoot, m.repoRootErr
 }
 
+func (m *mockGitWorktreeCreator) DefaultBranch(_ string) (string, error) {
+	return m.defaultBranch, nil
+}
+
 func (m *mockGitWorktreeCreator) BranchExists(_ string) (bool, error) {
 	return m.branchExists, m.branchExistsErr
 }
@@ -252,14 +341,57 @@ func (m *mockGitWorktreeCreator) FetchBranch(remote, branch string) error {
 	return m.fetchErr
 }
 
-func (m *mockGitWorktreeCreator) CreateWorktree(path, branch, startPoint string) error {
-	m.createCalls = append(m.createCalls, createCall{path, branch, startPoint})
+func (m *mockGitWorktreeCreator) CreateWorktree(path, branch, startPoint string, track *bool) error {
+	m.createCalls = append(m.createCalls, createCall{path, branch, startPoint, track})
 	return m.createErr
 }
 
-func (m *mockGitWorktreeCreator) PostCreate(dir string) error {
-	m.postCreateCalls = append(m.postCreateCalls, postCreateCall{dir})
-	return m.postCreateErr
+func (m *mockGitWorktreeCreator) RunHook(dir, command string, env []string, _ io.Writer) error {
+	m.hookCalls = append(m.hookCalls, hookCall{dir, command, env})
+	return m.hookErrs[command]
+}
+
+func (m *mockGitWorktreeCreator) ListWorktrees() ([]cmd.GitWorktree, error) {
+	return m.worktrees, m.listErr
+}
+
+func (m *mockGitWorktreeCreator) IsDirty(path string) (bool, error) {
+	return m.dirty[path], nil
+}
+
+func (m *mockGitWorktreeCreator) WorktreeStatus(path string) (string, error) {
+	return m.status[path], nil
+}
+
+func (m *mockGitWorktreeCreator) RemoveWorktree(path string, force bool) error {
+	m.removeCalls = append(m.removeCalls, removeCall{path, force})
+	return m.removeErr
+}
+
+func (m *mockGitWorktreeCreator) DeleteBranch(branch string, force bool) error {
+	m.deleteCalls = append(m.deleteCalls, deleteBranchCall{branch, force})
+	return m.deleteErr
+}
+
+// mockGitRunner records pushes and returns canned branch and hooks data.
+type mockGitRunner struct {
+	currentBranch string
+	hooksDir      string
+	pushErr       error
+	pushCalls     []pushCall
+}
+
+func (m *mockGitRunner) PushBranch(remote, branch string) error {
+	m.pushCalls = append(m.pushCalls, pushCall{remote, branch})
+	return m.pushErr
+}
+
+func (m *mockGitRunner) CurrentBranch() (string, error) {
+	return m.currentBranch, nil
+}
+
+func (m *mockGitRunner) HooksDir() (string, error) {
+	return m.hooksDir, nil
 }
 
 // --- Shared test fixtures ---
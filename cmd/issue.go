@@ -13,12 +13,17 @@ func newIssueCmd(opts Options) *cobra.Command {
 		},
 	}
 	cmd.AddCommand(
+		newIssueActionCmd(opts),
+		newIssueCurrentCmd(opts),
 		newIssueEditCmd(opts),
 		newIssueEditInteractiveCmd(opts),
 		newIssueGetCmd(opts),
 		newIssueListCmd(opts),
 		newIssueRunCommandCmd(opts),
 		newIssuePickCycleCmd(opts),
+		newIssuePickViewCmd(opts),
+		newIssuePreviewCmd(opts),
+		newIssuePromptCmd(opts),
 		newIssueWorktreeCmd(opts),
 	)
 	return cmd
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/duboisf/linear/internal/api"
+	"github.com/duboisf/linear/internal/format"
+)
+
+// newIssueActionCmd creates the hidden "issue action" subcommand used by the
+// fzf bindings for quick actions on the selected issues (see
+// interactive.bindings).
+func newIssueActionCmd(opts Options) *cobra.Command {
+	return &cobra.Command{
+		Use:       "action ACTION IDENTIFIER...",
+		Short:     "Run a quick action on issues (used by fzf bindings)",
+		Args:      cobra.MinimumNArgs(2),
+		Hidden:    true,
+		ValidArgs: []string{actionOpen, actionCopyID, actionComment, actionAssignMe},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			action, identifiers := args[0], args[1:]
+			if action == actionCopyID {
+				return copyToClipboard(strings.Join(identifiers, " "))
+			}
+			if action != actionOpen && action != actionComment && action != actionAssignMe {
+				return fmt.Errorf("unknown action %q (valid: %s, %s, %s, %s)", action, actionOpen, actionCopyID, actionComment, actionAssignMe)
+			}
+
+			client, err := resolveClient(cmd, opts)
+			if err != nil {
+				return err
+			}
+			issues, err := getIssues(cmd.Context(), client, identifiers)
+			if err != nil {
+				return err
+			}
+
+			var result string
+			switch action {
+			case actionOpen:
+				for _, issue := range issues {
+					if err := openBrowser(issue.Url); err != nil {
+						return err
+					}
+				}
+				return nil
+			case actionComment:
+				body, err := editInEditor("", "linear-comment-*.md")
+				if err != nil {
+					return err
+				}
+				if body = strings.TrimSpace(body); body == "" {
+					fmt.Fprintln(opts.Stderr, "Empty comment, nothing posted.")
+					return nil
+				}
+				for _, issue := range issues {
+					resp, err := api.CreateComment(cmd.Context(), client, issue.Id, body)
+					if err != nil {
+						return fmt.Errorf("commenting on %s: %w", issue.Identifier, err)
+					}
+					if resp.CommentCreate == nil || !resp.CommentCreate.Success {
+						return fmt.Errorf("commenting on %s was not successful", issue.Identifier)
+					}
+				}
+				result = "comment posted"
+			case actionAssignMe:
+				resp, err := api.Viewer(cmd.Context(), client)
+				if err != nil {
+					return fmt.Errorf("getting current user: %w", err)
+				}
+				if resp.Viewer == nil {
+					return fmt.Errorf("getting current user: no viewer in response")
+				}
+				input := &api.IssueUpdateInput{AssigneeId: &resp.Viewer.Id}
+				if err := updateIssues(cmd.Context(), client, issues, input, "assignee update"); err != nil {
+					return err
+				}
+				result = "assignee → " + resp.Viewer.Name
+			}
+
+			// Refresh cache so the fzf preview shows updated data.
+			if opts.Cache != nil {
+				for _, identifier := range identifiers {
+					refreshIssueCache(cmd.Context(), client, opts.Cache, identifier)
+				}
+			}
+
+			colorEnabled := format.ColorEnabled(opts.Stderr)
+			issueIDs := format.Colorize(colorEnabled, format.Bold, strings.Join(identifiers, ", "))
+			fmt.Fprintf(opts.Stderr, "Updated %s: %s\n", issueIDs, result)
+			return nil
+		},
+	}
+}
+
+// openBrowser opens url with the platform's URL handler.
+func openBrowser(url string) error {
+	opener := "xdg-open"
+	if runtime.GOOS == "darwin" {
+		opener = "open"
+	}
+	if err := exec.Command(opener, url).Run(); err != nil {
+		return fmt.Errorf("opening %s: %w", url, err)
+	}
+	return nil
+}
+
+// copyToClipboard copies text with the first clipboard tool found, falling
+// back to the OSC 52 escape sequence, which most terminals support.
+func copyToClipboard(text string) error {
+	tools := [][]string{{"wl-copy"}, {"xclip", "-selection", "clipboard"}, {"xsel", "--clipboard", "--input"}}
+	if runtime.GOOS == "darwin" {
+		tools = [][]string{{"pbcopy"}}
+	} else if os.Getenv("WAYLAND_DISPLAY") == "" {
+		tools = tools[1:]
+	}
+	for _, tool := range tools {
+		if _, err := exec.LookPath(tool[0]); err != nil {
+			continue
+		}
+		c := exec.Command(tool[0], tool[1:]...)
+		c.Stdin = strings.NewReader(text)
+		if err := c.Run(); err != nil {
+			return fmt.Errorf("running %s: %w", tool[0], err)
+		}
+		return nil
+	}
+
+	tty, err := os.OpenFile("/dev/tty", os.O_WRONLY, 0)
+	if err != nil {
+		return fmt.Errorf("no clipboard tool found (install wl-copy, xclip or xsel)")
+	}
+	defer tty.Close()
+	_, err = fmt.Fprintf(tty, "\033]52;c;%s\a", base64.StdEncoding.EncodeToString([]byte(text)))
+	return err
+}
@@ -0,0 +1,88 @@
+package cmd_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/cmd"
+)
+
+const createCommentResponse = `{
+	"data": {
+		"commentCreate": {
+			"success": true
+		}
+	}
+}`
+
+func TestIssueAction_AssignMe(t *testing.T) {
+	t.Parallel()
+
+	server, rec := newRecordingGraphQLServer(t, map[string]string{
+		"GetIssue":    getIssueWithIDsResponse,
+		"Viewer":      viewerResponse,
+		"UpdateIssue": updateIssueResponse,
+	})
+	opts, _, stderr := testOptionsWithBuffers(t, server)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "action", "assign-me", "ENG-42"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	updates := rec.calls("UpdateIssue")
+	if len(updates) != 1 {
+		t.Fatalf("expected 1 UpdateIssue call, got %d", len(updates))
+	}
+	if vars := string(updates[0].Variables); !strings.Contains(vars, `"assigneeId":"user-1"`) {
+		t.Errorf("UpdateIssue variables %s should assign user-1", vars)
+	}
+	if want := "Updated ENG-42: assignee → Fred Dubois"; !strings.Contains(stderr.String(), want) {
+		t.Errorf("stderr %q should contain %q", stderr.String(), want)
+	}
+}
+
+func TestIssueAction_Comment(t *testing.T) {
+	editor := filepath.Join(t.TempDir(), "editor")
+	if err := os.WriteFile(editor, []byte("#!/bin/sh\necho 'Looks good' > \"$1\"\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("VISUAL", editor)
+
+	server, rec := newRecordingGraphQLServer(t, map[string]string{
+		"GetIssue":      getIssueWithIDsResponse,
+		"CreateComment": createCommentResponse,
+	})
+	opts, _, stderr := testOptionsWithBuffers(t, server)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "action", "comment", "ENG-42", "ENG-42"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	comments := rec.calls("CreateComment")
+	if len(comments) != 2 {
+		t.Fatalf("expected 2 CreateComment calls, got %d", len(comments))
+	}
+	if vars := string(comments[0].Variables); !strings.Contains(vars, `"body":"Looks good"`) {
+		t.Errorf("CreateComment variables %s should hold the edited body", vars)
+	}
+	if !strings.Contains(stderr.String(), "comment posted") {
+		t.Errorf("stderr %q should confirm the comment", stderr.String())
+	}
+}
+
+func TestIssueAction_UnknownAction(t *testing.T) {
+	t.Parallel()
+
+	opts, _, _ := testOptionsWithBuffers(t, nil)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "action", "frobnicate", "ENG-42"})
+
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), `unknown action "frobnicate"`) {
+		t.Errorf("expected an unknown action error, got %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/spf13/cobra"
+
+	"github.com/duboisf/linear/internal/cache"
+)
+
+// currentBranchIssue returns the issue identifier referenced by the current
+// git branch (e.g. "fred/ais-42-fix-login" → "AIS-42"), or empty string when
+// not in a git repository or the branch doesn't reference an existing issue
+// of a known team. Callers then fall back to the issue picker, so lookup
+// errors are not reported. A note naming the detected issue is written to
+// stderr.
+func currentBranchIssue(ctx context.Context, opts Options, client graphql.Client) string {
+	if opts.GitRunner == nil {
+		return ""
+	}
+	branch, err := opts.GitRunner.CurrentBranch()
+	if err != nil {
+		return ""
+	}
+	identifier, err := branchIssue(ctx, client, opts.Cache, branch)
+	if err != nil || identifier == "" {
+		return ""
+	}
+	if exists, err := issueExists(ctx, client, identifier); err != nil || !exists {
+		return ""
+	}
+	fmt.Fprintf(opts.Stderr, "Using %s from branch %q\n", identifier, branch)
+	return identifier
+}
+
+// branchIssue returns the first identifier in branch whose prefix is a team
+// key of the workspace, so that names like "utf-8-ais-42" or "release-2024"
+// don't resolve to bogus issues. Returns empty string if there is none.
+func branchIssue(ctx context.Context, client graphql.Client, c *cache.Cache, branch string) (string, error) {
+	ids := branchIdentifiers(branch)
+	if len(ids) == 0 {
+		return "", nil
+	}
+	keys, err := teamKeys(ctx, client, c)
+	if err != nil {
+		return "", fmt.Errorf("listing teams: %w", err)
+	}
+	return teamIdentifier(ids, keys), nil
+}
+
+// newIssueCurrentCmd creates the "issue current" subcommand that prints the
+// issue referenced by the current git branch.
+func newIssueCurrentCmd(opts Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "current",
+		Short: "Print the issue identifier for the current git branch",
+		Long: `Print the identifier of the issue referenced by the current git branch,
+such as AIS-42 for "fred/ais-42-fix-login". Only identifiers starting with
+a team key of the workspace count. Commands that take an optional IDENTIFIER
+use this issue when none is given, or show the picker when it doesn't exist.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.GitRunner == nil {
+				return fmt.Errorf("git is not available")
+			}
+			branch, err := opts.GitRunner.CurrentBranch()
+			if err != nil {
+				return err
+			}
+			if branch == "" {
+				return fmt.Errorf("HEAD is detached; no current branch")
+			}
+			client, err := resolveClient(cmd, opts)
+			if err != nil {
+				return err
+			}
+			identifier, err := branchIssue(cmd.Context(), client, opts.Cache, branch)
+			if err != nil {
+				return err
+			}
+			if identifier == "" {
+				return fmt.Errorf("branch %q doesn't reference an issue", branch)
+			}
+			fmt.Fprintln(opts.Stdout, identifier)
+			return nil
+		},
+		ValidArgsFunction: cobra.NoFileCompletions,
+	}
+}
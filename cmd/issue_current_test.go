@@ -0,0 +1,158 @@
+package cmd_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/cmd"
+)
+
+func TestIssueCurrent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		branch  string
+		want    string
+		wantErr string
+	}{
+		{name: "prefixed branch", branch: "fred/eng-42-implement-feature-x", want: "ENG-42\n"},
+		{name: "bare identifier", branch: "ENG-7", want: "ENG-7\n"},
+		{name: "skips unknown team keys", branch: "utf-8-eng-42-encoding", want: "ENG-42\n"},
+		{name: "no identifier", branch: "main", wantErr: `branch "main" doesn't reference an issue`},
+		{name: "unknown team key", branch: "release-2024", wantErr: `branch "release-2024" doesn't reference an issue`},
+		{name: "detached", branch: "", wantErr: "HEAD is detached"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newMockGraphQLServer(t, map[string]string{
+				"ListTeams": listTeamsResponse,
+			})
+			opts, stdout, _ := testOptionsWithBuffers(t, server)
+			opts.GitRunner = &mockGitRunner{currentBranch: tt.branch}
+
+			root := cmd.NewRootCmd(opts)
+			root.SetArgs([]string{"issue", "current"})
+
+			err := root.Execute()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("issue current returned error: %v", err)
+			}
+			if stdout.String() != tt.want {
+				t.Errorf("output = %q, want %q", stdout.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestIssueGet_FromCurrentBranch(t *testing.T) {
+	t.Parallel()
+
+	// Only ListTeams and GetIssue are served: falling back to the picker
+	// would fail on the missing issue list query.
+	server := newMockGraphQLServer(t, map[string]string{
+		"ListTeams": listTeamsResponse,
+		"GetIssue":  getIssueResponse,
+	})
+	opts, stdout, stderr := testOptionsWithBuffers(t, server)
+	opts.GitRunner = &mockGitRunner{currentBranch: "fred/eng-42-implement-feature-x"}
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "get"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue get returned error: %v", err)
+	}
+	if !strings.Contains(stdout.String(), "ENG-42") {
+		t.Errorf("output = %q, want ENG-42 details", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), `Using ENG-42 from branch "fred/eng-42-implement-feature-x"`) {
+		t.Errorf("stderr = %q, want detection note", stderr.String())
+	}
+}
+
+func TestIssueWorktree_FromCurrentBranch(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"ListTeams": listTeamsResponse,
+		"GetIssue":  getIssueResponse,
+	})
+	mock := &mockGitWorktreeCreator{repoRoot: "/tmp/test-repo"}
+	opts, _, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+	opts.GitRunner = &mockGitRunner{currentBranch: "eng-42"}
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue worktree returned error: %v", err)
+	}
+	if len(mock.createCalls) != 1 {
+		t.Fatalf("create calls = %+v, want 1", mock.createCalls)
+	}
+}
+
+func TestIssueGet_NoIssueBranchFallsBackToPicker(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"GetIssue": getIssueResponse,
+	})
+	opts, _, stderr := testOptionsWithBuffers(t, server)
+	opts.GitRunner = &mockGitRunner{currentBranch: "main"}
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "get"})
+
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "listing issues") {
+		t.Fatalf("error = %v, want the picker's issue listing to be attempted", err)
+	}
+	if strings.Contains(stderr.String(), "Using ") {
+		t.Errorf("stderr = %q, no issue should be detected", stderr.String())
+	}
+}
+
+func TestIssueGet_MissingBranchIssueFallsBackToPicker(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		branch string
+	}{
+		{name: "unknown team key", branch: "fred/utf-8-fix"},
+		{name: "issue doesn't exist", branch: "eng-999-stale"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newIssueLookupServer(t, "ENG-42")
+			opts, _, stderr := testOptionsWithBuffers(t, server)
+			opts.GitRunner = &mockGitRunner{currentBranch: tt.branch}
+
+			root := cmd.NewRootCmd(opts)
+			root.SetArgs([]string{"issue", "get"})
+
+			err := root.Execute()
+			if err == nil || !strings.Contains(err.Error(), "listing issues") {
+				t.Fatalf("error = %v, want the picker's issue listing to be attempted", err)
+			}
+			if strings.Contains(stderr.String(), "Using ") {
+				t.Errorf("stderr = %q, no issue should be detected", stderr.String())
+			}
+		})
+	}
+}
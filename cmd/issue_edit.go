@@ -36,7 +36,7 @@ func newIssueEditCmd(opts Options) *cobra.Command {
 			var identifier string
 			if len(args) > 0 {
 				identifier = args[0]
-			} else {
+			} else if identifier = currentBranchIssue(cmd.Context(), opts, client); identifier == "" {
 				var issues []issueForCompletion
 				if user != "" {
 					issues, err = fetchUserIssues(cmd.Context(), client, user)
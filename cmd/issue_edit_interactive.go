@@ -28,12 +28,16 @@ type editableField struct {
 
 // newIssueEditInteractiveCmd creates the hidden "issue edit-interactive"
 // subcommand used by the fzf ctrl-e binding. It launches nested fzf pickers
-// for field selection and value selection, then updates the issue via the API.
+// for field selection and value selection, then updates the issues via the
+// API. With several identifiers (multi-select), the chosen value is applied
+// to every issue.
 func newIssueEditInteractiveCmd(opts Options) *cobra.Command {
+	var fieldName string
+
 	cmd := &cobra.Command{
-		Use:    "edit-interactive [IDENTIFIER]",
-		Short:  "Interactively edit an issue (used by fzf binding)",
-		Args:   cobra.ExactArgs(1),
+		Use:    "edit-interactive IDENTIFIER...",
+		Short:  "Interactively edit issues (used by fzf binding)",
+		Args:   cobra.MinimumNArgs(1),
 		Hidden: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := resolveClient(cmd, opts)
@@ -41,23 +45,36 @@ func newIssueEditInteractiveCmd(opts Options) *cobra.Command {
 				return err
 			}
 
-			identifier := args[0]
-			resp, err := api.GetIssue(cmd.Context(), client, identifier)
+			issues, err := getIssues(cmd.Context(), client, args)
 			if err != nil {
-				return fmt.Errorf("getting issue: %w", err)
-			}
-			if resp.Issue == nil {
-				return fmt.Errorf("issue %s not found", identifier)
+				return err
 			}
 
-			issue := resp.Issue
-
-			field, err := fzfPickField(issue)
-			if err != nil {
-				return err
+			header := fmt.Sprintf("Edit %s: pick a field", issues[0].Identifier)
+			fields := buildEditableFields(issues[0])
+			if len(issues) > 1 {
+				header = fmt.Sprintf("Edit %d issues: pick a field", len(issues))
+				fields = buildBulkEditableFields(issues)
 			}
-			if field == "" {
-				return nil // user cancelled
+			field := fieldName
+			if field != "" {
+				i := slices.IndexFunc(fields, func(f editableField) bool { return strings.EqualFold(f.Name, field) })
+				if i < 0 {
+					names := make([]string, len(fields))
+					for j, f := range fields {
+						names[j] = f.Name
+					}
+					return fmt.Errorf("field %q can't be edited here (valid: %s)", field, strings.Join(names, ", "))
+				}
+				field = fields[i].Name
+			} else {
+				field, err = fzfPickField(header, fields)
+				if err != nil {
+					return err
+				}
+				if field == "" {
+					return nil // user cancelled
+				}
 			}
 
 			timeNow := opts.TimeNow
@@ -65,7 +82,7 @@ func newIssueEditInteractiveCmd(opts Options) *cobra.Command {
 				timeNow = time.Now
 			}
 
-			result, err := applyFieldEdit(cmd.Context(), client, opts.Cache, timeNow, issue, field)
+			result, err := applyFieldEdit(cmd.Context(), client, opts.Cache, timeNow, issues, field)
 			if err != nil {
 				return err
 			}
@@ -75,12 +92,14 @@ func newIssueEditInteractiveCmd(opts Options) *cobra.Command {
 
 			// Refresh cache so the fzf preview shows updated data.
 			if opts.Cache != nil {
-				refreshIssueCache(cmd.Context(), client, opts.Cache, identifier)
+				for _, identifier := range args {
+					refreshIssueCache(cmd.Context(), client, opts.Cache, identifier)
+				}
 			}
 
 			colorEnabled := format.ColorEnabled(os.Stderr)
-			issueID := format.Colorize(colorEnabled, format.Bold, identifier)
-			fmt.Fprintf(os.Stderr, "Updated %s: %s\n", issueID, result)
+			issueIDs := format.Colorize(colorEnabled, format.Bold, strings.Join(args, ", "))
+			fmt.Fprintf(os.Stderr, "Updated %s: %s\n", issueIDs, result)
 
 			return nil
 		},
@@ -88,14 +107,31 @@ func newIssueEditInteractiveCmd(opts Options) *cobra.Command {
 			return nil, cobra.ShellCompDirectiveNoFileComp
 		},
 	}
+
+	cmd.Flags().StringVar(&fieldName, "field", "", "Field to edit, skipping the field picker (e.g. Status)")
+
 	return cmd
 }
 
-// fzfPickField presents a field picker for the given issue and returns the
-// selected field name. Returns empty string if the user cancelled.
-func fzfPickField(issue *api.GetIssueIssue) (string, error) {
-	fields := buildEditableFields(issue)
+// getIssues fetches the issues with the given identifiers, in order.
+func getIssues(ctx context.Context, client graphql.Client, identifiers []string) ([]*api.GetIssueIssue, error) {
+	issues := make([]*api.GetIssueIssue, len(identifiers))
+	for i, identifier := range identifiers {
+		resp, err := api.GetIssue(ctx, client, identifier)
+		if err != nil {
+			return nil, fmt.Errorf("getting issue: %w", err)
+		}
+		if resp.Issue == nil {
+			return nil, fmt.Errorf("issue %s not found", identifier)
+		}
+		issues[i] = resp.Issue
+	}
+	return issues, nil
+}
 
+// fzfPickField presents a field picker and returns the selected field name.
+// Returns empty string if the user cancelled.
+func fzfPickField(header string, fields []editableField) (string, error) {
 	// Compute max name width for alignment.
 	maxName := 0
 	for _, f := range fields {
@@ -109,13 +145,21 @@ func fzfPickField(issue *api.GetIssueIssue) (string, error) {
 		lines[i] = fmt.Sprintf("%-*s  %s", maxName, f.Name, f.Current)
 	}
 
+	if useBuiltinTUI() {
+		picked, err := tuiPickLines(header, lines, false, false)
+		if err != nil || len(picked) == 0 {
+			return "", err
+		}
+		return strings.Fields(picked[0])[0], nil
+	}
+
 	input := strings.Join(lines, "\n") + "\n"
 
 	cmd := exec.Command("fzf",
 		"--ansi",
 		"--no-sort",
 		"--layout=reverse",
-		"--header", fmt.Sprintf("Edit %s: pick a field", issue.Identifier),
+		"--header", header,
 		"--header-first",
 	)
 	cmd.Stdin = strings.NewReader(input)
@@ -199,6 +243,69 @@ func buildEditableFields(issue *api.GetIssueIssue) []editableField {
 	return fields
 }
 
+// _singleIssueFields are editable only one issue at a time.
+var _singleIssueFields = []string{"Title", "Description"}
+
+// buildBulkEditableFields returns the fields that can be edited on all of
+// issues at once. The current value is shown when the issues share it.
+func buildBulkEditableFields(issues []*api.GetIssueIssue) []editableField {
+	var fields []editableField
+	for _, issue := range issues {
+		for _, f := range buildEditableFields(issue) {
+			if slices.Contains(_singleIssueFields, f.Name) {
+				continue
+			}
+			i := slices.IndexFunc(fields, func(e editableField) bool { return e.Name == f.Name })
+			switch {
+			case i < 0:
+				fields = append(fields, f)
+			case fields[i].Current != f.Current:
+				fields[i].Current = "(mixed)"
+			}
+		}
+	}
+	// Labels-Remove only exists for issues with labels: keep it next to
+	// Labels-Add, showing the same (possibly mixed) labels.
+	i := slices.IndexFunc(fields, func(e editableField) bool { return e.Name == "Labels-Remove" })
+	j := slices.IndexFunc(fields, func(e editableField) bool { return e.Name == "Labels-Add" })
+	if i >= 0 && j >= 0 {
+		remove := editableField{Name: "Labels-Remove", Current: fields[j].Current}
+		fields = slices.Delete(fields, i, i+1)
+		if i < j {
+			j--
+		}
+		fields = slices.Insert(fields, j+1, remove)
+	}
+	return fields
+}
+
+// sharedValue returns value(issue) when it is the same for all issues, or
+// empty string otherwise.
+func sharedValue(issues []*api.GetIssueIssue, value func(*api.GetIssueIssue) string) string {
+	v := value(issues[0])
+	for _, issue := range issues[1:] {
+		if value(issue) != v {
+			return ""
+		}
+	}
+	return v
+}
+
+// updateIssues applies input to each issue. action names the update in
+// errors, e.g. "status update".
+func updateIssues(ctx context.Context, client graphql.Client, issues []*api.GetIssueIssue, input *api.IssueUpdateInput, action string) error {
+	for _, issue := range issues {
+		resp, err := api.UpdateIssue(ctx, client, issue.Id, input)
+		if err != nil {
+			return fmt.Errorf("%s of %s: %w", action, issue.Identifier, err)
+		}
+		if resp.IssueUpdate == nil || !resp.IssueUpdate.Success {
+			return fmt.Errorf("%s of %s was not successful", action, issue.Identifier)
+		}
+	}
+	return nil
+}
+
 // truncate shortens s to maxLen runes, appending "..." if truncated.
 func truncate(s string, maxLen int) string {
 	runes := []rune(s)
@@ -209,40 +316,53 @@ func truncate(s string, maxLen int) string {
 }
 
 // applyFieldEdit presents the appropriate value picker for the given field,
-// performs the API update, and returns a human-readable result string.
-// Returns empty string if the user cancelled.
-func applyFieldEdit(ctx context.Context, client graphql.Client, c *cache.Cache, timeNow func() time.Time, issue *api.GetIssueIssue, field string) (string, error) {
+// performs the API update on every issue, and returns a human-readable
+// result string. Returns empty string if the user cancelled.
+func applyFieldEdit(ctx context.Context, client graphql.Client, c *cache.Cache, timeNow func() time.Time, issues []*api.GetIssueIssue, field string) (string, error) {
+	if len(issues) > 1 && slices.Contains(_singleIssueFields, field) {
+		return "", fmt.Errorf("%s can only be edited one issue at a time", field)
+	}
 	switch field {
 	case "Status":
-		return editStatus(ctx, client, issue)
+		return editStatus(ctx, client, issues)
 	case "Priority":
-		return editPriority(ctx, client, issue)
+		return editPriority(ctx, client, issues)
 	case "Cycle":
-		return editCycle(ctx, client, c, timeNow, issue)
+		return editCycle(ctx, client, c, timeNow, issues)
 	case "Labels-Add":
-		return editLabelsAdd(ctx, client, c, issue)
+		return editLabelsAdd(ctx, client, c, issues)
 	case "Labels-Remove":
-		return editLabelsRemove(ctx, client, issue)
+		return editLabelsRemove(ctx, client, issues)
 	case "Assignee":
-		return editAssignee(ctx, client, c, issue)
+		return editAssignee(ctx, client, c, issues)
 	case "Project":
-		return editProject(ctx, client, issue)
+		return editProject(ctx, client, issues)
 	case "Title":
-		return editTitle(ctx, client, issue)
+		return editTitle(ctx, client, issues[0])
 	case "Description":
-		return editDescription(ctx, client, issue)
+		return editDescription(ctx, client, issues[0])
 	default:
 		return "", fmt.Errorf("unknown field %q", field)
 	}
 }
 
-// editStatus presents a workflow state picker and updates the issue.
-func editStatus(ctx context.Context, client graphql.Client, issue *api.GetIssueIssue) (string, error) {
-	if issue.Team == nil {
+// editStatus presents a workflow state picker and updates the issues, which
+// must belong to the same team.
+func editStatus(ctx context.Context, client graphql.Client, issues []*api.GetIssueIssue) (string, error) {
+	teamID := sharedValue(issues, func(i *api.GetIssueIssue) string {
+		if i.Team == nil {
+			return ""
+		}
+		return i.Team.Id
+	})
+	if teamID == "" {
+		if len(issues) > 1 {
+			return "", fmt.Errorf("the selected issues must belong to the same team to change their status")
+		}
 		return "", fmt.Errorf("issue has no team")
 	}
 
-	resp, err := api.ListWorkflowStates(ctx, client, 50, issue.Team.Id)
+	resp, err := api.ListWorkflowStates(ctx, client, 50, teamID)
 	if err != nil {
 		return "", fmt.Errorf("listing workflow states: %w", err)
 	}
@@ -263,10 +383,12 @@ func editStatus(ctx context.Context, client graphql.Client, issue *api.GetIssueI
 	}
 
 	var lines []string
-	currentStateID := ""
-	if issue.State != nil {
-		currentStateID = issue.State.Id
-	}
+	currentStateID := sharedValue(issues, func(i *api.GetIssueIssue) string {
+		if i.State == nil {
+			return ""
+		}
+		return i.State.Id
+	})
 
 	for _, stateType := range typeOrder {
 		states := grouped[stateType]
@@ -293,12 +415,8 @@ func editStatus(ctx context.Context, client graphql.Client, issue *api.GetIssueI
 	stateID, _, _ := strings.Cut(selected, "\t")
 
 	input := &api.IssueUpdateInput{StateId: &stateID}
-	updateResp, err := api.UpdateIssue(ctx, client, issue.Id, input)
-	if err != nil {
-		return "", fmt.Errorf("updating status: %w", err)
-	}
-	if updateResp.IssueUpdate == nil || !updateResp.IssueUpdate.Success {
-		return "", fmt.Errorf("status update was not successful")
+	if err := updateIssues(ctx, client, issues, input, "status update"); err != nil {
+		return "", err
 	}
 
 	// Find the name of the selected state.
@@ -312,8 +430,8 @@ func editStatus(ctx context.Context, client graphql.Client, issue *api.GetIssueI
 	return fmt.Sprintf("status → %s", stateName), nil
 }
 
-// editPriority presents a priority picker and updates the issue.
-func editPriority(ctx context.Context, client graphql.Client, issue *api.GetIssueIssue) (string, error) {
+// editPriority presents a priority picker and updates the issues.
+func editPriority(ctx context.Context, client graphql.Client, issues []*api.GetIssueIssue) (string, error) {
 	priorities := []struct {
 		Value int
 		Label string
@@ -325,12 +443,14 @@ func editPriority(ctx context.Context, client graphql.Client, issue *api.GetIssu
 		{0, "No priority"},
 	}
 
-	currentPriority := int(issue.Priority)
+	currentPriority := sharedValue(issues, func(i *api.GetIssueIssue) string {
+		return strconv.Itoa(int(i.Priority))
+	})
 
 	var lines []string
 	for _, p := range priorities {
 		marker := "  "
-		if p.Value == currentPriority {
+		if strconv.Itoa(p.Value) == currentPriority {
 			marker = "* "
 		}
 		color := format.PriorityColor(float64(p.Value))
@@ -347,20 +467,16 @@ func editPriority(ctx context.Context, client graphql.Client, issue *api.GetIssu
 	val, _ := strconv.Atoi(valStr)
 
 	input := &api.IssueUpdateInput{Priority: &val}
-	updateResp, err := api.UpdateIssue(ctx, client, issue.Id, input)
-	if err != nil {
-		return "", fmt.Errorf("updating priority: %w", err)
-	}
-	if updateResp.IssueUpdate == nil || !updateResp.IssueUpdate.Success {
-		return "", fmt.Errorf("priority update was not successful")
+	if err := updateIssues(ctx, client, issues, input, "priority update"); err != nil {
+		return "", err
 	}
 
 	label := format.PriorityLabel(float64(val))
 	return fmt.Sprintf("priority → %s", label), nil
 }
 
-// editCycle presents a cycle picker and updates the issue.
-func editCycle(ctx context.Context, client graphql.Client, c *cache.Cache, timeNow func() time.Time, issue *api.GetIssueIssue) (string, error) {
+// editCycle presents a cycle picker and updates the issues.
+func editCycle(ctx context.Context, client graphql.Client, c *cache.Cache, timeNow func() time.Time, issues []*api.GetIssueIssue) (string, error) {
 	resp, err := listCyclesCached(ctx, client, c, timeNow)
 	if err != nil {
 		return "", fmt.Errorf("listing cycles: %w", err)
@@ -369,10 +485,12 @@ func editCycle(ctx context.Context, client graphql.Client, c *cache.Cache, timeN
 		return "", fmt.Errorf("no cycles found")
 	}
 
-	currentCycleID := ""
-	if issue.Cycle != nil {
-		currentCycleID = issue.Cycle.Id
-	}
+	currentCycleID := sharedValue(issues, func(i *api.GetIssueIssue) string {
+		if i.Cycle == nil {
+			return "none"
+		}
+		return i.Cycle.Id
+	})
 
 	var lines []string
 	for _, c := range resp.Cycles.Nodes {
@@ -412,7 +530,7 @@ func editCycle(ctx context.Context, client graphql.Client, c *cache.Cache, timeN
 
 	// Add "None" option to remove cycle.
 	noneMarker := "  "
-	if currentCycleID == "" {
+	if currentCycleID == "none" {
 		noneMarker = "* "
 	}
 	lines = append(lines, fmt.Sprintf("none\t%s%s", noneMarker, format.Colorize(true, format.Gray, "No cycle")))
@@ -428,23 +546,15 @@ func editCycle(ctx context.Context, client graphql.Client, c *cache.Cache, timeN
 		// Remove cycle — set to empty string to unset.
 		emptyStr := ""
 		input := &api.IssueUpdateInput{CycleId: &emptyStr}
-		updateResp, err := api.UpdateIssue(ctx, client, issue.Id, input)
-		if err != nil {
-			return "", fmt.Errorf("removing cycle: %w", err)
-		}
-		if updateResp.IssueUpdate == nil || !updateResp.IssueUpdate.Success {
-			return "", fmt.Errorf("cycle removal was not successful")
+		if err := updateIssues(ctx, client, issues, input, "cycle removal"); err != nil {
+			return "", err
 		}
 		return "cycle → None", nil
 	}
 
 	input := &api.IssueUpdateInput{CycleId: &cycleID}
-	updateResp, err := api.UpdateIssue(ctx, client, issue.Id, input)
-	if err != nil {
-		return "", fmt.Errorf("updating cycle: %w", err)
-	}
-	if updateResp.IssueUpdate == nil || !updateResp.IssueUpdate.Success {
-		return "", fmt.Errorf("cycle update was not successful")
+	if err := updateIssues(ctx, client, issues, input, "cycle update"); err != nil {
+		return "", err
 	}
 
 	// Find cycle name for display.
@@ -461,8 +571,9 @@ func editCycle(ctx context.Context, client graphql.Client, c *cache.Cache, timeN
 	return fmt.Sprintf("cycle → %s", cycleName), nil
 }
 
-// editLabelsAdd presents labels not on the issue for multi-selection and adds them.
-func editLabelsAdd(ctx context.Context, client graphql.Client, c *cache.Cache, issue *api.GetIssueIssue) (string, error) {
+// editLabelsAdd presents labels not already on every issue for
+// multi-selection and adds them.
+func editLabelsAdd(ctx context.Context, client graphql.Client, c *cache.Cache, issues []*api.GetIssueIssue) (string, error) {
 	resp, err := labelsCached(ctx, client, c)
 	if err != nil {
 		return "", fmt.Errorf("listing labels: %w", err)
@@ -471,24 +582,26 @@ func editLabelsAdd(ctx context.Context, client graphql.Client, c *cache.Cache, i
 		return "", fmt.Errorf("no labels found")
 	}
 
-	// Build set of current label IDs.
-	currentIDs := make(map[string]bool)
-	if issue.Labels != nil {
-		for _, l := range issue.Labels.Nodes {
-			currentIDs[l.Id] = true
+	// Count how many of the issues have each label.
+	counts := make(map[string]int)
+	for _, issue := range issues {
+		if issue.Labels != nil {
+			for _, l := range issue.Labels.Nodes {
+				counts[l.Id]++
+			}
 		}
 	}
 
 	var lines []string
 	for _, l := range resp.IssueLabels.Nodes {
-		if currentIDs[l.Id] {
-			continue // skip labels already on the issue
+		if counts[l.Id] == len(issues) {
+			continue // skip labels already on every issue
 		}
 		lines = append(lines, fmt.Sprintf("%s\t%s", l.Id, l.Name))
 	}
 
 	if len(lines) == 0 {
-		return "", fmt.Errorf("all labels are already assigned to the issue")
+		return "", fmt.Errorf("all labels are already assigned")
 	}
 
 	selected, err := fzfPickMultiValue("Add labels (TAB to select, ENTER to confirm)", lines, true)
@@ -505,26 +618,29 @@ func editLabelsAdd(ctx context.Context, client graphql.Client, c *cache.Cache, i
 	}
 
 	input := &api.IssueUpdateInput{AddedLabelIds: addIDs}
-	updateResp, err := api.UpdateIssue(ctx, client, issue.Id, input)
-	if err != nil {
-		return "", fmt.Errorf("adding labels: %w", err)
-	}
-	if updateResp.IssueUpdate == nil || !updateResp.IssueUpdate.Success {
-		return "", fmt.Errorf("label add was not successful")
+	if err := updateIssues(ctx, client, issues, input, "label add"); err != nil {
+		return "", err
 	}
 
 	return fmt.Sprintf("labels added: %s", strings.Join(addNames, ", ")), nil
 }
 
-// editLabelsRemove presents labels on the issue for multi-selection and removes them.
-func editLabelsRemove(ctx context.Context, client graphql.Client, issue *api.GetIssueIssue) (string, error) {
-	if issue.Labels == nil || len(issue.Labels.Nodes) == 0 {
-		return "", fmt.Errorf("issue has no labels to remove")
-	}
-
+// editLabelsRemove presents the labels on any of the issues for
+// multi-selection and removes them.
+func editLabelsRemove(ctx context.Context, client graphql.Client, issues []*api.GetIssueIssue) (string, error) {
 	var lines []string
-	for _, l := range issue.Labels.Nodes {
-		lines = append(lines, fmt.Sprintf("%s\t%s", l.Id, l.Name))
+	for _, issue := range issues {
+		if issue.Labels == nil {
+			continue
+		}
+		for _, l := range issue.Labels.Nodes {
+			if line := fmt.Sprintf("%s\t%s", l.Id, l.Name); !slices.Contains(lines, line) {
+				lines = append(lines, line)
+			}
+		}
+	}
+	if len(lines) == 0 {
+		return "", fmt.Errorf("no labels to remove")
 	}
 
 	selected, err := fzfPickMultiValue("Remove labels (TAB to select, ENTER to confirm)", lines, true)
@@ -541,19 +657,15 @@ func editLabelsRemove(ctx context.Context, client graphql.Client, issue *api.Get
 	}
 
 	input := &api.IssueUpdateInput{RemovedLabelIds: removeIDs}
-	updateResp, err := api.UpdateIssue(ctx, client, issue.Id, input)
-	if err != nil {
-		return "", fmt.Errorf("removing labels: %w", err)
-	}
-	if updateResp.IssueUpdate == nil || !updateResp.IssueUpdate.Success {
-		return "", fmt.Errorf("label removal was not successful")
+	if err := updateIssues(ctx, client, issues, input, "label removal"); err != nil {
+		return "", err
 	}
 
 	return fmt.Sprintf("labels removed: %s", strings.Join(removeNames, ", ")), nil
 }
 
-// editAssignee presents a user picker and updates the issue.
-func editAssignee(ctx context.Context, client graphql.Client, c *cache.Cache, issue *api.GetIssueIssue) (string, error) {
+// editAssignee presents a user picker and updates the issues.
+func editAssignee(ctx context.Context, client graphql.Client, c *cache.Cache, issues []*api.GetIssueIssue) (string, error) {
 	resp, err := usersForCompletionCached(ctx, client, c)
 	if err != nil {
 		return "", fmt.Errorf("listing users: %w", err)
@@ -562,15 +674,17 @@ func editAssignee(ctx context.Context, client graphql.Client, c *cache.Cache, is
 		return "", fmt.Errorf("no users found")
 	}
 
-	currentAssigneeID := ""
-	if issue.Assignee != nil {
-		currentAssigneeID = issue.Assignee.Id
-	}
+	currentAssigneeID := sharedValue(issues, func(i *api.GetIssueIssue) string {
+		if i.Assignee == nil {
+			return "none"
+		}
+		return i.Assignee.Id
+	})
 
 	var lines []string
 	// Add "Unassigned" option.
 	unassignMarker := "  "
-	if currentAssigneeID == "" {
+	if currentAssigneeID == "none" {
 		unassignMarker = "* "
 	}
 	lines = append(lines, fmt.Sprintf("none\t%s%s", unassignMarker, format.Colorize(true, format.Gray, "Unassigned")))
@@ -594,23 +708,15 @@ func editAssignee(ctx context.Context, client graphql.Client, c *cache.Cache, is
 		// Unassign.
 		emptyStr := ""
 		input := &api.IssueUpdateInput{AssigneeId: &emptyStr}
-		updateResp, err := api.UpdateIssue(ctx, client, issue.Id, input)
-		if err != nil {
-			return "", fmt.Errorf("unassigning: %w", err)
-		}
-		if updateResp.IssueUpdate == nil || !updateResp.IssueUpdate.Success {
-			return "", fmt.Errorf("unassign was not successful")
+		if err := updateIssues(ctx, client, issues, input, "unassign"); err != nil {
+			return "", err
 		}
 		return "assignee → Unassigned", nil
 	}
 
 	input := &api.IssueUpdateInput{AssigneeId: &userID}
-	updateResp, err := api.UpdateIssue(ctx, client, issue.Id, input)
-	if err != nil {
-		return "", fmt.Errorf("updating assignee: %w", err)
-	}
-	if updateResp.IssueUpdate == nil || !updateResp.IssueUpdate.Success {
-		return "", fmt.Errorf("assignee update was not successful")
+	if err := updateIssues(ctx, client, issues, input, "assignee update"); err != nil {
+		return "", err
 	}
 
 	// Find user name for display.
@@ -624,8 +730,8 @@ func editAssignee(ctx context.Context, client graphql.Client, c *cache.Cache, is
 	return fmt.Sprintf("assignee → %s", userName), nil
 }
 
-// editProject presents a project picker and updates the issue.
-func editProject(ctx context.Context, client graphql.Client, issue *api.GetIssueIssue) (string, error) {
+// editProject presents a project picker and updates the issues.
+func editProject(ctx context.Context, client graphql.Client, issues []*api.GetIssueIssue) (string, error) {
 	resp, err := api.ListProjects(ctx, client, 50)
 	if err != nil {
 		return "", fmt.Errorf("listing projects: %w", err)
@@ -634,15 +740,17 @@ func editProject(ctx context.Context, client graphql.Client, issue *api.GetIssue
 		return "", fmt.Errorf("no projects found")
 	}
 
-	currentProjectID := ""
-	if issue.Project != nil {
-		currentProjectID = issue.Project.Id
-	}
+	currentProjectID := sharedValue(issues, func(i *api.GetIssueIssue) string {
+		if i.Project == nil {
+			return "none"
+		}
+		return i.Project.Id
+	})
 
 	var lines []string
 	// Add "None" option.
 	noneMarker := "  "
-	if currentProjectID == "" {
+	if currentProjectID == "none" {
 		noneMarker = "* "
 	}
 	lines = append(lines, fmt.Sprintf("none\t%s%s", noneMarker, format.Colorize(true, format.Gray, "No project")))
@@ -665,23 +773,15 @@ func editProject(ctx context.Context, client graphql.Client, issue *api.GetIssue
 	if projectID == "none" {
 		emptyStr := ""
 		input := &api.IssueUpdateInput{ProjectId: &emptyStr}
-		updateResp, err := api.UpdateIssue(ctx, client, issue.Id, input)
-		if err != nil {
-			return "", fmt.Errorf("removing project: %w", err)
-		}
-		if updateResp.IssueUpdate == nil || !updateResp.IssueUpdate.Success {
-			return "", fmt.Errorf("project removal was not successful")
+		if err := updateIssues(ctx, client, issues, input, "project removal"); err != nil {
+			return "", err
 		}
 		return "project → None", nil
 	}
 
 	input := &api.IssueUpdateInput{ProjectId: &projectID}
-	updateResp, err := api.UpdateIssue(ctx, client, issue.Id, input)
-	if err != nil {
-		return "", fmt.Errorf("updating project: %w", err)
-	}
-	if updateResp.IssueUpdate == nil || !updateResp.IssueUpdate.Success {
-		return "", fmt.Errorf("project update was not successful")
+	if err := updateIssues(ctx, client, issues, input, "project update"); err != nil {
+		return "", err
 	}
 
 	projectName := projectID
@@ -748,6 +848,13 @@ func editDescription(ctx context.Context, client graphql.Client, issue *api.GetI
 // with the ID in the first field (hidden via --with-nth=2..). Returns the
 // full selected line or empty string if cancelled.
 func fzfPickValue(header string, lines []string, hideIDs bool) (string, error) {
+	if useBuiltinTUI() {
+		picked, err := tuiPickLines(header, lines, hideIDs, false)
+		if err != nil || len(picked) == 0 {
+			return "", err
+		}
+		return picked[0], nil
+	}
 	input := strings.Join(lines, "\n") + "\n"
 
 	args := []string{
@@ -782,6 +889,9 @@ func fzfPickValue(header string, lines []string, hideIDs bool) (string, error) {
 // fzfPickMultiValue runs fzf for multi-value selection. Lines are tab-delimited
 // with the ID in the first field. Returns the selected lines or nil if cancelled.
 func fzfPickMultiValue(header string, lines []string, hideIDs bool) ([]string, error) {
+	if useBuiltinTUI() {
+		return tuiPickLines(header, lines, hideIDs, true)
+	}
 	input := strings.Join(lines, "\n") + "\n"
 
 	args := []string{
@@ -19,6 +19,20 @@ type TestGetIssueIssue struct {
 
 // BuildEditableFields is an exported wrapper for testing.
 func BuildEditableFields(t *TestGetIssueIssue) []editableField {
+	return buildEditableFields(t.issue())
+}
+
+// BuildBulkEditableFields is an exported wrapper for testing.
+func BuildBulkEditableFields(ts ...*TestGetIssueIssue) []editableField {
+	issues := make([]*api.GetIssueIssue, len(ts))
+	for i, t := range ts {
+		issues[i] = t.issue()
+	}
+	return buildBulkEditableFields(issues)
+}
+
+// issue builds the api.GetIssueIssue described by t.
+func (t *TestGetIssueIssue) issue() *api.GetIssueIssue {
 	issue := &api.GetIssueIssue{
 		Title:    t.Title,
 		Priority: t.Priority,
@@ -49,7 +63,7 @@ func BuildEditableFields(t *TestGetIssueIssue) []editableField {
 		issue.Labels = &api.GetIssueIssueLabelsIssueLabelConnection{Nodes: nodes}
 	}
 
-	return buildEditableFields(issue)
+	return issue
 }
 
 // Truncate is an exported wrapper for testing.
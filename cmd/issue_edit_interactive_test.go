@@ -1,6 +1,7 @@
 package cmd_test
 
 import (
+	"slices"
 	"strings"
 	"testing"
 
@@ -123,7 +124,7 @@ func TestEditInteractive_MissingIdentifier(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error when no identifier provided")
 	}
-	if !strings.Contains(err.Error(), "accepts 1 arg") {
+	if !strings.Contains(err.Error(), "requires at least 1 arg") {
 		t.Errorf("error %q should mention args requirement", err.Error())
 	}
 }
@@ -257,6 +258,36 @@ func fieldNames(fields []cmd.EditableField) []string {
 	return names
 }
 
+func TestBuildBulkEditableFields(t *testing.T) {
+	t.Parallel()
+
+	fields := cmd.BuildBulkEditableFields(
+		&cmd.TestGetIssueIssue{StateName: "Todo", Priority: 2, Title: "First"},
+		&cmd.TestGetIssueIssue{StateName: "In Progress", Priority: 2, Labels: []string{"bug"}, Title: "Second"},
+	)
+
+	// Title and Description only make sense for a single issue; Labels-Remove
+	// is offered because one of the issues has labels.
+	want := []string{"Status", "Priority", "Cycle", "Labels-Add", "Labels-Remove", "Assignee", "Project"}
+	if got := fieldNames(fields); !slices.Equal(got, want) {
+		t.Fatalf("fields = %v, want %v", got, want)
+	}
+	current := make(map[string]string)
+	for _, f := range fields {
+		current[f.Name] = f.Current
+	}
+	for name, want := range map[string]string{
+		"Status":        "(mixed)",
+		"Priority":      "High",
+		"Labels-Remove": "(mixed)",
+		"Assignee":      "Unassigned",
+	} {
+		if current[name] != want {
+			t.Errorf("%s current = %q, want %q", name, current[name], want)
+		}
+	}
+}
+
 func TestTruncate(t *testing.T) {
 	t.Parallel()
 
@@ -304,3 +335,19 @@ func TestEditorCmd(t *testing.T) {
 		t.Errorf("EditorCmd() = %q, want %q", got, "code --wait")
 	}
 }
+
+func TestEditInteractive_UnknownField(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"GetIssue": getIssueWithIDsResponse,
+	})
+	opts, _, _ := testOptionsWithBuffers(t, server)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "edit-interactive", "--field", "Estimate", "ENG-42"})
+
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), `field "Estimate" can't be edited here (valid: Status, Priority`) {
+		t.Errorf("expected an unknown field error, got %v", err)
+	}
+}
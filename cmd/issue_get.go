@@ -2,12 +2,14 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/duboisf/linear/internal/api"
 	"github.com/duboisf/linear/internal/format"
+	"github.com/duboisf/linear/internal/prompt"
 )
 
 // validOutputFormats lists the accepted values for --output.
@@ -19,6 +21,7 @@ func newIssueGetCmd(opts Options) *cobra.Command {
 	var (
 		outputFormat string
 		user         string
+		of           outputFlags
 	)
 
 	cmd := &cobra.Command{
@@ -27,6 +30,14 @@ func newIssueGetCmd(opts Options) *cobra.Command {
 		Short:   "Get details for a specific issue",
 		Args:    cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := of.validate(); err != nil {
+				return err
+			}
+			outputFormat, err := of.resolveFormat(cmd, outputFormat)
+			if err != nil {
+				return err
+			}
+
 			client, err := resolveClient(cmd, opts)
 			if err != nil {
 				return err
@@ -35,7 +46,7 @@ func newIssueGetCmd(opts Options) *cobra.Command {
 			var identifier string
 			if len(args) > 0 {
 				identifier = args[0]
-			} else {
+			} else if identifier = currentBranchIssue(cmd.Context(), opts, client); identifier == "" {
 				var issues []issueForCompletion
 				if strings.EqualFold(user, "all") {
 					issues, err = fetchAllIssues(cmd.Context(), client)
@@ -65,6 +76,21 @@ func newIssueGetCmd(opts Options) *cobra.Command {
 				return fmt.Errorf("issue %s not found", identifier)
 			}
 
+			color := format.ColorEnabled(cmd.OutOrStdout())
+			if of.template != "" {
+				return of.writeTemplate(opts.Stdout, color, prompt.NewIssueData(resp.Issue))
+			}
+			if of.jq != "" {
+				return of.writeJQ(opts.Stdout, func(w io.Writer) error {
+					out, err := format.FormatIssueDetailJSON(resp.Issue)
+					if err != nil {
+						return err
+					}
+					_, err = io.WriteString(w, out)
+					return err
+				})
+			}
+
 			var out string
 			switch outputFormat {
 			case "json":
@@ -77,7 +103,7 @@ func newIssueGetCmd(opts Options) *cobra.Command {
 			case "markdown", "md":
 				out = format.FormatIssueDetailMarkdown(resp.Issue)
 			default:
-				out = format.FormatIssueDetail(resp.Issue, format.ColorEnabled(cmd.OutOrStdout()))
+				out = format.FormatIssueDetail(resp.Issue, color)
 			}
 			fmt.Fprint(opts.Stdout, out)
 
@@ -102,6 +128,8 @@ func newIssueGetCmd(opts Options) *cobra.Command {
 		return validOutputFormats, cobra.ShellCompDirectiveNoFileComp
 	})
 
+	of.register(cmd)
+
 	cmd.Flags().StringVarP(&user, "user", "u", "", "User whose issues to browse")
 	cmd.RegisterFlagCompletionFunc("user", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return completeUserNames(cmd, opts)
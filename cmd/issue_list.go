@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"slices"
 	"strconv"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/duboisf/linear/internal/api"
 	"github.com/duboisf/linear/internal/cache"
+	"github.com/duboisf/linear/internal/config"
 	"github.com/duboisf/linear/internal/format"
 	"github.com/duboisf/linear/internal/prompt"
 )
@@ -60,9 +62,14 @@ func newIssueListCmd(opts Options) *cobra.Command {
 		interactive  bool
 		labelFilter  string
 		limit        int
+		of           outputFlags
+		outputFormat string
 		sortBy       string
 		statusFilter string
 		user         string
+		viewFile     string
+		viewName     string
+		wide         bool
 	)
 
 	cmd := &cobra.Command{
@@ -73,9 +80,41 @@ func newIssueListCmd(opts Options) *cobra.Command {
 			return nil, cobra.ShellCompDirectiveNoFileComp
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			// In interactive mode, a view picked with ctrl-v is stored in
+			// the view state file and replaces the command-line filters.
+			replaceView := false
+			if viewFile != "" {
+				if data, err := os.ReadFile(viewFile); err == nil {
+					if name := strings.TrimSpace(string(data)); name != "" {
+						viewName, replaceView = name, true
+					}
+				}
+			}
+			if viewName != "" {
+				if err := applyView(cmd, opts.Config, viewName, replaceView); err != nil {
+					return err
+				}
+			}
 			if limit <= 0 {
 				return fmt.Errorf("--limit must be greater than 0, got %d", limit)
 			}
+			if err := format.ValidateOutputFormat(outputFormat); err != nil {
+				return err
+			}
+			if interactive && (outputFormat != "table" || of.active()) {
+				return fmt.Errorf("--output, --template and --jq cannot be used with --interactive")
+			}
+			if err := of.validate(); err != nil {
+				return err
+			}
+			colSet, err := configColumns(opts)
+			if err != nil {
+				return err
+			}
+			outputFormat, err := of.resolveFormat(cmd, outputFormat)
+			if err != nil {
+				return err
+			}
 
 			client, err := resolveClient(cmd, opts)
 			if err != nil {
@@ -98,14 +137,14 @@ func newIssueListCmd(opts Options) *cobra.Command {
 			// Parse --column flag early so it applies to all output modes.
 			var columns []string
 			if columnFlag != "" {
-				columns, err = format.ParseColumns(columnFlag)
+				columns, err = format.ParseColumns(colSet, columnFlag)
 				if err != nil {
 					return err
 				}
 			}
 
 			if fzfData {
-				return outputFzfData(cmd.Context(), client, user, limit, filter, sortBy, columns, opts.Stdout)
+				return outputFzfData(cmd.Context(), client, user, limit, filter, sortBy, colSet, columns, opts.Stdout)
 			}
 
 			if interactive {
@@ -117,44 +156,59 @@ func newIssueListCmd(opts Options) *cobra.Command {
 					sortIssues(nodes, sortBy)
 					return nodes, nil
 				}
-				hasCommands := opts.Config != nil && len(opts.Config.Interactive.Commands) > 0
+				var commands []config.Command
+				if opts.Config != nil {
+					commands = opts.Config.Interactive.Commands
+				}
+				hasViews := opts.Config != nil && len(opts.Config.Views) > 0
+				bindings, err := resolveFzfBindings(opts.Config, hasViews)
+				if err != nil {
+					return err
+				}
 				self, _ := os.Executable()
 
-				// Create a temp state file for cycle switching.
-				// The file holds the current cycle filter value;
-				// fzf reload commands read it via shell substitution.
-				stateFile, err := os.CreateTemp("", "linear-cycle-*")
+				// Files shared with the hidden commands run by the
+				// bindings live in a private session directory.
+				session, err := newBrowseSession()
 				if err != nil {
-					return fmt.Errorf("creating cycle state file: %w", err)
+					return err
 				}
-				stateFilePath := stateFile.Name()
-				defer os.Remove(stateFilePath)
-				defer os.Remove(stateFilePath + ".header")
+				defer session.Close()
 
-				// Write initial cycle value. Default to "current" when the
-				// flag is empty (which is the default filter behavior).
+				// The cycle file holds the current cycle filter value;
+				// fzf reload commands read it via shell substitution.
+				// Default to "current" when the flag is empty (which is
+				// the default filter behavior).
+				stateFilePath := session.cycleFile()
 				initialCycle := cycle
 				if initialCycle == "" {
 					initialCycle = "current"
 				}
-				if _, err := stateFile.WriteString(initialCycle); err != nil {
-					stateFile.Close()
+				if err := os.WriteFile(stateFilePath, []byte(initialCycle), 0o644); err != nil {
 					return fmt.Errorf("writing cycle state file: %w", err)
 				}
-				stateFile.Close()
 
 				// Write initial header to the header companion file.
 				if err := os.WriteFile(stateFilePath+".header", []byte(cycleHeader), 0o644); err != nil {
 					return fmt.Errorf("writing cycle header file: %w", err)
 				}
+				// The view file starts empty: reloads use the flags above
+				// until a view is picked with ctrl-v.
+				if err := os.WriteFile(stateFilePath+".view", nil, 0o644); err != nil {
+					return fmt.Errorf("writing view state file: %w", err)
+				}
 
 				dynamicReloadCmd := buildFzfDynamicReloadCmd(self, stateFilePath, statusFilter, labelFilter, user, sortBy, columnFlag, limit)
-				selected, err := fzfBrowseIssues(cmd.Context(), client, fetchIssues, opts.Cache, cycleHeader, dynamicReloadCmd, columns, stateFilePath, hasCommands)
+				browse := fzfBrowseIssues
+				if useBuiltinTUI() {
+					browse = tuiBrowseIssues
+				}
+				selected, err := browse(cmd.Context(), client, fetchIssues, opts.Cache, cycleHeader, dynamicReloadCmd, colSet, columns, session, commands, bindings)
 				if err != nil {
 					return err
 				}
-				if selected != "" {
-					fmt.Fprintln(opts.Stdout, selected)
+				for _, id := range selected {
+					fmt.Fprintln(opts.Stdout, id)
 				}
 				return nil
 			}
@@ -166,15 +220,30 @@ func newIssueListCmd(opts Options) *cobra.Command {
 
 			sortIssues(nodes, sortBy)
 
-			if cycleHeader != "" {
-				fmt.Fprintln(opts.Stdout, cycleHeader)
-			}
-
 			if columns == nil {
 				columns = format.DefaultColumns(nodes)
 			}
 
-			out := format.FormatIssueList(nodes, format.ColorEnabled(cmd.OutOrStdout()), columns)
+			switch {
+			case of.template != "":
+				items := make([]any, len(nodes))
+				for i, n := range nodes {
+					items[i] = prompt.NewIssueDataFromList(n)
+				}
+				return of.writeTemplate(opts.Stdout, format.ColorEnabled(cmd.OutOrStdout()), items...)
+			case of.jq != "":
+				return of.writeJQ(opts.Stdout, func(w io.Writer) error {
+					return format.WriteRecords(w, format.IssueRecords(nodes, colSet, columns), "json")
+				})
+			case outputFormat != "table":
+				return format.WriteRecords(opts.Stdout, format.IssueRecords(nodes, colSet, columns), outputFormat)
+			}
+
+			if cycleHeader != "" {
+				fmt.Fprintln(opts.Stdout, cycleHeader)
+			}
+
+			out := format.FormatIssueList(nodes, format.ColorEnabled(cmd.OutOrStdout()), colSet, columns, tableWidth(opts, cmd.OutOrStdout(), wide))
 			fmt.Fprint(opts.Stdout, out)
 
 			return nil
@@ -202,8 +271,9 @@ func newIssueListCmd(opts Options) *cobra.Command {
 			addPrefix = "+"
 		}
 
+		colSet, _ := configColumns(opts)
 		var completions []string
-		for _, col := range format.ColumnNames {
+		for _, col := range colSet.Names() {
 			if used[col] {
 				continue
 			}
@@ -217,7 +287,7 @@ func newIssueListCmd(opts Options) *cobra.Command {
 	})
 	cmd.Flags().BoolVar(&fzfData, "fzf-data", false, "")
 	_ = cmd.Flags().MarkHidden("fzf-data")
-	cmd.Flags().BoolVarP(&interactive, "interactive", "i", false, "Browse issues interactively with fzf preview")
+	cmd.Flags().BoolVarP(&interactive, "interactive", "i", false, "Browse issues interactively with a preview (fzf or the builtin UI)")
 	_ = cmd.RegisterFlagCompletionFunc("interactive", cobra.NoFileCompletions)
 	cmd.Flags().StringVarP(&labelFilter, "label", "l", "", "Filter by label (comma=OR, plus=AND, e.g. bug,devex or bug+frontend)")
 	_ = cmd.RegisterFlagCompletionFunc("label", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -228,6 +298,11 @@ func newIssueListCmd(opts Options) *cobra.Command {
 		return completeCycleValues(cmd, opts)
 	})
 	cmd.Flags().IntVarP(&limit, "limit", "n", 50, "Maximum number of issues to return")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "Output format: table, csv, tsv, json, yaml, ndjson")
+	_ = cmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return format.OutputFormats, cobra.ShellCompDirectiveNoFileComp
+	})
+	of.register(cmd)
 	cmd.Flags().StringVarP(&sortBy, "sort", "S", "status", "Sort by column: status, priority, identifier, title")
 	_ = cmd.RegisterFlagCompletionFunc("sort", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return []string{"status", "priority", "identifier", "title"}, cobra.ShellCompDirectiveNoFileComp
@@ -272,6 +347,14 @@ func newIssueListCmd(opts Options) *cobra.Command {
 	_ = cmd.RegisterFlagCompletionFunc("user", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return completeUserNames(cmd, opts)
 	})
+	cmd.Flags().StringVar(&viewName, "view", "", "Apply a saved view from the config file (flags override its settings)")
+	_ = cmd.RegisterFlagCompletionFunc("view", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return completeViewNames(opts)
+	})
+	cmd.Flags().StringVar(&viewFile, "view-file", "", "")
+	_ = cmd.Flags().MarkHidden("view-file")
+	cmd.Flags().BoolVarP(&wide, "wide", "w", false, "Do not truncate the title and labels to fit the terminal width")
+	_ = cmd.RegisterFlagCompletionFunc("wide", cobra.NoFileCompletions)
 
 	return cmd
 }
@@ -317,6 +400,55 @@ func parseNegation(s string) (prefix, bare string, negated bool) {
 	return "", s, false
 }
 
+// parseStatusFilter splits a lowercased --status value into the state types
+// to include and the !negated ones to exclude, expanding aliases.
+func parseStatusFilter(value string) (inTypes, ninTypes []string) {
+	for s := range strings.SplitSeq(value, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if after, ok := cutNegationPrefix(s); ok {
+			ninTypes = append(ninTypes, resolveStatusAlias(after))
+		} else {
+			inTypes = append(inTypes, resolveStatusAlias(s))
+		}
+	}
+	return inTypes, ninTypes
+}
+
+// validateStatusFilter reports --status values that aren't "all" or known
+// workflow state types (or their aliases).
+func validateStatusFilter(value string) error {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" || value == "all" {
+		return nil
+	}
+	inTypes, ninTypes := parseStatusFilter(value)
+	for _, t := range append(inTypes, ninTypes...) {
+		if _, ok := stateTypeOrder[t]; !ok {
+			return fmt.Errorf("unknown status %q (valid: all, todo, %s)", t, strings.Join(slices.Sorted(maps.Keys(stateTypeOrder)), ", "))
+		}
+	}
+	return nil
+}
+
+// validateLabelFilter reports --label values with empty OR groups or AND
+// terms, such as "bug,,devex" or "bug+".
+func validateLabelFilter(value string) error {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	for group := range strings.SplitSeq(value, ",") {
+		for term := range strings.SplitSeq(group, "+") {
+			if strings.TrimSpace(term) == "" {
+				return fmt.Errorf("invalid label filter %q: empty label name", value)
+			}
+		}
+	}
+	return nil
+}
+
 // buildIssueFilter constructs an IssueFilter from the flag values.
 // When cycle is set, the resolved cycleInfo is returned for header rendering.
 func buildIssueFilter(statusFilter, labelFilter, user, cycle string, ctx context.Context, client graphql.Client, c *cache.Cache, timeNow func() time.Time) (*api.IssueFilter, *cycleInfo, error) {
@@ -330,18 +462,7 @@ func buildIssueFilter(statusFilter, labelFilter, user, cycle string, ctx context
 		filter = nil
 	case statusLower != "":
 		// User takes full control: parse positive and !negated values.
-		var inTypes, ninTypes []string
-		for s := range strings.SplitSeq(statusLower, ",") {
-			s = strings.TrimSpace(s)
-			if s == "" {
-				continue
-			}
-			if after, ok := cutNegationPrefix(s); ok {
-				ninTypes = append(ninTypes, resolveStatusAlias(after))
-			} else {
-				inTypes = append(inTypes, resolveStatusAlias(s))
-			}
-		}
+		inTypes, ninTypes := parseStatusFilter(statusLower)
 		comp := &api.StringComparator{}
 		if len(inTypes) > 0 {
 			comp.In = inTypes
@@ -429,7 +550,7 @@ func buildIssueFilter(statusFilter, labelFilter, user, cycle string, ctx context
 // user flag. When user is non-empty, ListIssues is used; otherwise ListMyIssues.
 func fetchIssueNodes(ctx context.Context, client graphql.Client, user string, limit int, filter *api.IssueFilter) ([]*issueNode, error) {
 	if user != "" {
-		resp, err := api.ListIssues(ctx, client, limit, nil, filter)
+		resp, err := api.ListIssues(ctx, client, limit, nil, filter, nil)
 		if err != nil {
 			return nil, fmt.Errorf("listing issues: %w", err)
 		}
@@ -488,10 +609,17 @@ func convertListIssuesNode(n *api.ListIssuesIssuesIssueConnectionNodesIssue) *is
 			Name: n.Project.Name,
 		}
 	}
+	var parent *api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueParentIssue
+	if n.Parent != nil {
+		parent = &api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueParentIssue{
+			Identifier: n.Parent.Identifier,
+		}
+	}
 	return &issueNode{
 		Id:         n.Id,
 		Identifier: n.Identifier,
 		Title:      n.Title,
+		BranchName: n.BranchName,
 		State:      (*api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueStateWorkflowState)(n.State),
 		Priority:   n.Priority,
 		CreatedAt:  n.CreatedAt,
@@ -502,11 +630,38 @@ func convertListIssuesNode(n *api.ListIssuesIssuesIssueConnectionNodesIssue) *is
 		Cycle:      cycle,
 		Project:    project,
 		Labels:     labels,
+		Parent:     parent,
 	}
 }
 
 type issueNode = api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue
 
+// configColumns returns the issue list columns: the built-in ones plus those
+// defined in the config's columns: section.
+func configColumns(opts Options) (*format.Columns, error) {
+	var cols []prompt.Column
+	if opts.Config != nil {
+		for _, c := range opts.Config.Columns {
+			cols = append(cols, prompt.Column{
+				Name:     c.Name,
+				Header:   c.Header,
+				Template: c.Template,
+				Color:    c.Color,
+				Width:    c.Width,
+			})
+		}
+	}
+	timeNow := opts.TimeNow
+	if timeNow == nil {
+		timeNow = time.Now
+	}
+	set, err := prompt.NewColumns(cols, timeNow)
+	if err != nil {
+		return nil, fmt.Errorf("config columns: %w", err)
+	}
+	return set, nil
+}
+
 // cycleInfo holds resolved cycle metadata for display and mutations.
 type cycleInfo struct {
 	Id       string
@@ -691,8 +846,9 @@ func sortIssues(nodes []*issueNode, sortBy string) {
 
 // outputFzfData fetches, sorts, and prints fzf-formatted issue data to w.
 // Used by the hidden --fzf-data flag for fzf's reload() action.
-// columns controls which columns are displayed; nil means use defaults.
-func outputFzfData(ctx context.Context, client graphql.Client, user string, limit int, filter *api.IssueFilter, sortBy string, columns []string, w io.Writer) error {
+// columns controls which columns of colSet are displayed; nil means use
+// defaults.
+func outputFzfData(ctx context.Context, client graphql.Client, user string, limit int, filter *api.IssueFilter, sortBy string, colSet *format.Columns, columns []string, w io.Writer) error {
 	nodes, err := fetchIssueNodes(ctx, client, user, limit, filter)
 	if err != nil {
 		return err
@@ -701,7 +857,7 @@ func outputFzfData(ctx context.Context, client graphql.Client, user string, limi
 	if columns == nil {
 		columns = format.DefaultColumns(nodes)
 	}
-	header, lines := format.FormatFzfLines(nodes, columns)
+	header, lines := format.FormatFzfLines(nodes, colSet, columns)
 	if header == "" {
 		return nil
 	}
@@ -748,10 +904,12 @@ func buildFzfReloadCmd(self, cycle, statusFilter, labelFilter, user, sortBy, col
 // buildFzfDynamicReloadCmd constructs a reload command that reads the cycle
 // value from a state file via shell substitution instead of a fixed flag value.
 // This ensures reloads after cycle switching use the newly selected cycle.
+// The view picked with ctrl-v, if any, is read from <stateFile>.view.
 func buildFzfDynamicReloadCmd(self, stateFile, statusFilter, labelFilter, user, sortBy, columnFlag string, limit int) string {
 	args := []string{shellQuote(self), "issue", "list", "--fzf-data"}
 	// Read cycle from state file via shell substitution.
 	args = append(args, "--cycle", "\"$(cat '"+stateFile+"')\"")
+	args = append(args, "--view-file", shellQuote(stateFile+".view"))
 	if statusFilter != "" {
 		args = append(args, "--status", shellQuote(statusFilter))
 	}
@@ -1,12 +1,15 @@
 package cmd_test
 
 import (
+	"encoding/json"
+	"io"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/duboisf/linear/cmd"
 	"github.com/duboisf/linear/internal/cache"
+	"github.com/duboisf/linear/internal/config"
 )
 
 const listMyIssuesResponse = `{
@@ -1626,44 +1629,6 @@ func TestIssueList_NoLabelsColumn_WhenNoLabels(t *testing.T) {
 	}
 }
 
-func TestIssueList_InteractiveFlag_FzfNotAvailable(t *testing.T) {
-	getIssueResponse := `{
-		"data": {
-			"issue": {
-				"id": "id-1",
-				"identifier": "ENG-101",
-				"title": "Fix login bug",
-				"state": {"name": "In Progress", "type": "started"},
-				"priority": 1,
-				"url": "https://linear.app/team/ENG-101",
-				"branchName": "eng-101-fix-login-bug"
-			}
-		}
-	}`
-
-	server := newMockGraphQLServer(t, map[string]string{
-		"ListMyIssues": listMyIssuesResponse,
-		"GetIssue":     getIssueResponse,
-	})
-
-	opts, _, _ := testOptionsWithBuffers(t, server)
-	opts.Cache = cache.New(t.TempDir(), 5*time.Minute)
-
-	root := cmd.NewRootCmd(opts)
-	// Use PATH override to ensure fzf is not found.
-	t.Setenv("PATH", t.TempDir())
-	root.SetArgs([]string{"issue", "list", "--interactive"})
-
-	err := root.Execute()
-	// Should fail because fzf is not available.
-	if err == nil {
-		t.Fatal("expected error when fzf is not available")
-	}
-	if !strings.Contains(err.Error(), "fzf") {
-		t.Errorf("error %q should mention fzf", err.Error())
-	}
-}
-
 func TestIssueList_InteractiveFlag_EmptyResult(t *testing.T) {
 	t.Parallel()
 
@@ -1938,3 +1903,198 @@ func TestIssueList_FzfData(t *testing.T) {
 		t.Error("expected ENG-102 in fzf-data output")
 	}
 }
+
+// --- --output flag tests ---
+
+func TestIssueList_OutputJSON(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"ListMyIssues": listMyIssuesResponse,
+	})
+
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "list", "-o", "json", "-C", "id,priority,updated,labels"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue list -o json returned error: %v", err)
+	}
+
+	var got []map[string]any
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, stdout.String())
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d records, want 2", len(got))
+	}
+	first := got[0]
+	if first["id"] != "ENG-101" {
+		t.Errorf("id = %v, want ENG-101", first["id"])
+	}
+	if first["priority"] != float64(1) {
+		t.Errorf("priority = %v, want numeric 1", first["priority"])
+	}
+	if first["updated"] != "2025-01-01T00:00:00Z" {
+		t.Errorf("updated = %v, want ISO timestamp", first["updated"])
+	}
+	if labels, ok := first["labels"].([]any); !ok || len(labels) != 0 {
+		t.Errorf("labels = %#v, want empty list", first["labels"])
+	}
+	if _, ok := first["title"]; ok {
+		t.Error("title should be omitted when not selected by --column")
+	}
+}
+
+func TestIssueList_OutputCSV(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"ListMyIssues": listMyIssuesResponse,
+	})
+
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "list", "--output", "csv", "--column", "id,status,priority"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue list --output csv returned error: %v", err)
+	}
+
+	want := "id,status,priority\nENG-101,In Progress,1\nENG-102,Backlog,3\n"
+	if got := stdout.String(); got != want {
+		t.Errorf("csv output =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestIssueList_OutputNDJSON(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"ListMyIssues": listMyIssuesResponse,
+	})
+
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "list", "-o", "ndjson", "-C", "id,title"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue list -o ndjson returned error: %v", err)
+	}
+
+	want := `{"id":"ENG-101","title":"Fix login bug"}` + "\n" + `{"id":"ENG-102","title":"Add dark mode"}` + "\n"
+	if got := stdout.String(); got != want {
+		t.Errorf("ndjson output =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestIssueList_OutputInvalid(t *testing.T) {
+	t.Parallel()
+
+	opts, _, _ := testOptionsWithBuffers(t, nil)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "list", "-o", "xml"})
+
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), `unknown output format "xml"`) {
+		t.Fatalf("expected unknown output format error, got %v", err)
+	}
+}
+
+func TestIssueList_OutputWithInteractive(t *testing.T) {
+	t.Parallel()
+
+	opts, _, _ := testOptionsWithBuffers(t, nil)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "list", "-i", "-o", "json"})
+
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "cannot be used with --interactive") {
+		t.Fatalf("expected --interactive conflict error, got %v", err)
+	}
+}
+
+// --- config columns ---
+
+func TestIssueList_ConfigColumns(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"ListMyIssues": listMyIssuesResponse,
+	})
+
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	opts.Config = &config.Config{Columns: []config.ColumnConfig{
+		{Name: "cfg-slug", Header: "SLUG", Template: "{{lower .Identifier}}"},
+		{Name: "age", Template: "{{daysSince .UpdatedAt}}d"},
+	}}
+	opts.TimeNow = func() time.Time { return time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC) }
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "list", "-C", "id,cfg-slug,age", "-o", "csv"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue list returned error: %v", err)
+	}
+
+	want := "id,cfg-slug,age\nENG-101,eng-101,10d\nENG-102,eng-102,9d\n"
+	if got := stdout.String(); got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestIssueList_ConfigColumns_Invalid(t *testing.T) {
+	t.Parallel()
+
+	opts, _, _ := testOptionsWithBuffers(t, nil)
+	opts.Config = &config.Config{Columns: []config.ColumnConfig{
+		{Name: "status", Template: "{{.Title}}"},
+	}}
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "list"})
+
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "config columns") {
+		t.Fatalf("expected config columns error, got %v", err)
+	}
+}
+
+func TestIssueList_TruncatesToTerminalWidth(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+		not  string
+	}{
+		{name: "truncated", args: nil, want: "Fix login…", not: "Fix login bug"},
+		{name: "wide", args: []string{"--wide"}, want: "Fix login bug"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newMockGraphQLServer(t, map[string]string{
+				"ListMyIssues": listMyIssuesResponse,
+			})
+
+			opts, stdout, _ := testOptionsWithBuffers(t, server)
+			opts.TerminalWidth = func(io.Writer) int { return 30 }
+			root := cmd.NewRootCmd(opts)
+			root.SetArgs(append([]string{"issue", "list", "-C", "id,status,title"}, tt.args...))
+
+			if err := root.Execute(); err != nil {
+				t.Fatalf("issue list returned error: %v", err)
+			}
+
+			got := stdout.String()
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("expected output to contain %q, got:\n%s", tt.want, got)
+			}
+			if tt.not != "" && strings.Contains(got, tt.not) {
+				t.Errorf("expected output not to contain %q, got:\n%s", tt.not, got)
+			}
+		})
+	}
+}
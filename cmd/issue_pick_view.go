@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/duboisf/linear/internal/format"
+)
+
+// newIssuePickViewCmd creates the hidden "issue pick-view" subcommand used by
+// the fzf ctrl-v binding. It presents the saved views via nested fzf, writes
+// the selected view name to <state-file>.view and the view's cycle to the
+// cycle state file (with its header), so the reload that follows uses them.
+func newIssuePickViewCmd(opts Options) *cobra.Command {
+	var stateFile string
+
+	cmd := &cobra.Command{
+		Use:    "pick-view",
+		Short:  "Pick a saved view (used by fzf binding)",
+		Hidden: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if stateFile == "" {
+				return fmt.Errorf("--state-file is required")
+			}
+			if opts.Config == nil || len(opts.Config.Views) == 0 {
+				return fmt.Errorf("no views configured")
+			}
+
+			viewFile := stateFile + ".view"
+			currentValue := ""
+			if data, err := os.ReadFile(viewFile); err == nil {
+				currentValue = strings.TrimSpace(string(data))
+			}
+
+			lines := make([]string, len(opts.Config.Views))
+			for i, v := range opts.Config.Views {
+				marker := "  "
+				if v.Name == currentValue {
+					marker = "* "
+				}
+				lines[i] = fmt.Sprintf("%s\t%s%s  %s", v.Name, marker, v.Name,
+					format.Colorize(true, format.Gray, viewCommandLine(v)))
+			}
+
+			selected, err := fzfPickValue("Switch view", lines, true)
+			if err != nil || selected == "" {
+				return err // user cancelled — no change
+			}
+			name, _, _ := strings.Cut(selected, "\t")
+			view, _ := opts.Config.View(name)
+
+			// Views without a cycle use the default, the current cycle.
+			cycle := view.Cycle
+			if cycle == "" {
+				cycle = "current"
+			}
+			header := ""
+			if !strings.EqualFold(cycle, "all") {
+				client, err := resolveClient(cmd, opts)
+				if err != nil {
+					return err
+				}
+				timeNow := opts.TimeNow
+				if timeNow == nil {
+					timeNow = time.Now
+				}
+				if ci, err := resolveCycle(cmd.Context(), client, opts.Cache, timeNow, strings.ToLower(cycle)); err == nil {
+					header = ci.formatHeader(true)
+				}
+			}
+
+			if err := os.WriteFile(viewFile, []byte(name), 0o644); err != nil {
+				return fmt.Errorf("writing view state file: %w", err)
+			}
+			if err := os.WriteFile(stateFile, []byte(cycle), 0o644); err != nil {
+				return fmt.Errorf("writing state file: %w", err)
+			}
+			if err := os.WriteFile(stateFile+".header", []byte(header), 0o644); err != nil {
+				return fmt.Errorf("writing header file: %w", err)
+			}
+			return nil
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+
+	cmd.Flags().StringVar(&stateFile, "state-file", "", "Path to cycle state file")
+
+	return cmd
+}
@@ -0,0 +1,241 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/spf13/cobra"
+
+	"github.com/duboisf/linear/internal/api"
+	"github.com/duboisf/linear/internal/cache"
+	"github.com/duboisf/linear/internal/format"
+)
+
+// Preview modes of the issue browser, cycled with the next-preview and
+// prev-preview bindings.
+const (
+	previewDetails  = "details"
+	previewComments = "comments"
+	previewHistory  = "history"
+	previewRelated  = "related"
+	previewGit      = "git"
+)
+
+// _previewModes are the preview modes, in the order they are cycled.
+var _previewModes = []string{previewDetails, previewComments, previewHistory, previewRelated, previewGit}
+
+// newIssuePreviewCmd creates the hidden "issue preview" subcommand rendering
+// the issue browser's preview. With --step it only rotates the mode stored
+// in --mode-file, for the next-preview and prev-preview bindings.
+func newIssuePreviewCmd(opts Options) *cobra.Command {
+	var (
+		mode     string
+		modeFile string
+		step     int
+	)
+
+	cmd := &cobra.Command{
+		Use:    "preview [IDENTIFIER]",
+		Short:  "Render an issue preview (used by fzf bindings)",
+		Args:   cobra.MaximumNArgs(1),
+		Hidden: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if step != 0 {
+				if modeFile == "" {
+					return fmt.Errorf("--step requires --mode-file")
+				}
+				next := stepPreviewMode(readPreviewMode(modeFile), step)
+				if err := os.WriteFile(modeFile, []byte(next), 0o644); err != nil {
+					return fmt.Errorf("writing preview mode: %w", err)
+				}
+				return nil
+			}
+			if len(args) == 0 {
+				return fmt.Errorf("an issue identifier is required")
+			}
+			id := args[0]
+
+			if mode == "" {
+				mode = readPreviewMode(modeFile)
+			}
+			if !slices.Contains(_previewModes, mode) {
+				return fmt.Errorf("unknown preview mode %q (valid: %s)", mode, strings.Join(_previewModes, ", "))
+			}
+
+			newClient := func() (graphql.Client, error) { return resolveClient(cmd, opts) }
+			preview, err := issuePreview(cmd.Context(), newClient, opts.Cache, opts.GitWorktreeCreator, mode, id)
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(opts.Stdout, previewTabs(id, mode)+preview)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&mode, "mode", "", "Preview mode: "+strings.Join(_previewModes, ", "))
+	cmd.Flags().StringVar(&modeFile, "mode-file", "", "File holding the preview mode, read when --mode is unset")
+	cmd.Flags().IntVar(&step, "step", 0, "Rotate the mode in --mode-file by this many steps and exit")
+	return cmd
+}
+
+// readPreviewMode returns the preview mode stored in file, defaulting to
+// details.
+func readPreviewMode(file string) string {
+	if file == "" {
+		return previewDetails
+	}
+	data, err := os.ReadFile(file)
+	mode := strings.TrimSpace(string(data))
+	if err != nil || !slices.Contains(_previewModes, mode) {
+		return previewDetails
+	}
+	return mode
+}
+
+// stepPreviewMode returns the preview mode n steps after mode, wrapping
+// around; a negative n steps backwards.
+func stepPreviewMode(mode string, n int) string {
+	i := max(slices.Index(_previewModes, mode), 0)
+	k := len(_previewModes)
+	return _previewModes[((i+n)%k+k)%k]
+}
+
+// previewTabs returns the tab bar shown above the preview of issue id,
+// highlighting mode.
+func previewTabs(id, mode string) string {
+	tabs := make([]string, len(_previewModes))
+	for i, m := range _previewModes {
+		if m == mode {
+			tabs[i] = format.Colorize(true, format.Bold+format.Cyan, "["+m+"]")
+		} else {
+			tabs[i] = format.Colorize(true, format.Gray, " "+m+" ")
+		}
+	}
+	return format.Colorize(true, format.Bold, id) + "  " + strings.Join(tabs, " ") + "\n"
+}
+
+// previewCacheKey returns the cache key of the preview of issue id in mode.
+// The details preview is the one prefetched by the issue browser.
+func previewCacheKey(mode, id string) string {
+	if mode == previewDetails {
+		return "issues/" + id
+	}
+	return "preview/" + mode + "/" + id
+}
+
+// issuePreview returns the preview of issue id in mode, from the cache when
+// it holds it. The client is only created on a cache miss, so cached
+// previews show up fast. The git mode shows the status of the issue's
+// worktree; it is always current, so it's not cached.
+func issuePreview(ctx context.Context, newClient func() (graphql.Client, error), c *cache.Cache, git GitWorktreeCreator, mode, id string) (string, error) {
+	if mode == previewGit {
+		return worktreePreview(git, id)
+	}
+	key := previewCacheKey(mode, id)
+	if c != nil {
+		if content, ok := c.Get(key); ok {
+			return content, nil
+		}
+	}
+
+	client, err := newClient()
+	if err != nil {
+		return "", err
+	}
+	var content string
+	switch mode {
+	case previewDetails:
+		resp, err := api.GetIssue(ctx, client, id)
+		if err != nil {
+			return "", fmt.Errorf("getting issue: %w", err)
+		}
+		if resp.Issue == nil {
+			return "", fmt.Errorf("issue %s not found", id)
+		}
+		content = formatIssueCache(resp.Issue)
+	case previewComments:
+		resp, err := api.IssueComments(ctx, client, id)
+		if err != nil {
+			return "", fmt.Errorf("getting comments: %w", err)
+		}
+		if resp.Issue == nil {
+			return "", fmt.Errorf("issue %s not found", id)
+		}
+		var comments []*api.IssueCommentsIssueCommentsCommentConnectionNodesComment
+		if resp.Issue.Comments != nil {
+			comments = resp.Issue.Comments.Nodes
+		}
+		content = renderPreviewMarkdown(format.FormatIssueCommentsMarkdown(comments))
+	case previewHistory:
+		resp, err := api.IssueHistory(ctx, client, id)
+		if err != nil {
+			return "", fmt.Errorf("getting history: %w", err)
+		}
+		if resp.Issue == nil {
+			return "", fmt.Errorf("issue %s not found", id)
+		}
+		var history []*api.IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory
+		if resp.Issue.History != nil {
+			history = resp.Issue.History.Nodes
+		}
+		content = renderPreviewMarkdown(format.FormatIssueHistoryMarkdown(history))
+	case previewRelated:
+		resp, err := api.IssueRelations(ctx, client, id)
+		if err != nil {
+			return "", fmt.Errorf("getting relations: %w", err)
+		}
+		if resp.Issue == nil {
+			return "", fmt.Errorf("issue %s not found", id)
+		}
+		content = renderPreviewMarkdown(format.FormatIssueRelationsMarkdown(resp.Issue))
+	default:
+		return "", fmt.Errorf("unknown preview mode %q (valid: %s)", mode, strings.Join(_previewModes, ", "))
+	}
+
+	if c != nil {
+		_ = c.Set(key, content)
+	}
+	return content, nil
+}
+
+// renderPreviewMarkdown renders markdown for a preview, falling back to
+// the plain markdown on error.
+func renderPreviewMarkdown(md string) string {
+	rendered, err := renderMarkdown(md)
+	if err != nil {
+		return md
+	}
+	return rendered
+}
+
+// worktreePreview returns the path and status of the worktree of issue id,
+// or a note when it has none.
+func worktreePreview(git GitWorktreeCreator, id string) (string, error) {
+	worktrees, err := git.ListWorktrees()
+	if err != nil {
+		return format.Colorize(true, format.Gray, "Not in a git repository.") + "\n", nil
+	}
+	wt, ok := findIssueWorktree(worktrees, id, "")
+	if !ok {
+		return format.Colorize(true, format.Gray, "No worktree for "+id+".") + "\n", nil
+	}
+	status, err := git.WorktreeStatus(wt.Path)
+	if err != nil {
+		return "", err
+	}
+	return format.Colorize(true, format.Bold, wt.Path) + "\n\n" + status, nil
+}
+
+// deletePreviewCache removes the cached previews of issue id other than
+// details, so they are fetched again after the issue changes.
+func deletePreviewCache(c *cache.Cache, id string) {
+	for _, mode := range _previewModes {
+		if mode != previewDetails {
+			c.Delete(previewCacheKey(mode, id))
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package cmd_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/duboisf/linear/cmd"
+	"github.com/duboisf/linear/internal/cache"
+)
+
+const issueCommentsResponse = `{
+	"data": {
+		"issue": {
+			"comments": {
+				"nodes": [
+					{"body": "First look", "createdAt": "2026-01-02T10:00:00Z", "user": {"name": "Alice"}},
+					{"body": "Shipped the fix", "createdAt": "2026-01-05T10:00:00Z", "user": null}
+				]
+			}
+		}
+	}
+}`
+
+func TestIssuePreview_Comments(t *testing.T) {
+	t.Parallel()
+
+	server, rec := newRecordingGraphQLServer(t, map[string]string{"IssueComments": issueCommentsResponse})
+	c := cache.New(t.TempDir(), time.Minute)
+
+	var got string
+	for range 2 {
+		opts, stdout, _ := testOptionsWithBuffers(t, server)
+		opts.Cache = c
+		root := cmd.NewRootCmd(opts)
+		root.SetArgs([]string{"issue", "preview", "--mode", "comments", "ENG-42"})
+		if err := root.Execute(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = ansi.Strip(stdout.String())
+	}
+
+	if n := len(rec.calls("IssueComments")); n != 1 {
+		t.Errorf("expected 1 IssueComments call with the cache, got %d", n)
+	}
+	if !strings.Contains(got, "ENG-42  ") || !strings.Contains(got, "[comments]") {
+		t.Errorf("output should start with the tab bar, got:\n%s", got)
+	}
+	newer, older := strings.Index(got, "Shipped the fix"), strings.Index(got, "First look")
+	if newer < 0 || older < 0 || newer > older {
+		t.Errorf("output should list the comments newest first, got:\n%s", got)
+	}
+	if _, ok := c.Get("preview/comments/ENG-42"); !ok {
+		t.Error("comments preview should be cached")
+	}
+}
+
+func TestIssuePreview_Git(t *testing.T) {
+	t.Parallel()
+
+	// No server: the git mode doesn't use the API.
+	opts, stdout, _ := testOptionsWithBuffers(t, nil)
+	opts.GitWorktreeCreator = &mockGitWorktreeCreator{
+		worktrees: []cmd.GitWorktree{
+			{Path: "/repo", Branch: "main"},
+			{Path: "/wt/eng-42", Branch: "fred/eng-42-fix-login"},
+		},
+		status: map[string]string{"/wt/eng-42": "## fred/eng-42-fix-login\n M main.go\n"},
+	}
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "preview", "--mode", "git", "ENG-42"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := ansi.Strip(stdout.String())
+	if !strings.Contains(got, "/wt/eng-42") || !strings.Contains(got, " M main.go") {
+		t.Errorf("output should show the worktree status, got:\n%s", got)
+	}
+
+	stdout.Reset()
+	root.SetArgs([]string{"issue", "preview", "--mode", "git", "ENG-7"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := ansi.Strip(stdout.String()); !strings.Contains(got, "No worktree for ENG-7.") {
+		t.Errorf("output should note the missing worktree, got:\n%s", got)
+	}
+}
+
+func TestIssuePreview_Step(t *testing.T) {
+	t.Parallel()
+
+	modeFile := filepath.Join(t.TempDir(), "preview-mode")
+	tests := []struct {
+		step string
+		want string
+	}{
+		{"1", "comments"},
+		{"1", "history"},
+		{"-3", "git"},
+		{"1", "details"},
+	}
+	for _, tt := range tests {
+		opts, stdout, _ := testOptionsWithBuffers(t, nil)
+		root := cmd.NewRootCmd(opts)
+		root.SetArgs([]string{"issue", "preview", "--mode-file", modeFile, "--step", tt.step})
+		if err := root.Execute(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if stdout.Len() != 0 {
+			t.Errorf("--step should print nothing, got %q", stdout.String())
+		}
+		data, err := os.ReadFile(modeFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := string(data); got != tt.want {
+			t.Errorf("after --step %s: mode = %q, want %q", tt.step, got, tt.want)
+		}
+	}
+}
+
+func TestIssuePreview_UnknownMode(t *testing.T) {
+	t.Parallel()
+
+	opts, _, _ := testOptionsWithBuffers(t, nil)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "preview", "--mode", "diff", "ENG-42"})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), `unknown preview mode "diff"`) {
+		t.Errorf("error = %v, want unknown preview mode", err)
+	}
+}
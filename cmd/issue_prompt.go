@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/duboisf/linear/internal/api"
+	"github.com/duboisf/linear/internal/config"
+	"github.com/duboisf/linear/internal/prompt"
+)
+
+// defaultPromptName is the prompt used when none is named. A prompt of that
+// name in the config replaces prompt.DefaultContextTemplate.
+const defaultPromptName = "default"
+
+// newIssuePromptCmd creates the "issue prompt" subcommand, which renders a
+// Markdown document describing an issue for coding assistants, from a
+// template of the prompts: config section.
+func newIssuePromptCmd(opts Options) *cobra.Command {
+	var outFile string
+
+	cmd := &cobra.Command{
+		Use:   "prompt [NAME] IDENTIFIER",
+		Short: "Render an issue as a Markdown prompt for coding assistants",
+		Long: `Render a Markdown document with the issue's title, description, labels,
+parent, sub-issues, comments, acceptance criteria and branch name, to hand the
+issue to a coding assistant or any other tool.
+
+NAME picks a template from the prompts: section of the config file. Without
+it, the prompt named "default" is used, or the built-in template.`,
+		Example: `  linear issue prompt AIS-42
+  linear issue prompt review AIS-42 --file prompt.md
+  claude "$(linear issue prompt AIS-42)"`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, identifier := defaultPromptName, args[0]
+			if len(args) == 2 {
+				name, identifier = args[0], args[1]
+			}
+			tmpl, err := promptTemplate(opts.Config, name)
+			if err != nil {
+				return err
+			}
+
+			client, err := resolveClient(cmd, opts)
+			if err != nil {
+				return err
+			}
+			resp, err := api.IssueContext(cmd.Context(), client, identifier)
+			if err != nil {
+				return fmt.Errorf("getting issue: %w", err)
+			}
+			if resp.Issue == nil {
+				return fmt.Errorf("issue %s not found", identifier)
+			}
+			data := prompt.NewContextData(resp.Issue, resp.Related)
+
+			out, err := prompt.RenderRaw(tmpl, data)
+			if err != nil {
+				return fmt.Errorf("rendering prompt %q: %w", name, err)
+			}
+			if !strings.HasSuffix(out, "\n") {
+				out += "\n"
+			}
+			if outFile == "" {
+				fmt.Fprint(opts.Stdout, out)
+				return nil
+			}
+			if err := os.WriteFile(outFile, []byte(out), 0o644); err != nil {
+				return fmt.Errorf("writing prompt: %w", err)
+			}
+			fmt.Fprintf(opts.Stderr, "Wrote %s prompt for %s to %s\n", name, resp.Issue.Identifier, outFile)
+			return nil
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			switch {
+			case len(args) == 0:
+				names, _ := completePromptNames(opts)
+				issues, directive := completeMyIssues(cmd, opts)
+				return append(names, issues...), directive
+			case len(args) == 1:
+				if _, ok := opts.Config.Prompt(args[0]); ok || args[0] == defaultPromptName {
+					return completeMyIssues(cmd, opts)
+				}
+			}
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+
+	cmd.Flags().StringVarP(&outFile, "file", "f", "", "Write the prompt to `FILE` instead of stdout")
+
+	return cmd
+}
+
+// promptTemplate returns the template of the named prompt: from the config,
+// or the built-in template for "default".
+func promptTemplate(cfg *config.Config, name string) (string, error) {
+	if p, ok := cfg.Prompt(name); ok {
+		return p.Template, nil
+	}
+	if name == defaultPromptName {
+		return prompt.DefaultContextTemplate, nil
+	}
+	names := cfg.PromptNames()
+	if len(names) == 0 {
+		return "", fmt.Errorf("unknown prompt %q: no prompts are configured", name)
+	}
+	return "", fmt.Errorf("unknown prompt %q (available: %s)", name, strings.Join(names, ", "))
+}
+
+// completePromptNames returns the configured prompt names for shell
+// completion.
+func completePromptNames(opts Options) ([]string, cobra.ShellCompDirective) {
+	var comps []string
+	for _, name := range opts.Config.PromptNames() {
+		comps = append(comps, name+"\tprompt")
+	}
+	return comps, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,151 @@
+package cmd_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/cmd"
+	"github.com/duboisf/linear/internal/config"
+)
+
+// issueContextResponse lists the comments newest first, as the API does.
+const issueContextResponse = `{
+	"data": {
+		"issue": {
+			"id": "issue-1",
+			"identifier": "ENG-42",
+			"title": "Implement feature X",
+			"description": "Detailed description here.",
+			"url": "https://linear.app/team/ENG-42",
+			"priority": 2,
+			"branchName": "feat/implement-feature-x",
+			"state": {"id": "state-started", "name": "In Progress", "type": "started"}
+		},
+		"related": {
+			"children": {
+				"nodes": [
+					{"identifier": "ENG-43", "title": "Write the docs", "state": {"name": "Todo", "type": "unstarted"}}
+				]
+			},
+			"comments": {
+				"nodes": [
+					{"body": "Shipped the fix", "createdAt": "2026-01-05T10:00:00Z", "user": null},
+					{"body": "First look", "createdAt": "2026-01-02T10:00:00Z", "user": {"name": "Alice"}}
+				],
+				"pageInfo": {"hasNextPage": false}
+			}
+		}
+	}
+}`
+
+func issuePromptHandlers() map[string]string {
+	return map[string]string{"IssueContext": issueContextResponse}
+}
+
+func TestIssuePrompt_DefaultTemplate(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, issuePromptHandlers())
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "prompt", "ENG-42"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := stdout.String()
+	for _, want := range []string{
+		"# ENG-42: Implement feature X\n",
+		"- Branch: `feat/implement-feature-x`\n",
+		"## Description\n\nDetailed description here.\n",
+		"## Sub-issues\n\n- ENG-43: Write the docs (Todo)\n",
+		"### Alice, 2026-01-02\n\nFirst look\n\n### Unknown, 2026-01-05\n\nShipped the fix\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output should contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestIssuePrompt_TruncatedComments(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"IssueContext": strings.Replace(issueContextResponse, `"hasNextPage": false`, `"hasNextPage": true`, 1),
+	})
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "prompt", "ENG-42"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "## Comments\n\nOlder comments are left out; these are the latest 2.\n"; !strings.Contains(stdout.String(), want) {
+		t.Errorf("output should contain %q, got:\n%s", want, stdout.String())
+	}
+}
+
+func TestIssuePrompt_NamedTemplateToFile(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, issuePromptHandlers())
+	opts, stdout, stderr := testOptionsWithBuffers(t, server)
+	opts.Config = &config.Config{Prompts: []config.PromptConfig{
+		{Name: "short", Template: "{{.Identifier}} {{.Title}}: {{len .SubIssues}} sub-issues, {{len .Comments}} comments"},
+	}}
+	path := filepath.Join(t.TempDir(), "prompt.md")
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "prompt", "short", "ENG-42", "--file", path})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "ENG-42 Implement feature X: 1 sub-issues, 2 comments\n"; string(got) != want {
+		t.Errorf("file = %q, want %q", got, want)
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("expected no stdout, got %q", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "Wrote short prompt for ENG-42 to "+path) {
+		t.Errorf("stderr = %q", stderr.String())
+	}
+}
+
+func TestIssuePrompt_ConfiguredDefault(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, issuePromptHandlers())
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	opts.Config = &config.Config{Prompts: []config.PromptConfig{
+		{Name: "default", Template: "Work on {{.Identifier}}\n"},
+	}}
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "prompt", "ENG-42"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := stdout.String(), "Work on ENG-42\n"; got != want {
+		t.Errorf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestIssuePrompt_UnknownPrompt(t *testing.T) {
+	t.Parallel()
+
+	opts, _, _ := testOptionsWithBuffers(t, nil)
+	opts.Config = &config.Config{Prompts: []config.PromptConfig{{Name: "review", Template: "x"}}}
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "prompt", "nope", "ENG-42"})
+
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), `unknown prompt "nope" (available: review)`) {
+		t.Errorf("expected an unknown prompt error, got %v", err)
+	}
+}
@@ -1,14 +1,21 @@
 package cmd
 
 import (
+	"bufio"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 	"syscall"
 	"time"
 
+	"github.com/Khan/genqlient/graphql"
 	"github.com/spf13/cobra"
 
 	"github.com/duboisf/linear/internal/api"
@@ -17,18 +24,27 @@ import (
 )
 
 // newIssueRunCommandCmd creates the hidden "issue run-command" subcommand
-// used by the fzf ctrl-o binding. It runs a user-configured custom command
-// with issue data available via Go template fields.
+// used by the fzf ctrl-o binding and by commands bound to keys. It runs a
+// user-configured custom command with issue data available via Go template
+// fields. With several identifiers (multi-select), the command runs once per
+// issue, or once for all of them when it sets multi: true.
 func newIssueRunCommandCmd(opts Options) *cobra.Command {
-	var issueDataFile string
-	var identifier string
+	var issueDataDir string
 	var execFile string
+	var commandName string
+	var afterActionsFile string
 
 	cmd := &cobra.Command{
-		Use:    "run-command",
+		Use:    "run-command IDENTIFIER...",
 		Short:  "Run a custom command (used by fzf binding)",
+		Args:   cobra.MinimumNArgs(1),
 		Hidden: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			// Nothing runs after a cancelled or failed command.
+			if afterActionsFile != "" {
+				os.Remove(afterActionsFile)
+			}
+
 			var commands []config.Command
 			if opts.Config != nil {
 				commands = opts.Config.Interactive.Commands
@@ -64,62 +80,23 @@ func newIssueRunCommandCmd(opts Options) *cobra.Command {
 				return nil
 			}
 
-			// Read issue data from cache file, polling briefly for prefetch.
-			// Write progress to /dev/tty so the user sees feedback while
-			// waiting inside fzf's execute().
-			var issueData prompt.IssueData
-			if issueDataFile != "" {
-				tty, _ := os.OpenFile("/dev/tty", os.O_WRONLY, 0)
-				for i := range 20 {
-					data, err := os.ReadFile(issueDataFile)
-					if err == nil && len(data) > 0 {
-						if err := json.Unmarshal(data, &issueData); err == nil {
-							if tty != nil {
-								fmt.Fprint(tty, "\r\033[K")
-							}
-							break
-						}
-					}
-					if i == 0 && tty != nil {
-						fmt.Fprint(tty, "\033[2mLoading issue data...\033[0m")
-					}
-					time.Sleep(100 * time.Millisecond)
-				}
-				if tty != nil {
-					tty.Close()
-				}
-			}
-
-			// Fallback: fetch issue from API if cache didn't populate in time.
-			if issueData.Identifier == "" && identifier != "" {
-				client, err := resolveClient(cmd, opts)
-				if err == nil {
-					tty, _ := os.OpenFile("/dev/tty", os.O_WRONLY, 0)
-					if tty != nil {
-						fmt.Fprint(tty, "\033[2mFetching issue data...\033[0m")
-					}
-					resp, err := api.GetIssue(cmd.Context(), client, identifier)
-					if err == nil && resp.Issue != nil {
-						issueData = prompt.NewIssueData(resp.Issue)
-					}
-					if tty != nil {
-						fmt.Fprint(tty, "\r\033[K")
-						tty.Close()
-					}
-				}
-				// Last resort: at least set the identifier.
-				if issueData.Identifier == "" {
-					issueData.Identifier = identifier
-				}
-			}
-
 			selected := commands[0]
-			if len(commands) > 1 {
+			if commandName != "" {
+				c, ok := opts.Config.Command(commandName)
+				if !ok {
+					return fmt.Errorf("no command named %q in interactive.commands", commandName)
+				}
+				selected = c
+			} else if len(commands) > 1 {
 				lines := make([]string, len(commands))
 				for i, c := range commands {
 					lines[i] = fmt.Sprintf("%d\t%s", i, c.Name)
 				}
-				picked, err := fzfPickValue("Run command", lines, true)
+				header := "Run command"
+				if len(args) > 1 {
+					header = fmt.Sprintf("Run command on %d issues", len(args))
+				}
+				picked, err := fzfPickValue(header, lines, true)
 				if err != nil || picked == "" {
 					return err // user cancelled
 				}
@@ -131,11 +108,24 @@ func newIssueRunCommandCmd(opts Options) *cobra.Command {
 				selected = commands[idx]
 			}
 
-			rendered, err := prompt.Render(selected.Command, issueData)
+			issues := make([]prompt.IssueData, len(args))
+			for i, identifier := range args {
+				issues[i] = loadIssueData(cmd, opts, issueDataDir, identifier)
+			}
+			addGitContext(opts.GitWorktreeCreator, issues)
+
+			rendered, err := renderCommand(selected, issues)
 			if err != nil {
 				return fmt.Errorf("rendering command template: %w", err)
 			}
 
+			if selected.Confirm {
+				ok, err := confirmOnTTY(opts, fmt.Sprintf("Run %s on %s?", selected.Name, strings.Join(args, ", ")))
+				if err != nil || !ok {
+					return err
+				}
+			}
+
 			// For exec commands, atomically write the rendered command to a
 			// file so the caller can exec it after fzf exits.
 			if selected.Exec && execFile != "" {
@@ -157,12 +147,27 @@ func newIssueRunCommandCmd(opts Options) *cobra.Command {
 				os.Remove(execFile)
 			}
 
+			if selected.Output == config.OutputPreview && afterActionsFile != "" {
+				outputFile := filepath.Join(filepath.Dir(afterActionsFile), "command-output")
+				if err := runToFile(rendered, outputFile); err != nil {
+					return err
+				}
+				return writeAfterActions(afterActionsFile, selected, fmt.Sprintf("preview(cat '%s')", outputFile))
+			}
+			if afterActionsFile != "" {
+				if err := writeAfterActions(afterActionsFile, selected, "refresh-preview"); err != nil {
+					return err
+				}
+			}
+
 			// Show what we're about to run so the user isn't staring at a
 			// blank screen while the subprocess starts.
-			tty, _ := os.OpenFile("/dev/tty", os.O_WRONLY, 0)
-			if tty != nil {
-				fmt.Fprintf(tty, "\033[2mRunning %s...\033[0m\n", selected.Name)
-				tty.Close()
+			if !selected.Silent {
+				tty, _ := os.OpenFile("/dev/tty", os.O_WRONLY, 0)
+				if tty != nil {
+					fmt.Fprintf(tty, "\033[2mRunning %s...\033[0m\n", selected.Name)
+					tty.Close()
+				}
 			}
 
 			shPath := "/bin/sh"
@@ -173,9 +178,176 @@ func newIssueRunCommandCmd(opts Options) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVar(&issueDataFile, "issue-data-file", "", "Path to cached issue data JSON file")
-	cmd.Flags().StringVar(&identifier, "identifier", "", "Fallback issue identifier")
+	cmd.Flags().StringVar(&issueDataDir, "issue-data-dir", "", "Directory of cached issue data JSON files, named by identifier")
 	cmd.Flags().StringVar(&execFile, "exec-file", "", "Path to write rendered command for deferred exec")
+	cmd.Flags().StringVar(&commandName, "command", "", "Name of the command to run, skipping the picker")
+	cmd.Flags().StringVar(&afterActionsFile, "after-actions-file", "", "Path to write the fzf actions to run after the command")
 
 	return cmd
 }
+
+// writeAfterActions writes the fzf actions to run once command c returns to
+// path: the reload action read from path + ".reload" when c sets reload,
+// clearing the selection, then show.
+func writeAfterActions(path string, c config.Command, show string) error {
+	actions := []string{"deselect-all", show}
+	if c.Reload {
+		if reload, err := os.ReadFile(path + ".reload"); err == nil && len(reload) > 0 {
+			actions = slices.Insert(actions, 0, string(reload))
+		}
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(actions, "+")), 0o644); err != nil {
+		return fmt.Errorf("writing after-actions file: %w", err)
+	}
+	return nil
+}
+
+// runToFile runs script with sh, writing its output to path for the preview
+// pane. A failure is reported in the output rather than as an error, so the
+// user sees it next to what the command printed.
+func runToFile(script, path string) error {
+	out, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("creating output file: %w", err)
+	}
+	defer out.Close()
+	c := exec.Command("/bin/sh", "-c", script)
+	c.Stdout = out
+	c.Stderr = out
+	if err := c.Run(); err != nil {
+		fmt.Fprintf(out, "\n\033[31m%v\033[0m\n", err)
+	}
+	return nil
+}
+
+// confirmOnTTY asks question with a y/N prompt on the terminal, falling back
+// to opts.Stdin and opts.Stderr when there is none.
+func confirmOnTTY(opts Options, question string) (bool, error) {
+	var in io.Reader = opts.Stdin
+	var out io.Writer = opts.Stderr
+	if tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0); err == nil {
+		defer tty.Close()
+		in, out = tty, tty
+	}
+	return confirm(in, out, question)
+}
+
+// confirm writes question with a y/N prompt to out and reports whether the
+// answer read from in is yes.
+func confirm(in io.Reader, out io.Writer, question string) (bool, error) {
+	fmt.Fprintf(out, "%s [y/N] ", question)
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && answer == "" && !errors.Is(err, io.EOF) {
+		return false, fmt.Errorf("reading answer: %w", err)
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	}
+	return false, nil
+}
+
+// renderCommand renders c for issues: once with prompt.Render for a single
+// issue, once with prompt.RenderList for a multi command, and otherwise once
+// per issue, joined into a script that runs them in order.
+func renderCommand(c config.Command, issues []prompt.IssueData) (string, error) {
+	if c.Multi {
+		return prompt.RenderList(c.Command, issues)
+	}
+	scripts := make([]string, len(issues))
+	for i, issue := range issues {
+		rendered, err := prompt.Render(c.Command, issue)
+		if err != nil {
+			return "", err
+		}
+		scripts[i] = rendered
+	}
+	return strings.Join(scripts, "\n"), nil
+}
+
+// loadIssueData reads the issue data prefetched into dir, polling briefly
+// while the prefetch catches up, then falls back to fetching the issue.
+// Progress is written to /dev/tty so the user sees feedback while waiting
+// inside fzf's execute().
+func loadIssueData(cmd *cobra.Command, opts Options, dir, identifier string) prompt.IssueData {
+	var issueData prompt.IssueData
+	if dir != "" {
+		tty, _ := os.OpenFile("/dev/tty", os.O_WRONLY, 0)
+		for i := range 20 {
+			data, err := os.ReadFile(filepath.Join(dir, identifier))
+			if err == nil && len(data) > 0 {
+				if err := json.Unmarshal(data, &issueData); err == nil {
+					if tty != nil {
+						fmt.Fprint(tty, "\r\033[K")
+					}
+					break
+				}
+			}
+			if i == 0 && tty != nil {
+				fmt.Fprint(tty, "\033[2mLoading issue data...\033[0m")
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		if tty != nil {
+			tty.Close()
+		}
+	}
+	if issueData.Identifier != "" {
+		return issueData
+	}
+
+	// Fallback: fetch issue from API if cache didn't populate in time.
+	if client, err := resolveClient(cmd, opts); err == nil {
+		tty, _ := os.OpenFile("/dev/tty", os.O_WRONLY, 0)
+		if tty != nil {
+			fmt.Fprint(tty, "\033[2mFetching issue data...\033[0m")
+		}
+		resp, err := api.GetIssue(cmd.Context(), client, identifier)
+		if err == nil && resp.Issue != nil {
+			issueData = prompt.NewIssueData(resp.Issue)
+			_ = fetchChildrenAndComments(cmd.Context(), client, &issueData)
+		}
+		if tty != nil {
+			fmt.Fprint(tty, "\r\033[K")
+			tty.Close()
+		}
+	}
+	// Last resort: at least set the identifier.
+	if issueData.Identifier == "" {
+		issueData.Identifier = identifier
+	}
+	return issueData
+}
+
+// fetchChildrenAndComments fills in the sub-issues and the comment count
+// of d. GetIssue leaves them out as only command templates use them.
+func fetchChildrenAndComments(ctx context.Context, client graphql.Client, d *prompt.IssueData) error {
+	resp, err := api.IssueChildrenAndComments(ctx, client, d.Identifier)
+	if err != nil {
+		return err
+	}
+	if resp.Issue != nil {
+		d.SetChildrenAndComments(resp.Issue)
+	}
+	return nil
+}
+
+// addGitContext sets the RepoRoot of issues to the repository of the
+// current directory and their WorktreePath to their linked worktree, when
+// there are.
+func addGitContext(git GitWorktreeCreator, issues []prompt.IssueData) {
+	if git == nil {
+		return
+	}
+	root, err := git.RepoRootDir()
+	if err != nil {
+		return // not in a git repository
+	}
+	worktrees, _ := git.ListWorktrees()
+	for i := range issues {
+		issues[i].RepoRoot = root
+		if wt, ok := findIssueWorktree(worktrees, issues[i].Identifier, issues[i].BranchName); ok {
+			issues[i].WorktreePath = wt.Path
+		}
+	}
+}
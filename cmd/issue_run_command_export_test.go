@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"io"
+
+	"github.com/duboisf/linear/internal/config"
+	"github.com/duboisf/linear/internal/prompt"
+)
+
+// RenderCommand is an exported wrapper for testing.
+func RenderCommand(c config.Command, issues []prompt.IssueData) (string, error) {
+	return renderCommand(c, issues)
+}
+
+// Confirm is an exported wrapper for testing.
+func Confirm(in io.Reader, out io.Writer, question string) (bool, error) {
+	return confirm(in, out, question)
+}
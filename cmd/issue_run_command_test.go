@@ -0,0 +1,191 @@
+package cmd_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/cmd"
+	"github.com/duboisf/linear/internal/config"
+	"github.com/duboisf/linear/internal/prompt"
+)
+
+func TestRenderCommand(t *testing.T) {
+	t.Parallel()
+
+	issues := []prompt.IssueData{
+		{Identifier: "ENG-1", Title: "Fix it"},
+		{Identifier: "ENG-2", Title: "Ship it"},
+	}
+	tests := []struct {
+		name   string
+		c      config.Command
+		issues []prompt.IssueData
+		want   string
+	}{
+		{
+			name:   "single issue",
+			c:      config.Command{Command: "echo {{.Identifier}}"},
+			issues: issues[:1],
+			want:   "echo 'ENG-1'",
+		},
+		{
+			name:   "once per issue",
+			c:      config.Command{Command: "echo {{.Identifier}}"},
+			issues: issues,
+			want:   "echo 'ENG-1'\necho 'ENG-2'",
+		},
+		{
+			name:   "multi",
+			c:      config.Command{Command: "echo {{.Identifiers}}", Multi: true},
+			issues: issues,
+			want:   "echo 'ENG-1' 'ENG-2'",
+		},
+		{
+			name:   "multi with one issue",
+			c:      config.Command{Command: "echo {{.Identifiers}}", Multi: true},
+			issues: issues[:1],
+			want:   "echo 'ENG-1'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := cmd.RenderCommand(tt.c, tt.issues)
+			if err != nil {
+				t.Fatalf("RenderCommand() error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RenderCommand() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunCommand_UnknownCommandName(t *testing.T) {
+	t.Parallel()
+
+	opts, _, _ := testOptionsWithBuffers(t, nil)
+	opts.Config = &config.Config{Interactive: config.InteractiveConfig{
+		Commands: []config.Command{{Name: "Claude", Command: "claude"}},
+	}}
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "run-command", "--command", "Nope", "ENG-1"})
+
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), `no command named "Nope"`) {
+		t.Errorf("expected an unknown command error, got %v", err)
+	}
+}
+
+func TestRunCommand_OutputPreview(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	afterFile := filepath.Join(dir, "after-actions")
+	if err := os.WriteFile(afterFile+".reload", []byte("reload(list)"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"GetIssue": getIssueWithIDsResponse,
+	})
+	opts, _, _ := testOptionsWithBuffers(t, server)
+	opts.Config = &config.Config{Interactive: config.InteractiveConfig{
+		Commands: []config.Command{{
+			Name:    "Summary",
+			Command: "echo {{.Identifier}} {{.State}}",
+			Output:  config.OutputPreview,
+			Reload:  true,
+		}},
+	}}
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "run-command", "--after-actions-file", afterFile, "ENG-42"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output, err := os.ReadFile(filepath.Join(dir, "command-output"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(output), "ENG-42 In Progress\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+	actions, err := os.ReadFile(afterFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "reload(list)+deselect-all+preview(cat '" + filepath.Join(dir, "command-output") + "')"
+	if string(actions) != want {
+		t.Errorf("after actions = %q, want %q", actions, want)
+	}
+}
+
+func TestRunCommand_GitContext(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	afterFile := filepath.Join(dir, "after-actions")
+	server := newMockGraphQLServer(t, map[string]string{
+		"GetIssue": getIssueWithIDsResponse,
+	})
+	opts, _, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = &mockGitWorktreeCreator{
+		repoRoot: "/src/repo",
+		worktrees: []cmd.GitWorktree{
+			{Path: "/src/repo", Branch: "main"},
+			{Path: "/src/repo-ENG-42", Branch: "fred/eng-42-implement-feature-x"},
+		},
+	}
+	opts.Config = &config.Config{Interactive: config.InteractiveConfig{
+		Commands: []config.Command{{
+			Name:    "Where",
+			Command: "echo {{.RepoRoot}} {{.WorktreePath}}",
+			Output:  config.OutputPreview,
+		}},
+	}}
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "run-command", "--after-actions-file", afterFile, "ENG-42"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output, err := os.ReadFile(filepath.Join(dir, "command-output"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(output), "/src/repo /src/repo-ENG-42\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestConfirm(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		answer string
+		want   bool
+	}{
+		{"y\n", true},
+		{"Yes\n", true},
+		{"n\n", false},
+		{"\n", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		var out bytes.Buffer
+		got, err := cmd.Confirm(strings.NewReader(tt.answer), &out, "Run Claude on ENG-1?")
+		if err != nil {
+			t.Fatalf("Confirm(%q) error: %v", tt.answer, err)
+		}
+		if got != tt.want {
+			t.Errorf("Confirm(%q) = %v, want %v", tt.answer, got, tt.want)
+		}
+		if out.String() != "Run Claude on ENG-1? [y/N] " {
+			t.Errorf("prompt = %q", out.String())
+		}
+	}
+}
@@ -7,14 +7,23 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// newIssueWorktreeCmd creates the "issue worktree" command. Without a
+// subcommand it creates a worktree for the given (or picked) issue.
 func newIssueWorktreeCmd(opts Options) *cobra.Command {
-	var user string
+	var (
+		base         string
+		path         string
+		user         string
+		noTransition bool
+	)
 
 	cmd := &cobra.Command{
 		Use:     "worktree [IDENTIFIER]",
 		Aliases: []string{"wt"},
-		Short:   "Create a git worktree for an issue",
-		Args:    cobra.MaximumNArgs(1),
+		Short:   "Create or manage git worktrees for issues",
+		Long: `Create a git worktree for an issue, or manage existing ones with the
+list, switch, remove and prune subcommands.`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := resolveClient(cmd, opts)
 			if err != nil {
@@ -24,7 +33,7 @@ func newIssueWorktreeCmd(opts Options) *cobra.Command {
 			var identifier string
 			if len(args) > 0 {
 				identifier = args[0]
-			} else {
+			} else if identifier = currentBranchIssue(cmd.Context(), opts, client); identifier == "" {
 				var issues []issueForCompletion
 				if strings.EqualFold(user, "all") {
 					issues, err = fetchAllIssues(cmd.Context(), client)
@@ -45,7 +54,11 @@ func newIssueWorktreeCmd(opts Options) *cobra.Command {
 				}
 			}
 
-			return runWorktreeCreate(cmd.Context(), client, identifier, opts.GitWorktreeCreator, opts.Stdout)
+			wo := newWorktreeOptions(opts.Config, base, path)
+			if noTransition {
+				wo.Transition = ""
+			}
+			return runWorktreeCreate(cmd.Context(), client, identifier, opts.GitWorktreeCreator, wo, opts.Stdout, opts.Stderr)
 		},
 		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 			if len(args) > 0 {
@@ -61,6 +74,20 @@ func newIssueWorktreeCmd(opts Options) *cobra.Command {
 		},
 	}
 
+	cmd.AddCommand(
+		newIssueWorktreeListCmd(opts),
+		newIssueWorktreePruneCmd(opts),
+		newIssueWorktreeRemoveCmd(opts),
+		newIssueWorktreeSwitchCmd(opts),
+	)
+
+	cmd.Flags().StringVar(&base, "base", "", "Base branch for new branches (default: worktree.base_branch or the remote's default branch)")
+	_ = cmd.RegisterFlagCompletionFunc("base", cobra.NoFileCompletions)
+	cmd.Flags().StringVar(&path, "path", "", "Worktree directory or path template (default: worktree.path)")
+	_ = cmd.RegisterFlagCompletionFunc("path", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveFilterDirs
+	})
+	cmd.Flags().BoolVar(&noTransition, "no-transition", false, "Don't move the issue to the workflow.worktree state")
 	cmd.Flags().StringVarP(&user, "user", "u", "", "User whose issues to browse")
 	cmd.RegisterFlagCompletionFunc("user", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return completeUserNames(cmd, opts)
@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/spf13/cobra"
+
+	"github.com/duboisf/linear/internal/api"
+	"github.com/duboisf/linear/internal/format"
+)
+
+// issueWorktree pairs a git worktree with the Linear issue its branch refers to.
+type issueWorktree struct {
+	GitWorktree
+	// Main is true for the repository's main worktree.
+	Main bool
+	// Issue is nil when the branch doesn't reference a known issue.
+	Issue *api.ListIssuesIssuesIssueConnectionNodesIssue
+}
+
+// loadIssueWorktrees lists the repository's worktrees and resolves the issues
+// referenced by their branch names in a single API query. When the lookup
+// fails, a warning is written to stderr and the worktrees are returned
+// without issue data.
+func loadIssueWorktrees(ctx context.Context, client graphql.Client, git GitWorktreeCreator, stderr io.Writer) ([]issueWorktree, error) {
+	worktrees, err := git.ListWorktrees()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]issueWorktree, len(worktrees))
+	var filters []*api.IssueFilter
+	seen := map[string]bool{}
+	for i, wt := range worktrees {
+		result[i] = issueWorktree{GitWorktree: wt, Main: i == 0}
+		id := identifierFromBranch(wt.Branch)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		if f := identifierFilter(id); f != nil {
+			filters = append(filters, f)
+		}
+	}
+	if len(filters) == 0 {
+		return result, nil
+	}
+
+	// Branch names like "release-2024" look like identifiers but match no
+	// issue; their rows are simply left without issue data.
+	resp, err := api.ListIssues(ctx, client, len(filters), nil, &api.IssueFilter{Or: filters}, nil)
+	if err != nil {
+		fmt.Fprintf(stderr, "linear: looking up worktree issues: %v\n", err)
+		return result, nil
+	}
+	issues := map[string]*api.ListIssuesIssuesIssueConnectionNodesIssue{}
+	if resp.Issues != nil {
+		for _, n := range resp.Issues.Nodes {
+			issues[n.Identifier] = n
+		}
+	}
+	for i := range result {
+		if id := identifierFromBranch(result[i].Branch); id != "" {
+			result[i].Issue = issues[id]
+		}
+	}
+	return result, nil
+}
+
+// identifierFilter returns an issue filter matching identifier (e.g.
+// "ENG-42") by team key and number, or nil if it isn't well-formed.
+func identifierFilter(identifier string) *api.IssueFilter {
+	i := strings.LastIndex(identifier, "-")
+	if i <= 0 {
+		return nil
+	}
+	key := identifier[:i]
+	number, err := strconv.ParseFloat(identifier[i+1:], 64)
+	if err != nil {
+		return nil
+	}
+	return &api.IssueFilter{
+		Team:   &api.TeamFilter{Key: &api.StringComparator{Eq: &key}},
+		Number: &api.NumberComparator{Eq: &number},
+	}
+}
+
+// newIssueWorktreeListCmd creates the "issue worktree list" subcommand.
+func newIssueWorktreeListCmd(opts Options) *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List worktrees with the state of their issues",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := resolveClient(cmd, opts)
+			if err != nil {
+				return err
+			}
+			worktrees, err := loadIssueWorktrees(cmd.Context(), client, opts.GitWorktreeCreator, opts.Stderr)
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(opts.Stdout, formatWorktreeList(worktrees, format.ColorEnabled(cmd.OutOrStdout())))
+			return nil
+		},
+		ValidArgsFunction: cobra.NoFileCompletions,
+	}
+}
+
+// formatWorktreeList renders worktrees as an aligned ISSUE/STATUS/BRANCH/PATH table.
+func formatWorktreeList(worktrees []issueWorktree, color bool) string {
+	const gap = "  "
+
+	type row struct {
+		id, state, stateColor, branch, path string
+	}
+	rows := make([]row, len(worktrees))
+	maxID, maxState, maxBranch := len("ISSUE"), len("STATUS"), len("BRANCH")
+	for i, wt := range worktrees {
+		r := row{branch: wt.Branch, path: wt.Path}
+		switch {
+		case wt.Bare:
+			r.branch = "(bare)"
+		case wt.Detached:
+			r.branch = "(detached)"
+		}
+		if wt.Issue != nil {
+			r.id = wt.Issue.Identifier
+			if wt.Issue.State != nil {
+				r.state = wt.Issue.State.Name
+				r.stateColor = format.StateColor(wt.Issue.State.Type)
+			}
+		}
+		maxID = max(maxID, len(r.id))
+		maxState = max(maxState, len(r.state))
+		maxBranch = max(maxBranch, len(r.branch))
+		rows[i] = r
+	}
+
+	var buf strings.Builder
+	buf.WriteString(format.PadColor(color, format.Bold, "ISSUE", maxID) + gap)
+	buf.WriteString(format.PadColor(color, format.Bold, "STATUS", maxState) + gap)
+	buf.WriteString(format.PadColor(color, format.Bold, "BRANCH", maxBranch) + gap)
+	buf.WriteString(format.Colorize(color, format.Bold, "PATH"))
+	buf.WriteByte('\n')
+	for _, r := range rows {
+		buf.WriteString(fmt.Sprintf("%-*s", maxID, r.id) + gap)
+		buf.WriteString(format.PadColor(color, r.stateColor, r.state, maxState) + gap)
+		buf.WriteString(fmt.Sprintf("%-*s", maxBranch, r.branch) + gap)
+		buf.WriteString(format.Colorize(color, format.Gray, r.path))
+		buf.WriteByte('\n')
+	}
+	return buf.String()
+}
+
+// completeWorktreeIssues completes identifiers of issues that have a linked
+// worktree, using branch names only (no API calls).
+func completeWorktreeIssues(opts Options) ([]string, cobra.ShellCompDirective) {
+	if opts.GitWorktreeCreator == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	worktrees, err := opts.GitWorktreeCreator.ListWorktrees()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var comps []string
+	for i, wt := range worktrees {
+		if i == 0 {
+			continue
+		}
+		if id := identifierFromBranch(wt.Branch); id != "" {
+			comps = append(comps, id+"\t"+wt.Path)
+		}
+	}
+	return comps, cobra.ShellCompDirectiveNoFileComp
+}
+
+// worktreeNotFoundError is returned when an issue has no linked worktree.
+func worktreeNotFoundError(identifier string) error {
+	return fmt.Errorf("no worktree found for %s (create one with 'linear issue worktree %s')", identifier, identifier)
+}
@@ -0,0 +1,177 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/cmd"
+	"github.com/duboisf/linear/internal/api"
+)
+
+// issueWorktrees is a fixture with a main worktree and one issue worktree.
+func issueWorktrees() []cmd.GitWorktree {
+	return []cmd.GitWorktree{
+		{Path: "/tmp/test-repo", Branch: "main"},
+		{Path: "/tmp/eng-42/test-repo", Branch: "fred/eng-42-implement-feature-x"},
+		{Path: "/tmp/scratch", Detached: true},
+	}
+}
+
+func TestIssueWorktreeList(t *testing.T) {
+	t.Parallel()
+
+	server, rec := newRecordingGraphQLServer(t, map[string]string{
+		"ListIssues": worktreeIssuesResponse("In Progress", "started"),
+	})
+
+	mock := &mockGitWorktreeCreator{worktrees: append(issueWorktrees(),
+		cmd.GitWorktree{Path: "/tmp/eng-42-copy", Branch: "eng-42-retry"},
+		cmd.GitWorktree{Path: "/tmp/release", Branch: "release-2024"},
+	)}
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "list"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue worktree list returned error: %v", err)
+	}
+
+	calls := rec.calls("ListIssues")
+	if len(calls) != 1 {
+		t.Fatalf("expected one ListIssues lookup, got %d", len(calls))
+	}
+	var vars struct {
+		Filter api.IssueFilter `json:"filter"`
+	}
+	if err := json.Unmarshal(calls[0].Variables, &vars); err != nil {
+		t.Fatalf("decoding variables: %v", err)
+	}
+	var got []string
+	for _, f := range vars.Filter.Or {
+		got = append(got, fmt.Sprintf("%s-%.0f", *f.Team.Key.Eq, *f.Number.Eq))
+	}
+	if want := []string{"ENG-42", "RELEASE-2024"}; !slices.Equal(got, want) {
+		t.Errorf("looked up %v, want %v once each", got, want)
+	}
+
+	lines := strings.Split(strings.TrimRight(stdout.String(), "\n"), "\n")
+	if len(lines) != 6 {
+		t.Fatalf("expected header + 5 rows, got %d lines:\n%s", len(lines), stdout.String())
+	}
+	for _, want := range []string{"ISSUE", "STATUS", "BRANCH", "PATH"} {
+		if !strings.Contains(lines[0], want) {
+			t.Errorf("header %q missing %q", lines[0], want)
+		}
+	}
+	if !strings.Contains(lines[2], "ENG-42") || !strings.Contains(lines[2], "In Progress") || !strings.Contains(lines[2], "/tmp/eng-42/test-repo") {
+		t.Errorf("issue row = %q, want ENG-42, In Progress and path", lines[2])
+	}
+	if strings.Contains(lines[1], "ENG-42") {
+		t.Errorf("main worktree row should have no issue, got %q", lines[1])
+	}
+	if !strings.Contains(lines[3], "(detached)") {
+		t.Errorf("detached row = %q, want (detached)", lines[3])
+	}
+	if !strings.Contains(lines[4], "ENG-42") {
+		t.Errorf("second ENG-42 row = %q, want ENG-42", lines[4])
+	}
+	if strings.HasPrefix(lines[5], "RELEASE") {
+		t.Errorf("release row = %q, want no issue", lines[5])
+	}
+}
+
+func TestIssueWorktreeList_LookupError(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{})
+	mock := &mockGitWorktreeCreator{worktrees: issueWorktrees()}
+	opts, stdout, stderr := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "list"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue worktree list returned error: %v", err)
+	}
+	if !strings.Contains(stderr.String(), "linear: looking up worktree issues:") {
+		t.Errorf("stderr = %q, want a lookup warning", stderr.String())
+	}
+	lines := strings.Split(strings.TrimRight(stdout.String(), "\n"), "\n")
+	if len(lines) != 4 || !strings.Contains(lines[2], "/tmp/eng-42/test-repo") || strings.Contains(lines[2], "ENG-42") {
+		t.Errorf("output = %q, want every worktree without issue data", stdout.String())
+	}
+}
+
+func TestIssueWorktreeList_ListError(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{})
+	mock := &mockGitWorktreeCreator{listErr: fmt.Errorf("listing worktrees: not a git repository")}
+	opts, _, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "list"})
+
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "not a git repository") {
+		t.Fatalf("error = %v, want 'not a git repository'", err)
+	}
+}
+
+func TestIssueWorktreeSwitch(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockGitWorktreeCreator{worktrees: issueWorktrees()}
+	opts, stdout, _ := testOptionsWithBuffers(t, newMockGraphQLServer(t, nil))
+	opts.GitWorktreeCreator = mock
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "switch", "eng-42"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue worktree switch returned error: %v", err)
+	}
+	if got := strings.TrimSpace(stdout.String()); got != "/tmp/eng-42/test-repo" {
+		t.Errorf("output = %q, want worktree path", got)
+	}
+}
+
+func TestIssueWorktreeSwitch_NotFound(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockGitWorktreeCreator{worktrees: issueWorktrees()}
+	opts, _, _ := testOptionsWithBuffers(t, newMockGraphQLServer(t, nil))
+	opts.GitWorktreeCreator = mock
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "switch", "ENG-99"})
+
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "no worktree found for ENG-99") {
+		t.Fatalf("error = %v, want 'no worktree found for ENG-99'", err)
+	}
+}
+
+func TestIssueWorktreeSwitch_Completion(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockGitWorktreeCreator{worktrees: issueWorktrees()}
+	opts := testOptions(t, newMockGraphQLServer(t, nil))
+	opts.GitWorktreeCreator = mock
+	root := cmd.NewRootCmd(opts)
+
+	stdout, _, err := executeCommand(root, "__complete", "issue", "worktree", "switch", "")
+	if err != nil {
+		t.Fatalf("completion returned error: %v", err)
+	}
+	if !strings.Contains(stdout, "ENG-42\t/tmp/eng-42/test-repo") {
+		t.Errorf("completion should offer ENG-42, got %q", stdout)
+	}
+}
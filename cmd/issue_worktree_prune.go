@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// prunableStateTypes are the workflow state types whose worktrees are pruned.
+var prunableStateTypes = map[string]bool{
+	"completed": true,
+	"canceled":  true,
+}
+
+// newIssueWorktreePruneCmd creates the "issue worktree prune" subcommand that
+// removes worktrees (and their branches) whose issues are completed or canceled.
+func newIssueWorktreePruneCmd(opts Options) *cobra.Command {
+	var (
+		dryRun bool
+		force  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Remove worktrees and branches of completed or canceled issues",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := resolveClient(cmd, opts)
+			if err != nil {
+				return err
+			}
+			git := opts.GitWorktreeCreator
+
+			worktrees, err := loadIssueWorktrees(cmd.Context(), client, git, opts.Stderr)
+			if err != nil {
+				return err
+			}
+
+			pruned := 0
+			for _, wt := range worktrees {
+				if wt.Main || wt.Issue == nil || wt.Issue.State == nil || !prunableStateTypes[wt.Issue.State.Type] {
+					continue
+				}
+				label := fmt.Sprintf("%s (%s)", wt.Issue.Identifier, wt.Issue.State.Name)
+
+				dirty, err := git.IsDirty(wt.Path)
+				if err != nil {
+					return err
+				}
+				if dirty && !force {
+					fmt.Fprintf(opts.Stderr, "Skipping %s: %s has uncommitted changes\n", label, wt.Path)
+					continue
+				}
+
+				if dryRun {
+					fmt.Fprintf(opts.Stdout, "Would remove %s: %s\n", label, wt.Path)
+					pruned++
+					continue
+				}
+
+				if err := git.RemoveWorktree(wt.Path, force); err != nil {
+					return err
+				}
+				fmt.Fprintf(opts.Stdout, "Removed %s: %s\n", label, wt.Path)
+				// Branches with unmerged commits are kept unless --force:
+				// a canceled issue's work may not be pushed anywhere.
+				if wt.Branch != "" {
+					if err := git.DeleteBranch(wt.Branch, force); err != nil {
+						if !errors.Is(err, ErrBranchNotMerged) {
+							return err
+						}
+						fmt.Fprintf(opts.Stderr, "Kept unmerged branch %s (use --force to delete it)\n", wt.Branch)
+					}
+				}
+				pruned++
+			}
+
+			switch {
+			case pruned == 0:
+				fmt.Fprintln(opts.Stdout, "Nothing to prune.")
+			case dryRun:
+				fmt.Fprintf(opts.Stdout, "Would prune %d worktree(s).\n", pruned)
+			default:
+				fmt.Fprintf(opts.Stdout, "Pruned %d worktree(s).\n", pruned)
+			}
+			return nil
+		},
+		ValidArgsFunction: cobra.NoFileCompletions,
+	}
+
+	cmd.Flags().BoolVarP(&dryRun, "dry-run", "n", false, "Show what would be removed without removing anything")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Also remove worktrees with uncommitted changes and delete unmerged branches")
+
+	return cmd
+}
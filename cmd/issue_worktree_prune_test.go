@@ -0,0 +1,194 @@
+package cmd_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/cmd"
+)
+
+// worktreeIssuesResponse answers the ListIssues lookup of the issueWorktrees
+// branches with ENG-42 in the given state.
+func worktreeIssuesResponse(stateName, stateType string) string {
+	return fmt.Sprintf(`{
+	"data": {
+		"issues": {
+			"nodes": [
+				{
+					"id": "issue-1",
+					"identifier": "ENG-42",
+					"title": "Implement feature X",
+					"branchName": "fred/eng-42-implement-feature-x",
+					"state": {"name": %q, "type": %q}
+				}
+			],
+			"pageInfo": {"hasNextPage": false}
+		}
+	}
+}`, stateName, stateType)
+}
+
+var getCompletedIssueResponse = worktreeIssuesResponse("Done", "completed")
+
+func TestIssueWorktreePrune(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"ListIssues": getCompletedIssueResponse,
+	})
+
+	mock := &mockGitWorktreeCreator{worktrees: issueWorktrees()}
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "prune"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue worktree prune returned error: %v", err)
+	}
+
+	if len(mock.removeCalls) != 1 || mock.removeCalls[0].path != "/tmp/eng-42/test-repo" {
+		t.Errorf("remove calls = %+v, want only the ENG-42 worktree", mock.removeCalls)
+	}
+	if len(mock.deleteCalls) != 1 || mock.deleteCalls[0] != (deleteBranchCall{"fred/eng-42-implement-feature-x", false}) {
+		t.Errorf("delete calls = %+v, want a safe delete of the issue branch", mock.deleteCalls)
+	}
+	if !strings.Contains(stdout.String(), "Pruned 1 worktree(s).") {
+		t.Errorf("output = %q", stdout.String())
+	}
+}
+
+func TestIssueWorktreePrune_KeepsUnmergedBranch(t *testing.T) {
+	t.Parallel()
+
+	notMerged := fmt.Errorf("deleting branch: %w", cmd.ErrBranchNotMerged)
+	tests := []struct {
+		name      string
+		args      []string
+		deleteErr error
+		wantErr   bool
+	}{
+		{name: "without force", args: []string{"issue", "worktree", "prune"}, deleteErr: notMerged},
+		{name: "other error", args: []string{"issue", "worktree", "prune"}, deleteErr: errors.New("deleting branch: branch is locked"), wantErr: true},
+		{name: "with force", args: []string{"issue", "worktree", "prune", "--force"}, deleteErr: errors.New("deleting branch: branch is locked"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newMockGraphQLServer(t, map[string]string{
+				"ListIssues": getCompletedIssueResponse,
+			})
+			mock := &mockGitWorktreeCreator{worktrees: issueWorktrees(), deleteErr: tt.deleteErr}
+			opts, _, stderr := testOptionsWithBuffers(t, server)
+			opts.GitWorktreeCreator = mock
+
+			root := cmd.NewRootCmd(opts)
+			root.SetArgs(tt.args)
+
+			err := root.Execute()
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected the delete error")
+				}
+				if strings.Contains(stderr.String(), "Kept unmerged branch") {
+					t.Errorf("stderr = %q, only unmerged branches are kept", stderr.String())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("issue worktree prune returned error: %v", err)
+			}
+			if len(mock.removeCalls) != 1 {
+				t.Errorf("remove calls = %+v, want the worktree removed", mock.removeCalls)
+			}
+			if !strings.Contains(stderr.String(), "Kept unmerged branch fred/eng-42-implement-feature-x (use --force to delete it)") {
+				t.Errorf("stderr = %q", stderr.String())
+			}
+		})
+	}
+}
+
+func TestIssueWorktreePrune_DryRun(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"ListIssues": getCompletedIssueResponse,
+	})
+
+	mock := &mockGitWorktreeCreator{worktrees: issueWorktrees()}
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "prune", "--dry-run"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue worktree prune --dry-run returned error: %v", err)
+	}
+	if len(mock.removeCalls) != 0 || len(mock.deleteCalls) != 0 {
+		t.Errorf("dry run must not modify anything, got remove=%+v delete=%+v", mock.removeCalls, mock.deleteCalls)
+	}
+	if !strings.Contains(stdout.String(), "Would remove ENG-42 (Done)") {
+		t.Errorf("output = %q", stdout.String())
+	}
+}
+
+func TestIssueWorktreePrune_SkipsDirty(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"ListIssues": getCompletedIssueResponse,
+	})
+
+	mock := &mockGitWorktreeCreator{
+		worktrees: issueWorktrees(),
+		dirty:     map[string]bool{"/tmp/eng-42/test-repo": true},
+	}
+	opts, stdout, stderr := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "prune"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue worktree prune returned error: %v", err)
+	}
+	if len(mock.removeCalls) != 0 {
+		t.Errorf("dirty worktree must be skipped, got %+v", mock.removeCalls)
+	}
+	if !strings.Contains(stderr.String(), "uncommitted changes") {
+		t.Errorf("stderr = %q, want skip notice", stderr.String())
+	}
+	if !strings.Contains(stdout.String(), "Nothing to prune.") {
+		t.Errorf("stdout = %q", stdout.String())
+	}
+}
+
+func TestIssueWorktreePrune_KeepsActiveIssues(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"ListIssues": worktreeIssuesResponse("In Progress", "started"),
+	})
+
+	mock := &mockGitWorktreeCreator{worktrees: issueWorktrees()}
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "prune"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue worktree prune returned error: %v", err)
+	}
+	if len(mock.removeCalls) != 0 {
+		t.Errorf("in-progress issue worktree must be kept, got %+v", mock.removeCalls)
+	}
+	if !strings.Contains(stdout.String(), "Nothing to prune.") {
+		t.Errorf("stdout = %q", stdout.String())
+	}
+}
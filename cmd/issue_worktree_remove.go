@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/duboisf/linear/internal/api"
+)
+
+// newIssueWorktreeRemoveCmd creates the "issue worktree remove" subcommand.
+// It refuses to remove worktrees with uncommitted changes unless --force.
+func newIssueWorktreeRemoveCmd(opts Options) *cobra.Command {
+	var (
+		deleteBranch bool
+		force        bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "remove IDENTIFIER",
+		Aliases: []string{"rm"},
+		Short:   "Remove the worktree of an issue",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			identifier := args[0]
+			git := opts.GitWorktreeCreator
+
+			// Look up the issue's branch name so worktrees on branches that
+			// don't embed the identifier are still found. The lookup is best
+			// effort: removal by identifier works offline too.
+			var branchName string
+			if client, err := resolveClient(cmd, opts); err == nil {
+				if resp, err := api.GetIssue(cmd.Context(), client, identifier); err == nil && resp.Issue != nil {
+					branchName = resp.Issue.BranchName
+				}
+			}
+
+			worktrees, err := git.ListWorktrees()
+			if err != nil {
+				return err
+			}
+			wt, ok := findIssueWorktree(worktrees, identifier, branchName)
+			if !ok {
+				return worktreeNotFoundError(identifier)
+			}
+
+			dirty, err := git.IsDirty(wt.Path)
+			if err != nil {
+				return err
+			}
+			if dirty && !force {
+				return fmt.Errorf("worktree %s has uncommitted changes; commit or stash them, or use --force", wt.Path)
+			}
+
+			if err := git.RemoveWorktree(wt.Path, force); err != nil {
+				return err
+			}
+			fmt.Fprintf(opts.Stdout, "Removed worktree %s\n", wt.Path)
+
+			if deleteBranch && wt.Branch != "" {
+				if err := git.DeleteBranch(wt.Branch, force); err != nil {
+					return err
+				}
+				fmt.Fprintf(opts.Stdout, "Deleted branch %q\n", wt.Branch)
+			}
+			return nil
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return completeWorktreeIssues(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&deleteBranch, "delete-branch", "d", false, "Also delete the worktree's branch")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Remove even with uncommitted changes (and delete unmerged branches)")
+
+	return cmd
+}
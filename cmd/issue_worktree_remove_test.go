@@ -0,0 +1,143 @@
+package cmd_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/cmd"
+)
+
+func TestIssueWorktreeRemove(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"GetIssue": getIssueResponse,
+	})
+
+	mock := &mockGitWorktreeCreator{worktrees: issueWorktrees()}
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "remove", "ENG-42"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue worktree remove returned error: %v", err)
+	}
+
+	if len(mock.removeCalls) != 1 || mock.removeCalls[0] != (removeCall{"/tmp/eng-42/test-repo", false}) {
+		t.Errorf("remove calls = %+v, want one non-forced removal of /tmp/eng-42/test-repo", mock.removeCalls)
+	}
+	if len(mock.deleteCalls) != 0 {
+		t.Errorf("branch should be kept without --delete-branch, got %+v", mock.deleteCalls)
+	}
+	if !strings.Contains(stdout.String(), "Removed worktree /tmp/eng-42/test-repo") {
+		t.Errorf("output = %q", stdout.String())
+	}
+}
+
+func TestIssueWorktreeRemove_MatchesIssueBranchName(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"GetIssue": getIssueResponse,
+	})
+
+	// The fixture's branch name doesn't contain the identifier, so the
+	// worktree can only be found through the issue's branchName.
+	mock := &mockGitWorktreeCreator{worktrees: []cmd.GitWorktree{
+		{Path: "/tmp/test-repo", Branch: "main"},
+		{Path: "/tmp/feature-x", Branch: "feat/implement-feature-x"},
+	}}
+	opts, _, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "rm", "-d", "ENG-42"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue worktree rm returned error: %v", err)
+	}
+	if len(mock.removeCalls) != 1 || mock.removeCalls[0].path != "/tmp/feature-x" {
+		t.Errorf("remove calls = %+v, want /tmp/feature-x", mock.removeCalls)
+	}
+	if len(mock.deleteCalls) != 1 || mock.deleteCalls[0] != (deleteBranchCall{"feat/implement-feature-x", false}) {
+		t.Errorf("delete calls = %+v, want non-forced delete of feat/implement-feature-x", mock.deleteCalls)
+	}
+}
+
+func TestIssueWorktreeRemove_DirtyRefused(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"GetIssue": getIssueResponse,
+	})
+
+	mock := &mockGitWorktreeCreator{
+		worktrees: issueWorktrees(),
+		dirty:     map[string]bool{"/tmp/eng-42/test-repo": true},
+	}
+	opts, _, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "remove", "ENG-42"})
+
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "uncommitted changes") {
+		t.Fatalf("error = %v, want 'uncommitted changes'", err)
+	}
+	if len(mock.removeCalls) != 0 {
+		t.Errorf("dirty worktree must not be removed, got %+v", mock.removeCalls)
+	}
+}
+
+func TestIssueWorktreeRemove_DirtyForced(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"GetIssue": getIssueResponse,
+	})
+
+	mock := &mockGitWorktreeCreator{
+		worktrees: issueWorktrees(),
+		dirty:     map[string]bool{"/tmp/eng-42/test-repo": true},
+	}
+	opts, _, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "remove", "--force", "ENG-42"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue worktree remove --force returned error: %v", err)
+	}
+	if len(mock.removeCalls) != 1 || !mock.removeCalls[0].force {
+		t.Errorf("remove calls = %+v, want one forced removal", mock.removeCalls)
+	}
+}
+
+func TestIssueWorktreeRemove_NeverRemovesMainWorktree(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"GetIssue": getIssueResponse,
+	})
+
+	mock := &mockGitWorktreeCreator{worktrees: []cmd.GitWorktree{
+		{Path: "/tmp/test-repo", Branch: "feat/implement-feature-x"},
+	}}
+	opts, _, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "remove", "ENG-42"})
+
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "no worktree found") {
+		t.Fatalf("error = %v, want 'no worktree found'", err)
+	}
+	if len(mock.removeCalls) != 0 {
+		t.Errorf("main worktree must not be removed, got %+v", mock.removeCalls)
+	}
+}
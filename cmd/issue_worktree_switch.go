@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// newIssueWorktreeSwitchCmd creates the "issue worktree switch" subcommand.
+// A child process can't change the shell's directory, so it prints the
+// worktree path for use as: cd "$(linear issue worktree switch AIS-42)".
+func newIssueWorktreeSwitchCmd(opts Options) *cobra.Command {
+	return &cobra.Command{
+		Use:     "switch IDENTIFIER",
+		Aliases: []string{"path"},
+		Short:   "Print the worktree path of an issue (for cd)",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			identifier := args[0]
+			worktrees, err := opts.GitWorktreeCreator.ListWorktrees()
+			if err != nil {
+				return err
+			}
+			wt, ok := findIssueWorktree(worktrees, identifier, "")
+			if !ok {
+				return worktreeNotFoundError(identifier)
+			}
+			fmt.Fprintln(opts.Stdout, wt.Path)
+			return nil
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return completeWorktreeIssues(opts)
+		},
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/duboisf/linear/cmd"
+	"github.com/duboisf/linear/internal/config"
 )
 
 func TestIssueWorktree_Success(t *testing.T) {
@@ -48,11 +49,14 @@ func TestIssueWorktree_Success(t *testing.T) {
 		t.Errorf("create startPoint = %q, want %q", mock.createCalls[0].startPoint, "origin/main")
 	}
 
-	if len(mock.postCreateCalls) != 1 {
-		t.Fatalf("expected 1 postCreate call, got %d", len(mock.postCreateCalls))
+	if len(mock.hookCalls) != 1 {
+		t.Fatalf("expected 1 hook call (mise-trust), got %d", len(mock.hookCalls))
 	}
-	if mock.postCreateCalls[0].dir != wantPath {
-		t.Errorf("postCreate dir = %q, want %q", mock.postCreateCalls[0].dir, wantPath)
+	if mock.hookCalls[0].dir != wantPath {
+		t.Errorf("hook dir = %q, want %q", mock.hookCalls[0].dir, wantPath)
+	}
+	if !strings.Contains(mock.hookCalls[0].command, "mise trust") {
+		t.Errorf("default hook = %q, want mise trust", mock.hookCalls[0].command)
 	}
 
 	output := stdout.String()
@@ -291,11 +295,14 @@ func TestIssueWorktree_PostCreateError(t *testing.T) {
 	})
 
 	mock := &mockGitWorktreeCreator{
-		repoRoot:      "/tmp/test-repo",
-		postCreateErr: fmt.Errorf("running mise trust: permission denied"),
+		repoRoot: "/tmp/test-repo",
+		hookErrs: map[string]error{"npm install": fmt.Errorf("exit status 1")},
 	}
-	opts, _, _ := testOptionsWithBuffers(t, server)
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
 	opts.GitWorktreeCreator = mock
+	opts.Config = &config.Config{Worktree: config.WorktreeConfig{
+		PostCreate: []config.Hook{{Command: "npm install"}, {Command: "code ."}},
+	}}
 
 	root := cmd.NewRootCmd(opts)
 	root.SetArgs([]string{"issue", "worktree", "ENG-42"})
@@ -304,8 +311,139 @@ func TestIssueWorktree_PostCreateError(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error when post-create hook fails")
 	}
-	if !strings.Contains(err.Error(), "permission denied") {
-		t.Errorf("error %q should contain 'permission denied'", err.Error())
+	if !strings.Contains(err.Error(), `"npm install" failed`) || !strings.Contains(err.Error(), "exit status 1") {
+		t.Errorf("error %q should name the failed hook and its cause", err.Error())
+	}
+	if len(mock.hookCalls) != 1 {
+		t.Errorf("hooks after an aborting failure must not run, got %+v", mock.hookCalls)
+	}
+	if strings.Contains(stdout.String(), "/tmp/eng-42/test-repo\n") {
+		t.Errorf("worktree path should not be printed on failure, got %q", stdout.String())
+	}
+}
+
+func TestIssueWorktree_PostCreateHooks(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"GetIssue": getIssueResponse,
+	})
+
+	mock := &mockGitWorktreeCreator{
+		repoRoot: "/tmp/test-repo",
+		hookErrs: map[string]error{
+			"cp .env.missing .env": fmt.Errorf("exit status 1"),
+			"false":                fmt.Errorf("exit status 1"),
+		},
+	}
+	opts, stdout, stderr := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+	opts.Config = &config.Config{Worktree: config.WorktreeConfig{
+		PostCreate: []config.Hook{
+			{Name: "Copy env", Command: "cp .env.missing .env", OnFailure: "warn"},
+			{Command: "echo {{.Title}}"},
+			{Command: "false", OnFailure: "ignore"},
+		},
+	}}
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "ENG-42"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue worktree returned error: %v", err)
+	}
+
+	wantCommands := []string{"cp .env.missing .env", "echo 'Implement feature X'", "false"}
+	if len(mock.hookCalls) != len(wantCommands) {
+		t.Fatalf("hook calls = %+v, want %d", mock.hookCalls, len(wantCommands))
+	}
+	for i, want := range wantCommands {
+		if mock.hookCalls[i].command != want {
+			t.Errorf("hook %d command = %q, want %q", i, mock.hookCalls[i].command, want)
+		}
+		if mock.hookCalls[i].dir != "/tmp/eng-42/test-repo" {
+			t.Errorf("hook %d dir = %q, want the new worktree", i, mock.hookCalls[i].dir)
+		}
+	}
+	env := strings.Join(mock.hookCalls[0].env, "\n")
+	for _, want := range []string{"LINEAR_ISSUE=ENG-42", "LINEAR_REPO_ROOT=/tmp/test-repo", "LINEAR_WORKTREE=/tmp/eng-42/test-repo"} {
+		if !strings.Contains(env, want) {
+			t.Errorf("hook env %q missing %q", env, want)
+		}
+	}
+
+	if !strings.Contains(stderr.String(), `Warning: post_create hook "Copy env" failed`) {
+		t.Errorf("stderr = %q, want warning for Copy env", stderr.String())
+	}
+	if strings.Contains(stderr.String(), `"false"`) {
+		t.Errorf("ignored hook failure should not warn, stderr = %q", stderr.String())
+	}
+	if !strings.HasSuffix(stdout.String(), "/tmp/eng-42/test-repo\n") {
+		t.Errorf("stdout should end with the worktree path, got %q", stdout.String())
+	}
+}
+
+func TestIssueWorktree_PostCreateHooksDisabled(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"GetIssue": getIssueResponse,
+	})
+
+	mock := &mockGitWorktreeCreator{repoRoot: "/tmp/test-repo"}
+	opts, _, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+	opts.Config = &config.Config{Worktree: config.WorktreeConfig{PostCreate: []config.Hook{}}}
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "ENG-42"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue worktree returned error: %v", err)
+	}
+	if len(mock.hookCalls) != 0 {
+		t.Errorf("empty post_create should disable hooks, got %+v", mock.hookCalls)
+	}
+}
+
+func TestIssueWorktree_PostCreateInvalidHook(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		hook config.Hook
+		want string
+	}{
+		{"unknown builtin", config.Hook{Builtin: "nope"}, `unknown builtin "nope"`},
+		{"empty", config.Hook{}, "no command or builtin"},
+		{"bad policy", config.Hook{Command: "true", OnFailure: "retry"}, `invalid on_failure "retry"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newMockGraphQLServer(t, map[string]string{
+				"GetIssue": getIssueResponse,
+			})
+
+			mock := &mockGitWorktreeCreator{repoRoot: "/tmp/test-repo"}
+			opts, _, _ := testOptionsWithBuffers(t, server)
+			opts.GitWorktreeCreator = mock
+			// The invalid hook comes second: the first must not run either.
+			opts.Config = &config.Config{Worktree: config.WorktreeConfig{PostCreate: []config.Hook{{Command: "true"}, tt.hook}}}
+
+			root := cmd.NewRootCmd(opts)
+			root.SetArgs([]string{"issue", "worktree", "ENG-42"})
+
+			err := root.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("error = %v, want %q", err, tt.want)
+			}
+			if len(mock.hookCalls) != 0 {
+				t.Errorf("no hook may run when one is invalid, got %+v", mock.hookCalls)
+			}
+		})
 	}
 }
 
@@ -524,3 +662,142 @@ func TestIssueWorktree_UserFlagCompletion(t *testing.T) {
 		t.Errorf("--user completion should contain 'jane', got %q", stdout)
 	}
 }
+
+func TestIssueWorktree_DetectsRemoteDefaultBranch(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"GetIssue": getIssueResponse,
+	})
+
+	mock := &mockGitWorktreeCreator{repoRoot: "/tmp/test-repo", defaultBranch: "master"}
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "ENG-42"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue worktree returned error: %v", err)
+	}
+
+	if len(mock.fetchCalls) != 1 || mock.fetchCalls[0].branch != "master" {
+		t.Fatalf("fetch calls = %+v, want one fetch of master", mock.fetchCalls)
+	}
+	if mock.createCalls[0].startPoint != "origin/master" {
+		t.Errorf("create startPoint = %q, want %q", mock.createCalls[0].startPoint, "origin/master")
+	}
+	if !strings.Contains(stdout.String(), "from origin/master") {
+		t.Errorf("output %q should mention origin/master", stdout.String())
+	}
+}
+
+func TestIssueWorktree_Config(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"GetIssue": getIssueResponse,
+	})
+
+	track := false
+	mock := &mockGitWorktreeCreator{repoRoot: "/tmp/test-repo", defaultBranch: "main"}
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+	opts.Config = &config.Config{
+		Worktree: config.WorktreeConfig{
+			Path:       "/tmp/worktrees/{{.RepoName}}-{{lower .Identifier}}",
+			Remote:     "upstream",
+			BaseBranch: "develop",
+			Track:      &track,
+		},
+	}
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "ENG-42"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue worktree returned error: %v", err)
+	}
+
+	if len(mock.fetchCalls) != 1 {
+		t.Fatalf("expected 1 fetch call, got %d", len(mock.fetchCalls))
+	}
+	if mock.fetchCalls[0].remote != "upstream" || mock.fetchCalls[0].branch != "develop" {
+		t.Errorf("fetch call = %+v, want {upstream develop}", mock.fetchCalls[0])
+	}
+
+	wantPath := "/tmp/worktrees/test-repo-eng-42"
+	got := mock.createCalls[0]
+	if got.path != wantPath {
+		t.Errorf("create path = %q, want %q", got.path, wantPath)
+	}
+	if got.startPoint != "upstream/develop" {
+		t.Errorf("create startPoint = %q, want %q", got.startPoint, "upstream/develop")
+	}
+	if got.track == nil || *got.track {
+		t.Errorf("create track = %v, want false", got.track)
+	}
+	if !strings.Contains(stdout.String(), wantPath) {
+		t.Errorf("output %q does not contain worktree path %q", stdout.String(), wantPath)
+	}
+}
+
+func TestIssueWorktree_BaseAndPathFlags(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"GetIssue": getIssueResponse,
+	})
+
+	mock := &mockGitWorktreeCreator{repoRoot: "/tmp/test-repo", defaultBranch: "main"}
+	opts, _, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+	opts.Config = &config.Config{
+		Worktree: config.WorktreeConfig{BaseBranch: "develop"},
+	}
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "--base", "release", "--path", ".worktrees/{{.Identifier}}", "ENG-42"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue worktree returned error: %v", err)
+	}
+
+	got := mock.createCalls[0]
+	if got.startPoint != "origin/release" {
+		t.Errorf("create startPoint = %q, want %q", got.startPoint, "origin/release")
+	}
+	wantPath := "/tmp/test-repo/.worktrees/ENG-42"
+	if got.path != wantPath {
+		t.Errorf("create path = %q, want %q (relative paths resolve against the repo root)", got.path, wantPath)
+	}
+	if got.track != nil {
+		t.Errorf("create track = %v, want nil (git default)", *got.track)
+	}
+}
+
+func TestIssueWorktree_InvalidPathTemplate(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"GetIssue": getIssueResponse,
+	})
+
+	mock := &mockGitWorktreeCreator{repoRoot: "/tmp/test-repo"}
+	opts, _, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = mock
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "worktree", "--path", "{{.Nope", "ENG-42"})
+
+	err := root.Execute()
+	if err == nil {
+		t.Fatal("expected error for invalid path template")
+	}
+	if !strings.Contains(err.Error(), "rendering worktree path") {
+		t.Errorf("error %q should contain 'rendering worktree path'", err.Error())
+	}
+	if len(mock.createCalls) != 0 {
+		t.Errorf("expected no create calls, got %d", len(mock.createCalls))
+	}
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/duboisf/linear/internal/format"
+	"github.com/duboisf/linear/internal/jq"
+	"github.com/duboisf/linear/internal/prompt"
+)
+
+// outputFlags holds the --template and --jq flags shared by the commands that
+// print issues and users.
+type outputFlags struct {
+	template string
+	jq       string
+}
+
+// register adds --template and --jq to cmd. If cmd has an --output flag,
+// --template is made mutually exclusive with it; --jq implies JSON output.
+// The flags are per command rather than persistent, since only issue get,
+// issue list, user get and user list have JSON output to filter.
+func (f *outputFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.template, "template", "t", "", `Format each item with a Go template (e.g. '{{.Identifier}} {{.Title}}')`)
+	_ = cmd.RegisterFlagCompletionFunc("template", cobra.NoFileCompletions)
+	cmd.Flags().StringVarP(&f.jq, "jq", "q", "", "Filter JSON output with a jq expression (e.g. '.[].id')")
+	_ = cmd.RegisterFlagCompletionFunc("jq", cobra.NoFileCompletions)
+	cmd.MarkFlagsMutuallyExclusive("template", "jq")
+	if cmd.Flags().Lookup("output") != nil {
+		cmd.MarkFlagsMutuallyExclusive("template", "output")
+	}
+}
+
+// active reports whether --template or --jq was given.
+func (f *outputFlags) active() bool {
+	return f.template != "" || f.jq != ""
+}
+
+// validate parses --template and --jq so mistakes are reported before any
+// API request is made.
+func (f *outputFlags) validate() error {
+	if f.template != "" {
+		if _, err := prompt.ParseOutput(f.template, false); err != nil {
+			return err
+		}
+	}
+	if f.jq != "" {
+		if _, err := jq.Parse(f.jq); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveFormat returns the output format to use given the --output value:
+// --jq switches to JSON, and rejects an explicit non-JSON --output.
+func (f *outputFlags) resolveFormat(cmd *cobra.Command, outputFormat string) (string, error) {
+	if f.jq == "" {
+		return outputFormat, nil
+	}
+	if cmd.Flags().Changed("output") && outputFormat != "json" {
+		return "", fmt.Errorf("--jq requires JSON output, got --output %s", outputFormat)
+	}
+	return "json", nil
+}
+
+// writeTemplate renders --template once per item.
+func (f *outputFlags) writeTemplate(w io.Writer, color bool, items ...any) error {
+	tmpl, err := prompt.ParseOutput(f.template, color)
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(w, items...)
+}
+
+// writeJQ runs the --jq expression over the JSON produced by render.
+func (f *outputFlags) writeJQ(w io.Writer, render func(io.Writer) error) error {
+	var buf bytes.Buffer
+	if err := render(&buf); err != nil {
+		return err
+	}
+	return jq.Filter(w, f.jq, buf.Bytes())
+}
+
+// tableWidth returns the width list tables are truncated to: the terminal
+// width of w, or 0 (no truncation) when wide is set or w is not a terminal.
+func tableWidth(opts Options, w io.Writer, wide bool) int {
+	if wide {
+		return 0
+	}
+	if opts.TerminalWidth == nil {
+		return format.TerminalWidth(w)
+	}
+	return opts.TerminalWidth(w)
+}
@@ -0,0 +1,148 @@
+package cmd_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/cmd"
+)
+
+func TestOutputTemplateAndJQ(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		responses map[string]string
+		args      []string
+		want      string
+	}{
+		{
+			name:      "issue get template",
+			responses: map[string]string{"GetIssue": getIssueResponse},
+			args:      []string{"issue", "get", "ENG-42", "--template", `{{.BranchName}} {{join .Labels ","}} {{date "Jan 2" .DueDate}}`},
+			want:      "feat/implement-feature-x bug,frontend Dec 31\n",
+		},
+		{
+			name:      "issue get jq",
+			responses: map[string]string{"GetIssue": getIssueResponse},
+			args:      []string{"issue", "get", "ENG-42", "--jq", ".labels | join(\" \")"},
+			want:      "bug frontend\n",
+		},
+		{
+			name:      "issue list template",
+			responses: map[string]string{"ListMyIssues": listMyIssuesResponse},
+			args:      []string{"issue", "list", "-t", "{{.Identifier}} {{.Priority}}"},
+			want:      "ENG-101 Urgent\nENG-102 Normal\n",
+		},
+		{
+			name:      "issue list template skips empty renderings",
+			responses: map[string]string{"ListMyIssues": listMyIssuesResponse},
+			args:      []string{"issue", "list", "-t", `{{if eq .State "Backlog"}}{{.Title}}{{end}}`},
+			want:      "Add dark mode\n",
+		},
+		{
+			name:      "issue list jq uses raw values",
+			responses: map[string]string{"ListMyIssues": listMyIssuesResponse},
+			args:      []string{"issue", "list", "-C", "id,priority", "-q", ".[] | select(.priority < 3) | .id"},
+			want:      "ENG-101\n",
+		},
+		{
+			name:      "user list template",
+			responses: map[string]string{"ListUsers": listUsersResponse},
+			args:      []string{"user", "list", "--template", "{{.Email}}"},
+			want:      "jane@example.com\njohn@example.com\n",
+		},
+		{
+			name:      "user list jq",
+			responses: map[string]string{"ListUsers": listUsersResponse},
+			args:      []string{"user", "list", "--jq", "map(select(.admin)) | length"},
+			want:      "1\n",
+		},
+		{
+			name:      "user get template",
+			responses: map[string]string{"GetUserByDisplayName": getUserResponse},
+			args:      []string{"user", "get", "jane", "--template", "{{.Name}} <{{.Email}}>"},
+			want:      "Jane Doe <jane@example.com>\n",
+		},
+		{
+			name:      "user get jq",
+			responses: map[string]string{"GetUserByDisplayName": getUserResponse},
+			args:      []string{"user", "get", "jane", "--jq", "{name, is_me}"},
+			want:      `{"is_me":false,"name":"Jane Doe"}` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newMockGraphQLServer(t, tt.responses)
+			opts, stdout, _ := testOptionsWithBuffers(t, server)
+			root := cmd.NewRootCmd(opts)
+			root.SetArgs(tt.args)
+
+			if err := root.Execute(); err != nil {
+				t.Fatalf("%v returned error: %v", tt.args, err)
+			}
+			if got := stdout.String(); got != tt.want {
+				t.Errorf("output = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOutputTemplateAndJQ_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "template and jq",
+			args:    []string{"issue", "list", "--template", "{{.Title}}", "--jq", "."},
+			wantErr: "none of the others can be",
+		},
+		{
+			name:    "template and output",
+			args:    []string{"user", "list", "--template", "{{.Name}}", "--output", "csv"},
+			wantErr: "none of the others can be",
+		},
+		{
+			name:    "jq with non-json output",
+			args:    []string{"issue", "get", "ENG-42", "--jq", ".", "--output", "yaml"},
+			wantErr: "--jq requires JSON output",
+		},
+		{
+			name:    "invalid template",
+			args:    []string{"issue", "get", "ENG-42", "--template", "{{.Title"},
+			wantErr: "parsing --template",
+		},
+		{
+			name:    "invalid jq",
+			args:    []string{"user", "get", "jane", "--jq", ".[0"},
+			wantErr: "parsing jq expression",
+		},
+		{
+			name:    "template with interactive",
+			args:    []string{"issue", "list", "-i", "-t", "{{.Title}}"},
+			wantErr: "cannot be used with --interactive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, _, _ := testOptionsWithBuffers(t, nil)
+			root := cmd.NewRootCmd(opts)
+			root.SetArgs(tt.args)
+
+			err := root.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%v error = %v, want containing %q", tt.args, err, tt.wantErr)
+			}
+		})
+	}
+}
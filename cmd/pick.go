@@ -9,7 +9,6 @@ import (
 	"io"
 	"os"
 	"os/exec"
-	"path/filepath"
 	"slices"
 	"strings"
 	"sync"
@@ -21,8 +20,10 @@ import (
 
 	"github.com/duboisf/linear/internal/api"
 	"github.com/duboisf/linear/internal/cache"
+	"github.com/duboisf/linear/internal/config"
 	"github.com/duboisf/linear/internal/format"
 	"github.com/duboisf/linear/internal/prompt"
+	"github.com/duboisf/linear/internal/tui"
 )
 
 // fetchMyIssues returns the current user's active issues.
@@ -198,6 +199,18 @@ func fzfSelectedID(raw string) string {
 	return fields[0]
 }
 
+// fzfSelectedIDs extracts the identifier (first field) of each line of fzf's
+// output, as printed with --multi.
+func fzfSelectedIDs(raw string) []string {
+	var ids []string
+	for line := range strings.Lines(raw) {
+		if id := fzfSelectedID(line); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
 // fzfPickIssue presents issues in fzf with aligned columns and returns the
 // selected identifier. Returns empty string if the user cancelled (ESC/Ctrl-C).
 func fzfPickIssue(issues []issueForCompletion) (string, error) {
@@ -208,6 +221,20 @@ func fzfPickIssue(issues []issueForCompletion) (string, error) {
 	sortCompletionIssues(issues)
 	header, lines := formatFzfLines(issues)
 
+	if useBuiltinTUI() {
+		items := make([]tui.Item, len(lines))
+		for i, line := range lines {
+			items[i] = tui.Item{Value: line}
+		}
+		l := tui.NewList(items, false)
+		l.SetHeader(header)
+		picked, err := tui.Pick(l, nil)
+		if err != nil || len(picked) == 0 {
+			return "", err
+		}
+		return fzfSelectedID(picked[0].Value), nil
+	}
+
 	// Pass header as the first input line with --header-lines=1 so fzf
 	// applies the same left margin as data lines (pointer-width aware).
 	input := header + "\n" + strings.Join(lines, "\n") + "\n"
@@ -286,7 +313,9 @@ func formatIssueCache(issue *api.GetIssueIssue) string {
 }
 
 // refreshIssueCache re-fetches a single issue and updates its cached preview.
+// Its other previews are dropped from the cache, to be fetched when shown.
 func refreshIssueCache(ctx context.Context, client graphql.Client, c *cache.Cache, identifier string) {
+	deletePreviewCache(c, identifier)
 	resp, err := api.GetIssue(ctx, client, identifier)
 	if err != nil || resp.Issue == nil {
 		return
@@ -315,7 +344,9 @@ func prefetchIssueDetails(ctx context.Context, client graphql.Client, c *cache.C
 			content := formatIssueCache(resp.Issue)
 			_ = c.Set("issues/"+id, content)
 			if cacheIssueData {
-				data, err := json.Marshal(prompt.NewIssueData(resp.Issue))
+				issueData := prompt.NewIssueData(resp.Issue)
+				_ = fetchChildrenAndComments(ctx, client, &issueData)
+				data, err := json.Marshal(issueData)
 				if err == nil {
 					_ = c.Set("issue-data/"+id, string(data))
 				}
@@ -329,13 +360,17 @@ func prefetchIssueDetails(ctx context.Context, client graphql.Client, c *cache.C
 // background. fzf displays its built-in loading indicator while waiting for
 // data to arrive on stdin. Issue detail prefetching also runs concurrently so
 // previews populate as the user browses.
-// columns controls which columns are displayed; nil means use defaults.
-// cycleStateFile is the path to a temp file holding the current cycle filter
-// value; it enables the ctrl-y cycle switching binding.
-// hasCommands controls whether the ctrl-o custom command binding is enabled
-// and whether issue data is cached for custom commands.
-// Returns the selected identifier, or empty string if cancelled.
-func fzfBrowseIssues(ctx context.Context, client graphql.Client, fetchIssues func(context.Context) ([]*issueNode, error), c *cache.Cache, cycleHeader string, reloadCmd string, columns []string, cycleStateFile string, hasCommands bool) (string, error) {
+// columns controls which columns of colSet are displayed; nil means use
+// defaults.
+// session is the browser's private directory, holding the current cycle
+// filter used by the cycle and view switching bindings and the files of
+// custom commands.
+// commands are the custom commands; when there are any, issue data is cached
+// for them.
+// bindings are the key bindings, from resolveFzfBindings. Several issues can
+// be selected with tab: the bound actions then act on all of them. Returns
+// the selected identifiers, or nil if cancelled.
+func fzfBrowseIssues(ctx context.Context, client graphql.Client, fetchIssues func(context.Context) ([]*issueNode, error), c *cache.Cache, cycleHeader string, reloadCmd string, colSet *format.Columns, columns []string, session *browseSession, commands []config.Command, bindings []fzfBinding) ([]string, error) {
 	// Eagerly detect terminal background style before launching goroutines.
 	// HasDarkBackground sends an OSC 11 query to the terminal; doing it once
 	// here (synchronously, before fzf) avoids concurrent queries whose
@@ -347,6 +382,7 @@ func fzfBrowseIssues(ctx context.Context, client graphql.Client, fetchIssues fun
 	fetchErrCh := make(chan error, 1)
 
 	fetchCtx, fetchCancel := context.WithCancel(ctx)
+	defer fetchCancel()
 
 	go func() {
 		defer pw.Close()
@@ -366,88 +402,43 @@ func fzfBrowseIssues(ctx context.Context, client graphql.Client, fetchIssues fun
 		for i, n := range nodes {
 			identifiers[i] = n.Identifier
 		}
-		go prefetchIssueDetails(ctx, client, c, identifiers, hasCommands)
+		go prefetchIssueDetails(ctx, client, c, identifiers, len(commands) > 0)
 
 		cols := columns
 		if cols == nil {
 			cols = format.DefaultColumns(nodes)
 		}
-		header, lines := format.FormatFzfLines(nodes, cols)
+		header, lines := format.FormatFzfLines(nodes, colSet, cols)
 		input := header + "\n" + strings.Join(lines, "\n") + "\n"
 		_, _ = io.WriteString(pw, input)
 		fetchErrCh <- nil
 	}()
 
-	// Cache already contains pre-rendered ANSI (either from glamour or the
-	// built-in formatter), so plain cat is sufficient. The fallback handles
-	// the race where fzf previews an issue before prefetch has written it.
 	self, _ := os.Executable()
-	cacheFile := fmt.Sprintf("%s/issues/{1}", c.Dir)
-	previewCmd := fmt.Sprintf(
-		"for _ in $(seq 1 50); do [ -f '%s' ] && cat '%s' && exit 0; sleep 0.1; done; printf '\\033[2mFailed to load preview\\033[0m'",
-		cacheFile, cacheFile,
-	)
-
-	// Help line shown in the fzf header.
-	helpLine := `ctrl-e: edit  ctrl-y: switch cycle  ctrl-o: command  ctrl-d/u: scroll preview  shift-↑/↓: line by line\nenter: select  esc: cancel`
-	reloadAction := ""
-	if reloadCmd != "" {
-		reloadAction = "+reload(" + reloadCmd + ")"
-	}
-
-	// Build ctrl-y binding to switch the cycle filter via a nested fzf picker.
-	// 1. execute: runs pick-cycle which presents a cycle picker and writes to state file.
-	// 2. reload: re-fetches issues using the new cycle from the state file.
-	// 3. transform-header: reads the new cycle header from the companion file.
-	switchCycleBinding := fmt.Sprintf(
-		`execute(%s issue pick-cycle --state-file '%s')`+
-			`%s`+
-			`+transform-header(cat '%s.header' 2>/dev/null; echo ""; echo "%s")`,
-		self, cycleStateFile, reloadAction, cycleStateFile, helpLine,
-	)
 
-	// Build ctrl-e binding to interactively edit the selected issue.
-	// execute() runs the command with the terminal, allowing nested fzf pickers.
-	// reload refreshes the issue list to reflect the edit (reads cycle from state file).
-	// refresh-preview re-renders the preview from the updated cache.
-	editBinding := fmt.Sprintf(
-		`execute(%s issue edit-interactive {1})%s+refresh-preview`,
-		self, reloadAction,
-	)
+	// Help line shown in the fzf header. helpLine is echoed by
+	// transform-header, so its newline is escaped.
+	help := browserHelp(bindings)
+	helpLine := strings.Join(help, `\n`)
 
-	fzfHeader := "ctrl-e: edit  ctrl-y: switch cycle  ctrl-o: command  ctrl-d/u: scroll preview  shift-↑/↓: line by line\nenter: select  esc: cancel"
+	fzfHeader := strings.Join(help, "\n")
 	if cycleHeader != "" {
 		fzfHeader = cycleHeader + "\n" + fzfHeader
 	}
 
-	// Build ctrl-o binding to run a custom command via the run-command hidden command.
-	// execute() suspends fzf and gives the subprocess terminal control for nested
-	// fzf pickers. The hidden command shows a command picker (if multiple commands
-	// are configured), renders the selected command template, then execs it via sh.
-	// Commands with exec: true write to execFile instead of running inline;
-	// transform() then aborts fzf so the caller can exec the command.
-	// Issue data is pre-cached during prefetch at issue-data/<ID>.
-	issueDataFile := fmt.Sprintf("%s/issue-data/{1}", c.Dir)
-	execFile := fmt.Sprintf("%s/exec-command", c.Dir)
-	os.Remove(execFile) // clean up stale exec file from crashed sessions
-	afterActions := "refresh-preview"
-	if reloadAction != "" {
-		afterActions = strings.TrimPrefix(reloadAction, "+") + "+refresh-preview"
-	}
-	// Write afterActions to a file so the transform body avoids nested
-	// parentheses that confuse fzf's binding parser (reload(...) inside
-	// transform(...) causes fzf to mismatch the closing paren).
-	afterActionsFile := filepath.Join(c.Dir, "after-actions")
-	if err := os.WriteFile(afterActionsFile, []byte(afterActions), 0o644); err != nil {
-		return "", fmt.Errorf("writing after-actions file: %w", err)
+	bindCtx, err := newFzfBindContext(session, c, self, reloadCmd, helpLine, commands)
+	if err != nil {
+		return nil, err
 	}
-	commandBinding := fmt.Sprintf(
-		`execute(%s issue run-command --exec-file '%s' --issue-data-file '%s' --identifier {1})+transform([ -f '%s' ] && echo abort || cat '%s')`,
-		self, execFile, issueDataFile, execFile, afterActionsFile,
-	)
+	execFile := bindCtx.execFile
 
-	cmd := exec.Command("fzf",
+	// issue preview renders the issue in the mode the preview tab bindings
+	// switch to, from the cache prefetch fills when possible.
+	previewCmd := fmt.Sprintf("%s issue preview --mode-file '%s' {1}", self, bindCtx.previewModeFile)
+
+	fzfArgs := []string{
 		"--ansi",
+		"--multi",
 		"--header-lines=1",
 		"--header", fzfHeader,
 		"--header-first",
@@ -457,10 +448,11 @@ func fzfBrowseIssues(ctx context.Context, client graphql.Client, fetchIssues fun
 		"--preview-window", "right,86,wrap,<166(bottom,60%,wrap)",
 		"--bind", "ctrl-d:preview-half-page-down,ctrl-u:preview-half-page-up",
 		"--bind", "shift-down:preview-down,shift-up:preview-up",
-		"--bind", "ctrl-y:"+switchCycleBinding,
-		"--bind", "ctrl-e:"+editBinding,
-		"--bind", "ctrl-o:"+commandBinding,
-	)
+	}
+	for _, b := range bindings {
+		fzfArgs = append(fzfArgs, "--bind", b.key+":"+bindCtx.fzfAction(b))
+	}
+	cmd := exec.Command("fzf", fzfArgs...)
 	cmd.Stdin = pr
 	cmd.Env = append(os.Environ(), _glamourStyleEnv+"="+glamourStyle())
 
@@ -480,20 +472,20 @@ func fzfBrowseIssues(ctx context.Context, client graphql.Client, fetchIssues fun
 	// Check if an exec command was triggered (run-command wrote the rendered
 	// command to execFile and fzf was aborted via transform).
 	if data, err := os.ReadFile(execFile); err == nil {
-		os.Remove(execFile)
-		return "", syscall.Exec("/bin/sh", []string{"sh", "-c", string(data)}, os.Environ())
+		session.Close()
+		return nil, syscall.Exec("/bin/sh", []string{"sh", "-c", string(data)}, os.Environ())
 	}
 
 	if runErr != nil {
 		// Surface fetch errors (e.g. API failure, empty list) over fzf errors.
 		if fetchErr != nil && !errors.Is(fetchErr, context.Canceled) {
-			return "", fetchErr
+			return nil, fetchErr
 		}
 		if fzfExitOK(runErr) {
-			return "", nil
+			return nil, nil
 		}
-		return "", fmt.Errorf("running fzf: %w", runErr)
+		return nil, fmt.Errorf("running fzf: %w", runErr)
 	}
 
-	return fzfSelectedID(out.String()), nil
+	return fzfSelectedIDs(out.String()), nil
 }
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"regexp"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -276,11 +277,15 @@ func TestPrefetchIssueDetails_IssueDataCache(t *testing.T) {
 				"state": {"name": "In Progress", "type": "started"},
 				"priority": 2,
 				"url": "https://linear.app/team/ENG-1",
-				"branchName": "eng-1-test-issue"
+				"branchName": "eng-1-test-issue",
+				"children": {"nodes": [{"identifier": "ENG-2"}]},
+				"comments": {"nodes": [{"id": "c1"}, {"id": "c2"}]}
 			}
 		}
 	}`
 
+	// The same response answers GetIssue and IssueChildrenAndComments,
+	// each decoding its own fields.
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		w.Write([]byte(getIssueResponse))
@@ -308,6 +313,9 @@ func TestPrefetchIssueDetails_IssueDataCache(t *testing.T) {
 	if data["Title"] != "Test issue" {
 		t.Errorf("Title = %q, want %q", data["Title"], "Test issue")
 	}
+	if data["CommentCount"] != 2.0 {
+		t.Errorf("CommentCount = %v, want 2", data["CommentCount"])
+	}
 }
 
 func TestPrefetchIssueDetails_NoIssueDataCache(t *testing.T) {
@@ -428,3 +436,26 @@ func TestSortCompletionIssues(t *testing.T) {
 		}
 	}
 }
+
+func TestFzfSelectedIDs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		raw  string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"single", "ENG-1  Todo  High  Fix it\n", []string{"ENG-1"}},
+		{"multi", "ENG-1  Todo  Fix it\nENG-2  Done  Ship it\n\n", []string{"ENG-1", "ENG-2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := fzfSelectedIDs(tt.raw)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("fzfSelectedIDs(%q) = %v, want %v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
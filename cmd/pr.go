@@ -0,0 +1,17 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+// newPRCmd creates the parent "pr" command that groups pull request
+// subcommands.
+func newPRCmd(opts Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pr",
+		Short: "Manage pull requests for issues",
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+	cmd.AddCommand(newPRCreateCmd(opts))
+	return cmd
+}
@@ -0,0 +1,267 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/duboisf/linear/internal/api"
+	"github.com/duboisf/linear/internal/config"
+	"github.com/duboisf/linear/internal/prompt"
+)
+
+// PullRequest describes a pull request to open.
+type PullRequest struct {
+	Title string
+	Body  string
+	// Head is the branch with the changes.
+	Head string
+	// Base is the branch to merge into; empty uses the repository default.
+	Base  string
+	Draft bool
+}
+
+// PullRequestCreator abstracts the gh CLI for testability.
+type PullRequestCreator interface {
+	// CreatePullRequest opens pr and returns its URL.
+	CreatePullRequest(pr PullRequest) (string, error)
+}
+
+// execPullRequestCreator implements PullRequestCreator using the gh CLI.
+type execPullRequestCreator struct {
+	ctx context.Context
+}
+
+func (g *execPullRequestCreator) CreatePullRequest(pr PullRequest) (string, error) {
+	if _, err := exec.LookPath("gh"); err != nil {
+		return "", fmt.Errorf("gh CLI not found; install it from https://cli.github.com")
+	}
+	args := []string{"pr", "create", "--title", pr.Title, "--body", pr.Body, "--head", pr.Head}
+	if pr.Base != "" {
+		args = append(args, "--base", pr.Base)
+	}
+	if pr.Draft {
+		args = append(args, "--draft")
+	}
+	cmd := exec.CommandContext(g.ctx, "gh", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("running gh pr create: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	// gh prints the new pull request's URL as the last line.
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	return strings.TrimSpace(lines[len(lines)-1]), nil
+}
+
+// prCreateFlags holds the command-line overrides for "pr create".
+type prCreateFlags struct {
+	title        string
+	body         string
+	base         string
+	draft        bool
+	noPush       bool
+	noTransition bool
+}
+
+// newPullRequest renders the pull request for issue from the pr config
+// section, with flags taking precedence.
+func newPullRequest(cfg *config.Config, flags prCreateFlags, issue *api.GetIssueIssue) (PullRequest, error) {
+	titleTmpl, bodyTmpl := config.DefaultPRTitle, config.DefaultPRBody
+	pr := PullRequest{Head: issue.BranchName}
+	if cfg != nil {
+		if cfg.PR.Title != "" {
+			titleTmpl = cfg.PR.Title
+		}
+		if cfg.PR.Body != "" {
+			bodyTmpl = cfg.PR.Body
+		}
+		pr.Base = cfg.PR.Base
+		if pr.Base == "" {
+			pr.Base = cfg.Worktree.BaseBranch
+		}
+		pr.Draft = cfg.PR.Draft
+	}
+	if flags.title != "" {
+		titleTmpl = flags.title
+	}
+	if flags.body != "" {
+		bodyTmpl = flags.body
+	}
+	if flags.base != "" {
+		pr.Base = flags.base
+	}
+	if flags.draft {
+		pr.Draft = true
+	}
+
+	data := prompt.NewIssueData(issue)
+	title, err := prompt.RenderRaw(titleTmpl, data)
+	if err != nil {
+		return pr, fmt.Errorf("rendering pull request title: %w", err)
+	}
+	pr.Title = strings.TrimSpace(title)
+	if pr.Title == "" {
+		return pr, fmt.Errorf("pull request title template %q rendered an empty title", titleTmpl)
+	}
+	pr.Body, err = prompt.RenderRaw(bodyTmpl, data)
+	if err != nil {
+		return pr, fmt.Errorf("rendering pull request body: %w", err)
+	}
+	return pr, nil
+}
+
+// newPRCreateCmd creates the "pr create" subcommand that pushes an issue's
+// branch, opens a pull request with gh and links it back to the issue.
+func newPRCreateCmd(opts Options) *cobra.Command {
+	var (
+		flags prCreateFlags
+		user  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create [IDENTIFIER]",
+		Short: "Push an issue's branch and open a pull request for it",
+		Long: `Push the issue's branch, open a GitHub pull request with the gh CLI, and
+attach the pull request to the issue.
+
+The title and body are Go templates over the issue fields (see the pr config
+section). Without IDENTIFIER, the issue of the current branch is used, or an
+fzf picker is shown.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.PullRequestCreator == nil {
+				return fmt.Errorf("gh CLI is not available")
+			}
+			client, err := resolveClient(cmd, opts)
+			if err != nil {
+				return err
+			}
+
+			var identifier string
+			if len(args) > 0 {
+				identifier = args[0]
+			} else if identifier = currentBranchIssue(cmd.Context(), opts, client); identifier == "" {
+				var issues []issueForCompletion
+				if strings.EqualFold(user, "all") {
+					issues, err = fetchAllIssues(cmd.Context(), client)
+				} else if user != "" {
+					issues, err = fetchUserIssues(cmd.Context(), client, user)
+				} else {
+					issues, err = fetchMyIssues(cmd.Context(), client)
+				}
+				if err != nil {
+					return fmt.Errorf("listing issues: %w", err)
+				}
+				identifier, err = fzfPickIssue(issues)
+				if err != nil {
+					return err
+				}
+				if identifier == "" {
+					return nil // user cancelled
+				}
+			}
+
+			resp, err := api.GetIssue(cmd.Context(), client, identifier)
+			if err != nil {
+				return fmt.Errorf("getting issue: %w", err)
+			}
+			if resp.Issue == nil {
+				return fmt.Errorf("issue %s not found", identifier)
+			}
+			issue := resp.Issue
+			if issue.BranchName == "" {
+				return fmt.Errorf("issue %s has no branch name", identifier)
+			}
+
+			pr, err := newPullRequest(opts.Config, flags, issue)
+			if err != nil {
+				return err
+			}
+
+			if !flags.noPush {
+				if err := pushIssueBranch(opts, issue); err != nil {
+					return err
+				}
+			}
+
+			url, err := opts.PullRequestCreator.CreatePullRequest(pr)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(opts.Stdout, url)
+
+			// The pull request exists at this point, so follow-up failures only warn.
+			attachResp, err := api.AttachIssueURL(cmd.Context(), client, issue.Id, url, pr.Title)
+			switch {
+			case err != nil:
+				fmt.Fprintf(opts.Stderr, "Warning: attaching pull request to %s: %v\n", issue.Identifier, err)
+			case attachResp.AttachmentLinkURL == nil || !attachResp.AttachmentLinkURL.Success:
+				fmt.Fprintf(opts.Stderr, "Warning: attaching pull request to %s was not successful\n", issue.Identifier)
+			default:
+				fmt.Fprintf(opts.Stderr, "Attached pull request to %s\n", issue.Identifier)
+			}
+
+			if !flags.noTransition && opts.Config != nil {
+				if err := transitionIssue(cmd.Context(), client, issue, opts.Config.Workflow.PRCreate, workflowEventPRCreate, opts.Stderr); err != nil {
+					fmt.Fprintf(opts.Stderr, "Warning: %v\n", err)
+				}
+			}
+			return nil
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			if strings.EqualFold(user, "all") {
+				return completeAllIssues(cmd, opts)
+			}
+			if user != "" {
+				return completeUserIssues(cmd, opts, user)
+			}
+			return completeMyIssues(cmd, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.title, "title", "", "Pull request title template (default: pr.title)")
+	_ = cmd.RegisterFlagCompletionFunc("title", cobra.NoFileCompletions)
+	cmd.Flags().StringVar(&flags.body, "body", "", "Pull request body template (default: pr.body)")
+	_ = cmd.RegisterFlagCompletionFunc("body", cobra.NoFileCompletions)
+	cmd.Flags().StringVar(&flags.base, "base", "", "Branch to merge into (default: pr.base, worktree.base_branch or the repository default)")
+	_ = cmd.RegisterFlagCompletionFunc("base", cobra.NoFileCompletions)
+	cmd.Flags().BoolVarP(&flags.draft, "draft", "d", false, "Open the pull request as a draft")
+	cmd.Flags().BoolVar(&flags.noPush, "no-push", false, "Don't push the branch first")
+	cmd.Flags().BoolVar(&flags.noTransition, "no-transition", false, "Don't move the issue to the workflow.pr_create state")
+	cmd.Flags().StringVarP(&user, "user", "u", "", "User whose issues to browse")
+	cmd.RegisterFlagCompletionFunc("user", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return completeUserNames(cmd, opts)
+	})
+
+	return cmd
+}
+
+// pushIssueBranch pushes the issue's branch to the configured worktree remote.
+func pushIssueBranch(opts Options, issue *api.GetIssueIssue) error {
+	git := opts.GitWorktreeCreator
+	if git == nil || opts.GitRunner == nil {
+		return fmt.Errorf("git is not available")
+	}
+	exists, err := git.BranchExists(issue.BranchName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("branch %q doesn't exist locally (create it with 'linear issue worktree %s')", issue.BranchName, issue.Identifier)
+	}
+	remote := newWorktreeOptions(opts.Config, "", "").Remote
+	if err := opts.GitRunner.PushBranch(remote, issue.BranchName); err != nil {
+		return err
+	}
+	fmt.Fprintf(opts.Stderr, "Pushed %s to %s\n", issue.BranchName, remote)
+	return nil
+}
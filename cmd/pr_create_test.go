@@ -0,0 +1,180 @@
+package cmd_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/cmd"
+	"github.com/duboisf/linear/internal/config"
+)
+
+const attachIssueURLResponse = `{
+	"data": {
+		"attachmentLinkURL": {
+			"success": true,
+			"attachment": {"id": "att-1", "url": "https://github.com/org/repo/pull/7"}
+		}
+	}
+}`
+
+func prCreateHandlers() map[string]string {
+	return map[string]string{
+		"GetIssue":           getIssueWithIDsResponse,
+		"AttachIssueURL":     attachIssueURLResponse,
+		"ListWorkflowStates": listWorkflowStatesResponse,
+		"UpdateIssue":        updateIssueResponse,
+	}
+}
+
+func TestPRCreate(t *testing.T) {
+	t.Parallel()
+
+	server, rec := newRecordingGraphQLServer(t, prCreateHandlers())
+	runner := &mockGitRunner{}
+	gh := &mockPullRequestCreator{url: "https://github.com/org/repo/pull/7"}
+	opts, stdout, stderr := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = &mockGitWorktreeCreator{branchExists: true}
+	opts.GitRunner = runner
+	opts.PullRequestCreator = gh
+	opts.Config = &config.Config{Workflow: config.WorkflowConfig{PRCreate: "In Review"}}
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"pr", "create", "ENG-42"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("pr create returned error: %v", err)
+	}
+
+	if len(runner.pushCalls) != 1 || runner.pushCalls[0] != (pushCall{"origin", "feat/implement-feature-x"}) {
+		t.Errorf("push calls = %+v, want origin feat/implement-feature-x", runner.pushCalls)
+	}
+	if len(gh.calls) != 1 {
+		t.Fatalf("CreatePullRequest calls = %d, want 1", len(gh.calls))
+	}
+	pr := gh.calls[0]
+	if pr.Title != "ENG-42: Implement feature X" {
+		t.Errorf("title = %q", pr.Title)
+	}
+	if pr.Head != "feat/implement-feature-x" || pr.Base != "" || pr.Draft {
+		t.Errorf("head/base/draft = %q/%q/%v", pr.Head, pr.Base, pr.Draft)
+	}
+	for _, want := range []string{
+		"Detailed description here.",
+		"Linear: [ENG-42](https://linear.app/team/ENG-42)",
+		"Parent: ENG-1",
+		"Labels: bug, frontend",
+	} {
+		if !strings.Contains(pr.Body, want) {
+			t.Errorf("body %q missing %q", pr.Body, want)
+		}
+	}
+
+	if stdout.String() != "https://github.com/org/repo/pull/7\n" {
+		t.Errorf("stdout = %q, want the PR URL", stdout.String())
+	}
+	attach := rec.calls("AttachIssueURL")
+	if len(attach) != 1 || !strings.Contains(string(attach[0].Variables), `"url":"https://github.com/org/repo/pull/7"`) {
+		t.Errorf("AttachIssueURL calls = %+v", attach)
+	}
+	if !strings.Contains(stderr.String(), "ENG-42: In Progress → In Review (pr_create)") {
+		t.Errorf("stderr = %q, want transition audit line", stderr.String())
+	}
+}
+
+func TestPRCreate_FlagsAndConfig(t *testing.T) {
+	t.Parallel()
+
+	server, rec := newRecordingGraphQLServer(t, prCreateHandlers())
+	runner := &mockGitRunner{}
+	gh := &mockPullRequestCreator{url: "https://github.com/org/repo/pull/8"}
+	opts, _, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = &mockGitWorktreeCreator{}
+	opts.GitRunner = runner
+	opts.PullRequestCreator = gh
+	opts.Config = &config.Config{
+		PR:       config.PRConfig{Body: "Fixes {{.Identifier}}", Base: "develop"},
+		Workflow: config.WorkflowConfig{PRCreate: "In Review"},
+	}
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"pr", "create", "ENG-42", "--title", "[{{.TeamKey}}] {{lower .Title}}", "--draft", "--no-push", "--no-transition"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("pr create returned error: %v", err)
+	}
+
+	if len(runner.pushCalls) != 0 {
+		t.Errorf("--no-push should skip pushing, got %+v", runner.pushCalls)
+	}
+	pr := gh.calls[0]
+	if pr.Title != "[ENG] implement feature x" || pr.Body != "Fixes ENG-42" || pr.Base != "develop" || !pr.Draft {
+		t.Errorf("pr = %+v", pr)
+	}
+	if n := len(rec.calls("UpdateIssue")); n != 0 {
+		t.Errorf("--no-transition should skip UpdateIssue, got %d calls", n)
+	}
+}
+
+func TestPRCreate_BranchMissing(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, prCreateHandlers())
+	gh := &mockPullRequestCreator{}
+	opts, _, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = &mockGitWorktreeCreator{branchExists: false}
+	opts.GitRunner = &mockGitRunner{}
+	opts.PullRequestCreator = gh
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"pr", "create", "ENG-42"})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "doesn't exist locally") {
+		t.Fatalf("error = %v, want missing branch error", err)
+	}
+	if len(gh.calls) != 0 {
+		t.Errorf("no pull request should be created, got %+v", gh.calls)
+	}
+}
+
+func TestPRCreate_GhError(t *testing.T) {
+	t.Parallel()
+
+	server, rec := newRecordingGraphQLServer(t, prCreateHandlers())
+	opts, _, _ := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = &mockGitWorktreeCreator{branchExists: true}
+	opts.GitRunner = &mockGitRunner{}
+	opts.PullRequestCreator = &mockPullRequestCreator{err: fmt.Errorf("running gh pr create: a pull request already exists")}
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"pr", "create", "ENG-42"})
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("error = %v, want gh error", err)
+	}
+	if n := len(rec.calls("AttachIssueURL")); n != 0 {
+		t.Errorf("nothing should be attached after a gh failure, got %d calls", n)
+	}
+}
+
+func TestPRCreate_AttachFailureWarns(t *testing.T) {
+	t.Parallel()
+
+	handlers := prCreateHandlers()
+	handlers["AttachIssueURL"] = `{"data":null,"errors":[{"message":"forbidden"}]}`
+	server := newMockGraphQLServer(t, handlers)
+	opts, stdout, stderr := testOptionsWithBuffers(t, server)
+	opts.GitWorktreeCreator = &mockGitWorktreeCreator{branchExists: true}
+	opts.GitRunner = &mockGitRunner{}
+	opts.PullRequestCreator = &mockPullRequestCreator{url: "https://github.com/org/repo/pull/9"}
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"pr", "create", "ENG-42"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("pr create returned error: %v", err)
+	}
+	if !strings.Contains(stdout.String(), "pull/9") {
+		t.Errorf("stdout = %q, want the PR URL", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "Warning: attaching pull request to ENG-42") {
+		t.Errorf("stderr = %q, want attach warning", stderr.String())
+	}
+}
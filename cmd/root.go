@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -15,6 +16,7 @@ import (
 	"github.com/duboisf/linear/internal/api"
 	"github.com/duboisf/linear/internal/cache"
 	"github.com/duboisf/linear/internal/config"
+	"github.com/duboisf/linear/internal/format"
 	"github.com/duboisf/linear/internal/keyring"
 )
 
@@ -34,10 +36,17 @@ type Options struct {
 	FileStore keyring.Provider
 	// GitWorktreeCreator abstracts git worktree operations.
 	GitWorktreeCreator GitWorktreeCreator
+	// GitRunner abstracts the other git commands (branch, push, hooks).
+	GitRunner GitRunner
+	// PullRequestCreator abstracts the gh CLI for pull requests.
+	PullRequestCreator PullRequestCreator
 	// Cache provides file-based caching for issue details.
 	Cache *cache.Cache
 	// TimeNow returns the current time. Defaults to time.Now.
 	TimeNow func() time.Time
+	// TerminalWidth returns the width tables are fitted to, or 0 when w is
+	// not a terminal. Defaults to format.TerminalWidth.
+	TerminalWidth func(w io.Writer) int
 	// Stdin for interactive input.
 	Stdin io.Reader
 	// Stdout for command output.
@@ -50,7 +59,10 @@ type Options struct {
 
 // NewRootCmd creates the root cobra command with all subcommands wired up.
 func NewRootCmd(opts Options) *cobra.Command {
-	var refresh bool
+	var (
+		refresh   bool
+		configErr error
+	)
 
 	root := &cobra.Command{
 		Use:           "linear",
@@ -58,6 +70,14 @@ func NewRootCmd(opts Options) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// Bad aliases or defaults fail every command except "config ..."
+			// and "alias ...", so the config file can still be fixed.
+			if configErr != nil && !isConfigFixCommand(cmd) {
+				return configErr
+			}
+			// Pickers, including those of the hidden commands run by the
+			// issue browser, read the backend from the environment.
+			exportInteractiveBackend(opts.Config)
 			if refresh && opts.Cache != nil {
 				if _, err := opts.Cache.Clear(); err != nil {
 					return fmt.Errorf("clearing cache: %w", err)
@@ -84,6 +104,16 @@ func NewRootCmd(opts Options) *cobra.Command {
 	issueCmd.GroupID = "core"
 	userCmd := newUserCmd(opts)
 	userCmd.GroupID = "core"
+	gitCmd := newGitCmd(opts)
+	gitCmd.GroupID = "core"
+	prCmd := newPRCmd(opts)
+	prCmd.GroupID = "core"
+	viewCmd := newViewCmd(opts)
+	viewCmd.GroupID = "core"
+	triageCmd := newTriageCmd(opts)
+	triageCmd.GroupID = "core"
+	runCmd := newRunCmd(opts)
+	runCmd.GroupID = "core"
 
 	authCmd := newAuthCmd(opts)
 	authCmd.GroupID = "setup"
@@ -91,6 +121,8 @@ func NewRootCmd(opts Options) *cobra.Command {
 	cacheCmd.GroupID = "setup"
 	configCmd := newConfigCmd(opts)
 	configCmd.GroupID = "setup"
+	aliasCmd := newAliasCmd(opts)
+	aliasCmd.GroupID = "setup"
 	completionCmd := newCompletionCmd()
 	completionCmd.GroupID = "setup"
 	versionCmd := newVersionCmd()
@@ -101,19 +133,49 @@ func NewRootCmd(opts Options) *cobra.Command {
 	root.AddCommand(
 		issueCmd,
 		userCmd,
+		gitCmd,
+		prCmd,
+		viewCmd,
+		triageCmd,
+		runCmd,
 		authCmd,
 		cacheCmd,
 		configCmd,
+		aliasCmd,
 		completionCmd,
 		versionCmd,
 	)
+	configErr = errors.Join(addAliasCommands(root, opts), applyConfigDefaults(root, opts))
 	return root
 }
 
-// Execute creates the root command with default options and runs it.
+// isConfigFixCommand reports whether cmd is under "config" or "alias", the
+// commands used to repair a broken config file.
+func isConfigFixCommand(cmd *cobra.Command) bool {
+	for c := cmd; c.HasParent(); c = c.Parent() {
+		if !c.Parent().HasParent() {
+			return c.Name() == "config" || c.Name() == "alias"
+		}
+	}
+	return false
+}
+
+// Execute creates the root command with default options and runs it with
+// the process's arguments.
 func Execute() error {
-	opts := DefaultOptions()
-	return NewRootCmd(opts).ExecuteContext(context.Background())
+	return ExecuteArgs(context.Background(), DefaultOptions(), os.Args[1:])
+}
+
+// ExecuteArgs creates the root command and runs it with args, once aliases
+// at the start of args are expanded.
+func ExecuteArgs(ctx context.Context, opts Options, args []string) error {
+	root := NewRootCmd(opts)
+	expanded, err := expandAliases(root, args)
+	if err != nil {
+		return err
+	}
+	root.SetArgs(expanded)
+	return root.ExecuteContext(ctx)
 }
 
 // nativeKeyringProvider returns the platform-specific keyring provider.
@@ -153,8 +215,11 @@ func DefaultOptions() Options {
 		NativeStore:        native,
 		FileStore:          file,
 		GitWorktreeCreator: &execGitWorktreeCreator{ctx: context.Background()},
+		GitRunner:          &execGitRunner{ctx: context.Background()},
+		PullRequestCreator: &execPullRequestCreator{ctx: context.Background()},
 		Cache:              cache.New(cacheDir, 5*time.Minute),
 		TimeNow:            time.Now,
+		TerminalWidth:      format.TerminalWidth,
 		Stdin:              os.Stdin,
 		Stdout:             os.Stdout,
 		Stderr:             os.Stderr,
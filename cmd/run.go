@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/duboisf/linear/internal/api"
+	"github.com/duboisf/linear/internal/config"
+	"github.com/duboisf/linear/internal/format"
+	"github.com/duboisf/linear/internal/prompt"
+)
+
+// newRunCmd creates the "run" command, which runs a custom command from
+// interactive.commands outside of the issue browser, so scripts and editor
+// integrations can reuse the same definitions. Without identifiers it uses
+// the current branch's issue or opens the issue picker; without a name it
+// lists the commands.
+func newRunCmd(opts Options) *cobra.Command {
+	var printOnly bool
+
+	cmd := &cobra.Command{
+		Use:   "run [NAME] [IDENTIFIER...]",
+		Short: "Run a custom command on issues",
+		Long: `Run a command from the interactive.commands section of the config file on
+one or more issues. The command is rendered like in the issue browser (fields
+are shell-quoted) and run with sh. A multi: true command runs once for all
+issues, others once per issue.
+
+Without identifiers, the issue of the current git branch is used, or picked
+interactively. Without a name, the configured commands are listed.`,
+		Example: `  linear run                      # list commands
+  linear run Claude AIS-42
+  linear run "Open in browser" AIS-42 AIS-43
+  linear run Claude AIS-42 --print # show the shell script only`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return listCommands(cmd, opts)
+			}
+			selected, ok := opts.Config.Command(args[0])
+			if !ok {
+				names := opts.Config.CommandNames()
+				if len(names) == 0 {
+					return fmt.Errorf("unknown command %q: no commands are configured in interactive.commands", args[0])
+				}
+				return fmt.Errorf("unknown command %q (available: %s)", args[0], strings.Join(names, ", "))
+			}
+
+			client, err := resolveClient(cmd, opts)
+			if err != nil {
+				return err
+			}
+
+			identifiers := args[1:]
+			if len(identifiers) == 0 {
+				identifier := currentBranchIssue(cmd.Context(), opts, client)
+				if identifier == "" {
+					issues, err := fetchMyIssues(cmd.Context(), client)
+					if err != nil {
+						return fmt.Errorf("listing issues: %w", err)
+					}
+					if identifier, err = fzfPickIssue(issues); err != nil || identifier == "" {
+						return err // user cancelled
+					}
+				}
+				identifiers = []string{identifier}
+			}
+
+			issues := make([]prompt.IssueData, len(identifiers))
+			for i, identifier := range identifiers {
+				resp, err := api.GetIssue(cmd.Context(), client, identifier)
+				if err != nil {
+					return fmt.Errorf("getting issue %s: %w", identifier, err)
+				}
+				if resp.Issue == nil {
+					return fmt.Errorf("issue %s not found", identifier)
+				}
+				issues[i] = prompt.NewIssueData(resp.Issue)
+				if err := fetchChildrenAndComments(cmd.Context(), client, &issues[i]); err != nil {
+					return fmt.Errorf("getting sub-issues and comments of %s: %w", identifier, err)
+				}
+			}
+			addGitContext(opts.GitWorktreeCreator, issues)
+
+			rendered, err := renderCommand(selected, issues)
+			if err != nil {
+				return fmt.Errorf("rendering command template: %w", err)
+			}
+			if printOnly {
+				fmt.Fprintln(opts.Stdout, rendered)
+				return nil
+			}
+
+			if selected.Confirm {
+				ok, err := confirmOnTTY(opts, fmt.Sprintf("Run %s on %s?", selected.Name, strings.Join(identifiers, ", ")))
+				if err != nil || !ok {
+					return err
+				}
+			}
+			sh := exec.CommandContext(cmd.Context(), "sh", "-c", rendered)
+			sh.Stdin = opts.Stdin
+			sh.Stdout = opts.Stdout
+			sh.Stderr = opts.Stderr
+			if err := sh.Run(); err != nil {
+				return fmt.Errorf("command %q: %w", selected.Name, err)
+			}
+			return nil
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) == 0 {
+				return completeCommandNames(opts)
+			}
+			return completeMyIssues(cmd, opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&printOnly, "print", "p", false, "Print the rendered shell script instead of running it")
+
+	return cmd
+}
+
+// completeCommandNames returns the configured command names for shell
+// completion, described by their command.
+func completeCommandNames(opts Options) ([]string, cobra.ShellCompDirective) {
+	var comps []string
+	if opts.Config != nil {
+		for _, c := range opts.Config.Interactive.Commands {
+			comps = append(comps, c.Name+"\t"+commandSummary(c))
+		}
+	}
+	return comps, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveKeepOrder
+}
+
+// commandSummary returns the first line of c's command template, marked
+// with "..." when there are more.
+func commandSummary(c config.Command) string {
+	first, rest, _ := strings.Cut(strings.TrimSpace(c.Command), "\n")
+	if rest != "" {
+		first += " ..."
+	}
+	return first
+}
+
+// listCommands prints the configured commands and their templates.
+func listCommands(cmd *cobra.Command, opts Options) error {
+	if opts.Config == nil || len(opts.Config.Interactive.Commands) == 0 {
+		fmt.Fprintln(opts.Stderr, "No commands configured. Add an interactive.commands section with 'linear config edit'.")
+		return nil
+	}
+	cols := []format.TableColumn{{Header: "NAME"}, {Header: "COMMAND", Flexible: true}}
+	rows := make([][]format.TableCell, len(opts.Config.Interactive.Commands))
+	for i, c := range opts.Config.Interactive.Commands {
+		rows[i] = []format.TableCell{{Text: c.Name}, {Text: commandSummary(c), Color: format.Gray}}
+	}
+	color := format.ColorEnabled(cmd.OutOrStdout())
+	fmt.Fprint(opts.Stdout, format.RenderTable(cols, rows, color, true, tableWidth(opts, cmd.OutOrStdout(), false)))
+	return nil
+}
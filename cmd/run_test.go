@@ -0,0 +1,221 @@
+package cmd_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/cmd"
+	"github.com/duboisf/linear/internal/config"
+)
+
+const issueChildrenAndCommentsResponse = `{
+	"data": {
+		"issue": {
+			"children": {"nodes": [{"identifier": "ENG-43"}, {"identifier": "ENG-44"}]},
+			"comments": {"nodes": [{"id": "c1"}, {"id": "c2"}, {"id": "c3"}]}
+		}
+	}
+}`
+
+func runTestConfig() *config.Config {
+	return &config.Config{Interactive: config.InteractiveConfig{
+		Commands: []config.Command{
+			{Name: "Echo", Command: "echo {{.Identifier}} {{.Title}}"},
+			{Name: "All", Command: "echo{{range .Issues}} {{.Identifier}}{{end}}", Multi: true},
+			{Name: "Where", Command: "printf '%s\\n' {{.WorktreePath}}\necho done"},
+			{Name: "Subs", Command: "echo {{.Children}} {{.CommentCount}}"},
+		},
+	}}
+}
+
+func TestRun_Print(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "single issue",
+			args: []string{"run", "Echo", "ENG-42", "--print"},
+			want: "echo 'ENG-42' 'Implement feature X'\n",
+		},
+		{
+			name: "once per issue",
+			args: []string{"run", "Echo", "ENG-42", "ENG-42", "-p"},
+			want: "echo 'ENG-42' 'Implement feature X'\necho 'ENG-42' 'Implement feature X'\n",
+		},
+		{
+			name: "multi",
+			args: []string{"run", "All", "ENG-42", "ENG-42", "-p"},
+			want: "echo 'ENG-42' 'ENG-42'\n",
+		},
+		{
+			name: "sub-issues and comments",
+			args: []string{"run", "Subs", "ENG-42", "-p"},
+			want: "echo 'ENG-43' 'ENG-44' 3\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newMockGraphQLServer(t, map[string]string{
+				"GetIssue":                 getIssueWithIDsResponse,
+				"IssueChildrenAndComments": issueChildrenAndCommentsResponse,
+			})
+			opts, stdout, _ := testOptionsWithBuffers(t, server)
+			opts.Config = runTestConfig()
+			root := cmd.NewRootCmd(opts)
+			root.SetArgs(tt.args)
+
+			if err := root.Execute(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := stdout.String(); got != tt.want {
+				t.Errorf("stdout = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun_ExecutesCommand(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"GetIssue":                 getIssueWithIDsResponse,
+		"IssueChildrenAndComments": issueChildrenAndCommentsResponse,
+	})
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	opts.Config = runTestConfig()
+	opts.GitWorktreeCreator = &mockGitWorktreeCreator{
+		repoRoot: "/src/repo",
+		worktrees: []cmd.GitWorktree{
+			{Path: "/src/repo", Branch: "main"},
+			{Path: "/src/eng-42/repo", Branch: "fred/eng-42-implement-feature-x"},
+		},
+	}
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"run", "Where", "ENG-42"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := stdout.String(), "/src/eng-42/repo\ndone\n"; got != want {
+		t.Errorf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestRun_CurrentBranchIssue(t *testing.T) {
+	t.Parallel()
+
+	server, rec := newRecordingGraphQLServer(t, map[string]string{
+		"ListTeams":                listTeamsResponse,
+		"GetIssue":                 getIssueWithIDsResponse,
+		"IssueChildrenAndComments": issueChildrenAndCommentsResponse,
+	})
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	opts.Config = runTestConfig()
+	opts.GitRunner = &mockGitRunner{currentBranch: "fred/eng-42-implement-feature-x"}
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"run", "Echo", "--print"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// One lookup checks the branch's issue exists, the other loads it.
+	calls := rec.calls("GetIssue")
+	if len(calls) != 2 || !strings.Contains(string(calls[1].Variables), `"ENG-42"`) {
+		t.Errorf("GetIssue calls = %+v, want two for ENG-42", calls)
+	}
+	if !strings.HasPrefix(stdout.String(), "echo 'ENG-42'") {
+		t.Errorf("stdout = %q", stdout.String())
+	}
+}
+
+func TestRun_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cfg  *config.Config
+		args []string
+		want string
+	}{
+		{
+			name: "unknown command",
+			cfg:  runTestConfig(),
+			args: []string{"run", "Nope", "ENG-42"},
+			want: `unknown command "Nope" (available: Echo, All, Where, Subs)`,
+		},
+		{
+			name: "no commands",
+			args: []string{"run", "Nope", "ENG-42"},
+			want: "no commands are configured",
+		},
+		{
+			name: "failing command",
+			cfg: &config.Config{Interactive: config.InteractiveConfig{
+				Commands: []config.Command{{Name: "Fail", Command: "exit 3"}},
+			}},
+			args: []string{"run", "Fail", "ENG-42"},
+			want: `command "Fail": exit status 3`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newMockGraphQLServer(t, map[string]string{
+				"GetIssue":                 getIssueWithIDsResponse,
+				"IssueChildrenAndComments": issueChildrenAndCommentsResponse,
+			})
+			opts, _, _ := testOptionsWithBuffers(t, server)
+			opts.Config = tt.cfg
+			root := cmd.NewRootCmd(opts)
+			root.SetArgs(tt.args)
+
+			err := root.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun_ListsCommands(t *testing.T) {
+	t.Parallel()
+
+	opts, stdout, _ := testOptionsWithBuffers(t, nil)
+	opts.Config = runTestConfig()
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"run"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{"NAME", "Echo", "echo {{.Identifier}} {{.Title}}", "printf '%s\\n' {{.WorktreePath}} ..."} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("output %q should contain %q", stdout.String(), want)
+		}
+	}
+}
+
+func TestRun_CompletesCommandNames(t *testing.T) {
+	t.Parallel()
+
+	opts, stdout, _ := testOptionsWithBuffers(t, nil)
+	opts.Config = runTestConfig()
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"__complete", "run", ""})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("completion returned error: %v", err)
+	}
+	for _, want := range []string{"Echo\techo {{.Identifier}} {{.Title}}", "All\t", "Where\t"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("completions %q should contain %q", stdout.String(), want)
+		}
+	}
+}
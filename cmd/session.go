@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+)
+
+// browseSession is the private directory of one interactive issue browser.
+// It holds the files the browser and the hidden commands run by its
+// bindings coordinate through (cycle state, exec command, after-actions),
+// so browsers running in several terminals don't clobber each other's.
+type browseSession struct {
+	dir     string
+	signals chan os.Signal
+	done    chan struct{}
+	once    sync.Once
+}
+
+// newBrowseSession creates a session directory under $XDG_RUNTIME_DIR,
+// falling back to the temp directory. It is removed by Close, or when the
+// process is interrupted, terminated or loses its terminal.
+func newBrowseSession() (*browseSession, error) {
+	var dir string
+	var err error
+	if base := os.Getenv("XDG_RUNTIME_DIR"); base != "" {
+		dir, err = os.MkdirTemp(base, "linear-session-*")
+	}
+	if dir == "" {
+		dir, err = os.MkdirTemp("", "linear-session-*")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("creating session directory: %w", err)
+	}
+
+	s := &browseSession{dir: dir, signals: make(chan os.Signal, 1), done: make(chan struct{})}
+	signal.Notify(s.signals, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	go func() {
+		select {
+		case sig := <-s.signals:
+			s.Close()
+			// Close restored the default handling, so the process now dies
+			// from the signal as it would have without the session.
+			_ = syscall.Kill(os.Getpid(), sig.(syscall.Signal))
+		case <-s.done:
+		}
+	}()
+	return s, nil
+}
+
+// path returns the path of the session file name.
+func (s *browseSession) path(name string) string {
+	return filepath.Join(s.dir, name)
+}
+
+// cycleFile returns the file holding the cycle the browser lists, written
+// by the cycle and view pickers along with its .header and .view
+// companions.
+func (s *browseSession) cycleFile() string {
+	return s.path("cycle")
+}
+
+// Close removes the session directory. It is safe to call more than once,
+// and must be called before exec-ing another program.
+func (s *browseSession) Close() {
+	s.once.Do(func() {
+		signal.Stop(s.signals)
+		close(s.done)
+		os.RemoveAll(s.dir)
+	})
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBrowseSession(t *testing.T) {
+	runtimeDir := t.TempDir()
+	t.Setenv("XDG_RUNTIME_DIR", runtimeDir)
+
+	a, err := newBrowseSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := newBrowseSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+
+	if filepath.Dir(a.dir) != runtimeDir {
+		t.Errorf("session dir %s should be in $XDG_RUNTIME_DIR %s", a.dir, runtimeDir)
+	}
+	if a.cycleFile() == b.cycleFile() {
+		t.Errorf("sessions share the cycle file %s", a.cycleFile())
+	}
+	info, err := os.Stat(a.dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o700 {
+		t.Errorf("session dir permissions = %o, want 700", perm)
+	}
+
+	if err := os.WriteFile(a.path("exec-command"), []byte("true"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	a.Close()
+	a.Close()
+	if _, err := os.Stat(a.dir); !os.IsNotExist(err) {
+		t.Errorf("session dir should be removed on close, stat: %v", err)
+	}
+	if _, err := os.Stat(b.dir); err != nil {
+		t.Errorf("closing a session removed another one: %v", err)
+	}
+}
+
+func TestBrowseSession_NoRuntimeDir(t *testing.T) {
+	t.Setenv("XDG_RUNTIME_DIR", filepath.Join(t.TempDir(), "missing"))
+
+	s, err := newBrowseSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if filepath.Dir(s.dir) != filepath.Clean(os.TempDir()) {
+		t.Errorf("session dir %s should fall back to the temp dir", s.dir)
+	}
+}
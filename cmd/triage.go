@@ -0,0 +1,512 @@
+package cmd
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/spf13/cobra"
+
+	"github.com/duboisf/linear/internal/api"
+	"github.com/duboisf/linear/internal/cache"
+	"github.com/duboisf/linear/internal/format"
+	"github.com/duboisf/linear/internal/tui"
+)
+
+// triageAction is a single-key action of "linear triage". run returns a
+// description of the change, empty when cancelled, and whether the issue
+// is triaged, which moves on to the next one. Interactive actions run
+// pickers or the editor, so the triage screen is suspended meanwhile.
+type triageAction struct {
+	key         string
+	help        string
+	interactive bool
+	run         func(s *triageSession, issue *api.GetIssueIssue) (result string, done bool, err error)
+}
+
+// _triageActions are the actions of "linear triage", in help order.
+var _triageActions = []triageAction{
+	{key: "a", help: "accept", run: func(s *triageSession, issue *api.GetIssueIssue) (string, bool, error) {
+		return s.moveTo(issue, "unstarted", "Todo")
+	}},
+	{key: "b", help: "backlog", run: func(s *triageSession, issue *api.GetIssueIssue) (string, bool, error) {
+		return s.moveTo(issue, "backlog", "Backlog")
+	}},
+	{key: "s", help: "status", interactive: true, run: func(s *triageSession, issue *api.GetIssueIssue) (string, bool, error) {
+		result, err := editStatus(s.ctx, s.client, []*api.GetIssueIssue{issue})
+		return result, false, err
+	}},
+	{key: "p", help: "priority", interactive: true, run: func(s *triageSession, issue *api.GetIssueIssue) (string, bool, error) {
+		result, err := editPriority(s.ctx, s.client, []*api.GetIssueIssue{issue})
+		return result, false, err
+	}},
+	{key: "m", help: "assign me", run: func(s *triageSession, issue *api.GetIssueIssue) (string, bool, error) {
+		return s.assignMe(issue)
+	}},
+	{key: "u", help: "assign", interactive: true, run: func(s *triageSession, issue *api.GetIssueIssue) (string, bool, error) {
+		result, err := editAssignee(s.ctx, s.client, s.cache, []*api.GetIssueIssue{issue})
+		return result, false, err
+	}},
+	{key: "l", help: "label", interactive: true, run: func(s *triageSession, issue *api.GetIssueIssue) (string, bool, error) {
+		result, err := editLabelsAdd(s.ctx, s.client, s.cache, []*api.GetIssueIssue{issue})
+		return result, false, err
+	}},
+	{key: "d", help: "duplicate", interactive: true, run: func(s *triageSession, issue *api.GetIssueIssue) (string, bool, error) {
+		return s.markDuplicate(issue)
+	}},
+	{key: "x", help: "decline", interactive: true, run: func(s *triageSession, issue *api.GetIssueIssue) (string, bool, error) {
+		return s.decline(issue)
+	}},
+	{key: "o", help: "open", run: func(s *triageSession, issue *api.GetIssueIssue) (string, bool, error) {
+		return "", false, openBrowser(issue.Url)
+	}},
+}
+
+// triageResult records what was done to an issue during a triage session.
+type triageResult struct {
+	Identifier string
+	Title      string
+	Changes    []string
+	// Triaged is set once an action moved the issue out of triage.
+	Triaged bool
+}
+
+// triageSession holds the state shared by the triage actions.
+type triageSession struct {
+	ctx    context.Context
+	client graphql.Client
+	cache  *cache.Cache
+	// teamKey is the team whose issues are triaged.
+	teamKey string
+	// states caches the workflow states of the teams, by team ID.
+	states map[string][]*api.ListWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState
+	viewer *api.ViewerViewerUser
+}
+
+// newTriageCmd creates the "triage" command, which walks a team's triage
+// queue one issue at a time.
+func newTriageCmd(opts Options) *cobra.Command {
+	var (
+		team       string
+		unassigned bool
+		limit      int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "triage",
+		Short: "Triage a team's issues one at a time",
+		Long: `Walk a team's triage issues one at a time, or its open unassigned issues
+with --unassigned, showing each issue in full with single-key actions:
+
+  a  accept (move to Todo)        u  assign (picker)
+  b  move to the backlog          l  add labels
+  s  set the status               d  mark as a duplicate of another issue
+  p  set the priority             x  decline with a comment
+  m  assign to me                 o  open in the browser
+  n  skip                         q  quit
+
+Accepting, moving to the backlog, marking as a duplicate and declining move
+on to the next issue. A summary of the session is printed at the end.
+
+Set a default team with "defaults: {triage: {team: ENG}}" in the config.`,
+		Example: `  linear triage --team ENG
+  linear triage --team ENG --unassigned`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if team == "" {
+				return fmt.Errorf("--team is required (or set it in the config's defaults)")
+			}
+			client, err := resolveClient(cmd, opts)
+			if err != nil {
+				return err
+			}
+
+			identifiers, err := fetchTriageIssues(cmd.Context(), client, team, unassigned, limit)
+			if err != nil {
+				return err
+			}
+			if len(identifiers) == 0 {
+				fmt.Fprintf(opts.Stderr, "No issues to triage in %s.\n", strings.ToUpper(team))
+				return nil
+			}
+
+			s := &triageSession{
+				ctx:     cmd.Context(),
+				client:  client,
+				cache:   opts.Cache,
+				teamKey: team,
+				states:  make(map[string][]*api.ListWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState),
+			}
+			results, err := runTriage(s, identifiers)
+			fmt.Fprint(opts.Stdout, formatTriageSummary(results, len(identifiers), format.ColorEnabled(opts.Stdout)))
+			return err
+		},
+	}
+
+	cmd.Flags().StringVarP(&team, "team", "t", "", "Key of the team to triage (e.g. ENG)")
+	cmd.Flags().BoolVar(&unassigned, "unassigned", false, "Walk the team's open unassigned issues instead of its triage issues")
+	cmd.Flags().IntVarP(&limit, "limit", "n", 50, "Maximum number of issues to walk")
+	_ = cmd.RegisterFlagCompletionFunc("team", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return completeTeamKeys(cmd, opts)
+	})
+	return cmd
+}
+
+// triageFilter returns the filter of the issues to triage in team: those in
+// a triage state or, with unassigned set, the open unassigned ones.
+func triageFilter(team string, unassigned bool) *api.IssueFilter {
+	key := strings.ToUpper(team)
+	filter := &api.IssueFilter{
+		Team: &api.TeamFilter{Key: &api.StringComparator{Eq: &key}},
+	}
+	if unassigned {
+		null := true
+		filter.Assignee = &api.NullableUserFilter{Null: &null}
+		filter.State = &api.WorkflowStateFilter{Type: &api.StringComparator{Nin: excludedStateTypes}}
+		return filter
+	}
+	triage := "triage"
+	filter.State = &api.WorkflowStateFilter{Type: &api.StringComparator{Eq: &triage}}
+	return filter
+}
+
+// fetchTriageIssues returns the identifiers of the issues to triage,
+// oldest first.
+func fetchTriageIssues(ctx context.Context, client graphql.Client, team string, unassigned bool, limit int) ([]string, error) {
+	ascending := api.PaginationSortOrderAscending
+	sort := []*api.IssueSortInput{{CreatedAt: &api.CreatedAtSort{Order: &ascending}}}
+	resp, err := api.ListIssues(ctx, client, limit, nil, triageFilter(team, unassigned), sort)
+	if err != nil {
+		return nil, fmt.Errorf("listing issues: %w", err)
+	}
+	if resp.Issues == nil {
+		return nil, nil
+	}
+	identifiers := make([]string, len(resp.Issues.Nodes))
+	for i, n := range resp.Issues.Nodes {
+		identifiers[i] = n.Identifier
+	}
+	return identifiers, nil
+}
+
+// runTriage shows the issues one at a time on the terminal and runs the
+// actions typed on them, until the last issue is triaged or skipped, or
+// the user quits. It returns what was done to the issues shown.
+func runTriage(s *triageSession, identifiers []string) ([]*triageResult, error) {
+	t, err := tui.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer t.Close()
+
+	var results []*triageResult
+	p := &tui.Pager{Footer: triageHelp()}
+	for i, identifier := range identifiers {
+		issue, err := s.getIssue(identifier)
+		if err != nil {
+			return results, err
+		}
+		r := &triageResult{Identifier: issue.Identifier, Title: issue.Title}
+		results = append(results, r)
+
+		quit, err := triageIssue(t, p, s, issue, r, fmt.Sprintf("(%d/%d)", i+1, len(identifiers)))
+		if err != nil || quit {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+// triageIssue shows issue until it is triaged or skipped, recording the
+// changes made to it in r. It reports whether the user quit.
+func triageIssue(t *tui.Terminal, p *tui.Pager, s *triageSession, issue *api.GetIssueIssue, r *triageResult, progress string) (quit bool, err error) {
+	p.Status = ""
+	p.SetText(formatIssueCache(issue))
+	for {
+		p.Header = []string{
+			format.Colorize(true, format.Bold, "Triage "+issue.Identifier) + " " + format.Colorize(true, format.Gray, progress),
+		}
+		if len(r.Changes) > 0 {
+			p.Header = append(p.Header, format.Colorize(true, format.Green, strings.Join(r.Changes, "; ")))
+		}
+
+		width, height := t.Size()
+		lines, halfPage := p.Frame(width, height)
+		if err := t.Draw(lines); err != nil {
+			return false, err
+		}
+		k, ok, err := t.ReadKey(time.Second)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			continue
+		}
+
+		switch k.String() {
+		case "q", "esc", "ctrl-c":
+			return true, nil
+		case "n":
+			return false, nil
+		case "ctrl-d", "pgdn":
+			p.Scroll(halfPage)
+			continue
+		case "ctrl-u", "pgup":
+			p.Scroll(-halfPage)
+			continue
+		case "down", "j":
+			p.Scroll(1)
+			continue
+		case "up", "k":
+			p.Scroll(-1)
+			continue
+		}
+		i := slices.IndexFunc(_triageActions, func(a triageAction) bool { return a.key == k.String() })
+		if i < 0 {
+			continue
+		}
+		a := _triageActions[i]
+
+		p.Status = ""
+		if a.interactive {
+			if err := t.Suspend(); err != nil {
+				return false, err
+			}
+		}
+		result, done, err := a.run(s, issue)
+		if a.interactive {
+			if rerr := t.Resume(); rerr != nil {
+				return false, rerr
+			}
+		}
+		if err != nil {
+			// The action may have failed after a change, e.g. marking a
+			// duplicate without a Duplicate state to move it to.
+			p.Status = fmt.Sprintf("%s: %v", a.help, err)
+		}
+		if result == "" {
+			continue // cancelled, failed, or nothing changed
+		}
+
+		r.Changes = append(r.Changes, result)
+		if s.cache != nil {
+			refreshIssueCache(s.ctx, s.client, s.cache, issue.Identifier)
+		}
+		if done {
+			r.Triaged = true
+			return false, nil
+		}
+		if updated, err := s.getIssue(issue.Identifier); err == nil {
+			issue = updated
+			p.SetText(formatIssueCache(issue))
+		}
+	}
+}
+
+// triageHelp returns the footer of the triage screen.
+func triageHelp() []string {
+	keys := make([]string, 0, len(_triageActions)+2)
+	for _, a := range _triageActions {
+		keys = append(keys, a.key+": "+a.help)
+	}
+	keys = append(keys, "n: skip", "q: quit")
+	return []string{format.Colorize(true, format.Gray, strings.Join(keys, "  "))}
+}
+
+// getIssue fetches an issue.
+func (s *triageSession) getIssue(identifier string) (*api.GetIssueIssue, error) {
+	issues, err := getIssues(s.ctx, s.client, []string{identifier})
+	if err != nil {
+		return nil, err
+	}
+	return issues[0], nil
+}
+
+// teamStates returns the workflow states of the issue's team.
+func (s *triageSession) teamStates(issue *api.GetIssueIssue) ([]*api.ListWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState, error) {
+	if issue.Team == nil {
+		return nil, fmt.Errorf("issue has no team")
+	}
+	if states, ok := s.states[issue.Team.Id]; ok {
+		return states, nil
+	}
+	resp, err := api.ListWorkflowStates(s.ctx, s.client, 50, issue.Team.Id)
+	if err != nil {
+		return nil, fmt.Errorf("listing workflow states: %w", err)
+	}
+	if resp.WorkflowStates == nil || len(resp.WorkflowStates.Nodes) == 0 {
+		return nil, fmt.Errorf("no workflow states found")
+	}
+	s.states[issue.Team.Id] = resp.WorkflowStates.Nodes
+	return resp.WorkflowStates.Nodes, nil
+}
+
+// triageState returns the state of type stateType named name or, when the
+// team has none by that name, its first state of that type.
+func triageState(states []*api.ListWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState, stateType, name string) (*api.ListWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState, bool) {
+	var matching []*api.ListWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState
+	for _, st := range states {
+		if st.Type == stateType {
+			matching = append(matching, st)
+		}
+	}
+	if len(matching) == 0 {
+		return nil, false
+	}
+	if i := slices.IndexFunc(matching, func(st *api.ListWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) bool {
+		return strings.EqualFold(st.Name, name)
+	}); i >= 0 {
+		return matching[i], true
+	}
+	return slices.MinFunc(matching, func(a, b *api.ListWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) int {
+		return cmp.Compare(a.Position, b.Position)
+	}), true
+}
+
+// moveTo moves issue to its team's state of type stateType, preferring the
+// one named name. This triages the issue.
+func (s *triageSession) moveTo(issue *api.GetIssueIssue, stateType, name string) (string, bool, error) {
+	states, err := s.teamStates(issue)
+	if err != nil {
+		return "", false, err
+	}
+	state, ok := triageState(states, stateType, name)
+	if !ok {
+		return "", false, fmt.Errorf("the team has no %s state", stateType)
+	}
+	input := &api.IssueUpdateInput{StateId: &state.Id}
+	if err := updateIssues(s.ctx, s.client, []*api.GetIssueIssue{issue}, input, "status update"); err != nil {
+		return "", false, err
+	}
+	return "status → " + state.Name, true, nil
+}
+
+// assignMe assigns issue to the current user.
+func (s *triageSession) assignMe(issue *api.GetIssueIssue) (string, bool, error) {
+	if s.viewer == nil {
+		resp, err := api.Viewer(s.ctx, s.client)
+		if err != nil {
+			return "", false, fmt.Errorf("getting current user: %w", err)
+		}
+		if resp.Viewer == nil {
+			return "", false, fmt.Errorf("getting current user: no viewer in response")
+		}
+		s.viewer = resp.Viewer
+	}
+	input := &api.IssueUpdateInput{AssigneeId: &s.viewer.Id}
+	if err := updateIssues(s.ctx, s.client, []*api.GetIssueIssue{issue}, input, "assignee update"); err != nil {
+		return "", false, err
+	}
+	return "assignee → " + s.viewer.Name, false, nil
+}
+
+// markDuplicate picks another open issue of the team, marks issue as its
+// duplicate and moves issue to the team's Duplicate state.
+func (s *triageSession) markDuplicate(issue *api.GetIssueIssue) (string, bool, error) {
+	key := strings.ToUpper(s.teamKey)
+	filter := &api.IssueFilter{
+		Team:  &api.TeamFilter{Key: &api.StringComparator{Eq: &key}},
+		State: &api.WorkflowStateFilter{Type: &api.StringComparator{Nin: excludedStateTypes}},
+	}
+	resp, err := api.ListIssues(s.ctx, s.client, 250, nil, filter, nil)
+	if err != nil {
+		return "", false, fmt.Errorf("listing issues: %w", err)
+	}
+	var candidates []issueForCompletion
+	ids := make(map[string]string)
+	if resp.Issues != nil {
+		for _, n := range resp.Issues.Nodes {
+			if n.Identifier == issue.Identifier {
+				continue
+			}
+			c := issueForCompletion{Identifier: n.Identifier, Title: n.Title, Priority: n.Priority}
+			if n.State != nil {
+				c.StateName, c.StateType = n.State.Name, n.State.Type
+			}
+			candidates = append(candidates, c)
+			ids[n.Identifier] = n.Id
+		}
+	}
+	original, err := fzfPickIssue(candidates)
+	if err != nil || original == "" {
+		return "", false, err
+	}
+	return s.duplicateOf(issue, original, ids[original])
+}
+
+// duplicateOf marks issue as a duplicate of the issue with the given
+// identifier and ID, and moves issue to the team's Duplicate state.
+func (s *triageSession) duplicateOf(issue *api.GetIssueIssue, identifier, id string) (string, bool, error) {
+	resp, err := api.CreateDuplicateRelation(s.ctx, s.client, issue.Id, id)
+	if err != nil {
+		return "", false, fmt.Errorf("marking %s as a duplicate: %w", issue.Identifier, err)
+	}
+	if resp.IssueRelationCreate == nil || !resp.IssueRelationCreate.Success {
+		return "", false, fmt.Errorf("marking %s as a duplicate was not successful", issue.Identifier)
+	}
+	result := "duplicate of " + identifier
+	moved, _, err := s.moveTo(issue, "canceled", "Duplicate")
+	if err != nil {
+		return result, true, err
+	}
+	return result + ", " + moved, true, nil
+}
+
+// decline asks for a comment in the editor, posts it on issue and moves
+// issue to the team's Canceled state. An empty comment cancels.
+func (s *triageSession) decline(issue *api.GetIssueIssue) (string, bool, error) {
+	body, err := editInEditor("", "linear-decline-*.md")
+	if err != nil {
+		return "", false, err
+	}
+	if body = strings.TrimSpace(body); body == "" {
+		return "", false, nil
+	}
+	return s.declineWith(issue, body)
+}
+
+// declineWith posts body on issue and moves issue to the team's Canceled
+// state.
+func (s *triageSession) declineWith(issue *api.GetIssueIssue, body string) (string, bool, error) {
+	resp, err := api.CreateComment(s.ctx, s.client, issue.Id, body)
+	if err != nil {
+		return "", false, fmt.Errorf("commenting on %s: %w", issue.Identifier, err)
+	}
+	if resp.CommentCreate == nil || !resp.CommentCreate.Success {
+		return "", false, fmt.Errorf("commenting on %s was not successful", issue.Identifier)
+	}
+	moved, _, err := s.moveTo(issue, "canceled", "Canceled")
+	if err != nil {
+		return "declined", true, err
+	}
+	return "declined, " + moved, true, nil
+}
+
+// formatTriageSummary returns the summary printed after a triage session:
+// the changes made to each issue, then counts of the issues triaged,
+// updated without being triaged, skipped, and not reached.
+func formatTriageSummary(results []*triageResult, total int, color bool) string {
+	var b strings.Builder
+	var triaged, updated, skipped int
+	for _, r := range results {
+		switch {
+		case r.Triaged:
+			triaged++
+		case len(r.Changes) > 0:
+			updated++
+		default:
+			skipped++
+			continue
+		}
+		fmt.Fprintf(&b, "%s %s: %s\n", format.Colorize(color, format.Bold, r.Identifier), truncate(r.Title, 50), strings.Join(r.Changes, "; "))
+	}
+	if b.Len() > 0 {
+		b.WriteByte('\n')
+	}
+	fmt.Fprintf(&b, "%d triaged, %d updated, %d skipped, %d left\n", triaged, updated, skipped, total-len(results))
+	return b.String()
+}
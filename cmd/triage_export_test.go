@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/duboisf/linear/internal/api"
+)
+
+// TriageResult is an exported alias of triageResult for testing.
+type TriageResult = triageResult
+
+// FormatTriageSummary is an exported wrapper for testing, without color.
+func FormatTriageSummary(results []*TriageResult, total int) string {
+	return formatTriageSummary(results, total, false)
+}
+
+// TriageStateName returns the name of the state triageState picks among
+// states, given as name, type pairs in position order.
+func TriageStateName(states [][2]string, stateType, name string) string {
+	nodes := make([]*api.ListWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState, len(states))
+	for i, s := range states {
+		nodes[i] = &api.ListWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState{Name: s[0], Type: s[1], Position: float64(i)}
+	}
+	state, ok := triageState(nodes, stateType, name)
+	if !ok {
+		return ""
+	}
+	return state.Name
+}
+
+// newTestTriageSession returns a triage session of team ENG talking to the
+// GraphQL server at endpoint, with the issue identifier.
+func newTestTriageSession(endpoint, identifier string) (*triageSession, *api.GetIssueIssue, error) {
+	s := &triageSession{
+		ctx:     context.Background(),
+		client:  api.NewClient("test-key", endpoint),
+		teamKey: "ENG",
+		states:  make(map[string][]*api.ListWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState),
+	}
+	issue, err := s.getIssue(identifier)
+	return s, issue, err
+}
+
+// RunTriageAction runs the triage action bound to key on the issue
+// identifier, against the GraphQL server at endpoint.
+func RunTriageAction(endpoint, key, identifier string) (result string, done bool, err error) {
+	i := slices.IndexFunc(_triageActions, func(a triageAction) bool { return a.key == key })
+	if i < 0 {
+		return "", false, fmt.Errorf("no triage action bound to %q", key)
+	}
+	s, issue, err := newTestTriageSession(endpoint, identifier)
+	if err != nil {
+		return "", false, err
+	}
+	return _triageActions[i].run(s, issue)
+}
+
+// TriageDuplicateOf marks the issue identifier as a duplicate of original,
+// skipping the issue picker.
+func TriageDuplicateOf(endpoint, identifier, original, originalID string) (result string, done bool, err error) {
+	s, issue, err := newTestTriageSession(endpoint, identifier)
+	if err != nil {
+		return "", false, err
+	}
+	return s.duplicateOf(issue, original, originalID)
+}
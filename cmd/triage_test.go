@@ -0,0 +1,255 @@
+package cmd_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/cmd"
+)
+
+const emptyListIssuesResponse = `{
+	"data": {
+		"issues": {
+			"nodes": []
+		}
+	}
+}`
+
+const createDuplicateRelationResponse = `{
+	"data": {
+		"issueRelationCreate": {
+			"success": true
+		}
+	}
+}`
+
+const triageWorkflowStatesResponse = `{
+	"data": {
+		"workflowStates": {
+			"nodes": [
+				{"id": "state-triage", "name": "Triage", "type": "triage", "position": 0},
+				{"id": "state-backlog", "name": "Backlog", "type": "backlog", "position": 1},
+				{"id": "state-todo", "name": "Todo", "type": "unstarted", "position": 2},
+				{"id": "state-duplicate", "name": "Duplicate", "type": "canceled", "position": 3},
+				{"id": "state-canceled", "name": "Canceled", "type": "canceled", "position": 4}
+			]
+		}
+	}
+}`
+
+func TestTriage_RequiresTeam(t *testing.T) {
+	t.Parallel()
+
+	opts, _, _ := testOptionsWithBuffers(t, nil)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"triage"})
+
+	err := root.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--team is required") {
+		t.Fatalf("expected a missing team error, got %v", err)
+	}
+}
+
+func TestTriage_NoIssues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "triage state",
+			args: []string{"triage", "--team", "eng"},
+			want: []string{`"key":{"eq":"ENG"}`, `"type":{"eq":"triage"}`, `"sort":[{"createdAt":{"order":"Ascending"}}]`},
+		},
+		{
+			name: "unassigned",
+			args: []string{"triage", "--team", "ENG", "--unassigned"},
+			want: []string{`"key":{"eq":"ENG"}`, `"assignee":{"null":true}`, `"nin":["completed","canceled"]`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, rec := newRecordingGraphQLServer(t, map[string]string{"ListIssues": emptyListIssuesResponse})
+			opts, stdout, stderr := testOptionsWithBuffers(t, server)
+			root := cmd.NewRootCmd(opts)
+			root.SetArgs(tt.args)
+
+			if err := root.Execute(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			calls := rec.calls("ListIssues")
+			if len(calls) != 1 {
+				t.Fatalf("expected 1 ListIssues call, got %d", len(calls))
+			}
+			vars := string(calls[0].Variables)
+			for _, want := range tt.want {
+				if !strings.Contains(vars, want) {
+					t.Errorf("ListIssues variables %s should contain %s", vars, want)
+				}
+			}
+			if got := stderr.String(); got != "No issues to triage in ENG.\n" {
+				t.Errorf("stderr = %q", got)
+			}
+			if stdout.Len() != 0 {
+				t.Errorf("stdout should be empty, got %q", stdout.String())
+			}
+		})
+	}
+}
+
+func TestTriageState(t *testing.T) {
+	t.Parallel()
+
+	states := [][2]string{
+		{"Backlog", "backlog"},
+		{"Duplicate", "canceled"},
+		{"Canceled", "canceled"},
+		{"Todo", "unstarted"},
+	}
+	tests := []struct {
+		stateType, name, want string
+	}{
+		{"canceled", "Canceled", "Canceled"},
+		{"canceled", "duplicate", "Duplicate"},
+		{"canceled", "Won't do", "Duplicate"},
+		{"unstarted", "Todo", "Todo"},
+		{"started", "In Progress", ""},
+	}
+	for _, tt := range tests {
+		if got := cmd.TriageStateName(states, tt.stateType, tt.name); got != tt.want {
+			t.Errorf("TriageStateName(%q, %q) = %q, want %q", tt.stateType, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestTriageAction_Accept(t *testing.T) {
+	t.Parallel()
+
+	server, rec := newRecordingGraphQLServer(t, map[string]string{
+		"GetIssue":           getIssueWithIDsResponse,
+		"ListWorkflowStates": triageWorkflowStatesResponse,
+		"UpdateIssue":        updateIssueResponse,
+	})
+
+	result, done, err := cmd.RunTriageAction(server.URL, "a", "ENG-42")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != "status → Todo" || !done {
+		t.Errorf("accept = %q, %v; want %q, true", result, done, "status → Todo")
+	}
+	updates := rec.calls("UpdateIssue")
+	if len(updates) != 1 || !strings.Contains(string(updates[0].Variables), `"stateId":"state-todo"`) {
+		t.Errorf("expected an UpdateIssue call moving the issue to Todo, got %v", updates)
+	}
+}
+
+func TestTriageAction_AssignMe(t *testing.T) {
+	t.Parallel()
+
+	server, rec := newRecordingGraphQLServer(t, map[string]string{
+		"GetIssue":    getIssueWithIDsResponse,
+		"Viewer":      viewerResponse,
+		"UpdateIssue": updateIssueResponse,
+	})
+
+	result, done, err := cmd.RunTriageAction(server.URL, "m", "ENG-42")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != "assignee → Fred Dubois" || done {
+		t.Errorf("assign me = %q, %v; want %q, false", result, done, "assignee → Fred Dubois")
+	}
+	if n := len(rec.calls("UpdateIssue")); n != 1 {
+		t.Errorf("expected 1 UpdateIssue call, got %d", n)
+	}
+}
+
+func TestTriageAction_Decline(t *testing.T) {
+	editor := filepath.Join(t.TempDir(), "editor")
+	if err := os.WriteFile(editor, []byte("#!/bin/sh\necho 'Not a bug' > \"$1\"\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("VISUAL", editor)
+
+	server, rec := newRecordingGraphQLServer(t, map[string]string{
+		"GetIssue":           getIssueWithIDsResponse,
+		"CreateComment":      createCommentResponse,
+		"ListWorkflowStates": triageWorkflowStatesResponse,
+		"UpdateIssue":        updateIssueResponse,
+	})
+
+	result, done, err := cmd.RunTriageAction(server.URL, "x", "ENG-42")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "declined, status → Canceled"; result != want || !done {
+		t.Errorf("decline = %q, %v; want %q, true", result, done, want)
+	}
+	comments := rec.calls("CreateComment")
+	if len(comments) != 1 || !strings.Contains(string(comments[0].Variables), `"body":"Not a bug"`) {
+		t.Errorf("expected a CreateComment call with the edited body, got %v", comments)
+	}
+	updates := rec.calls("UpdateIssue")
+	if len(updates) != 1 || !strings.Contains(string(updates[0].Variables), `"stateId":"state-canceled"`) {
+		t.Errorf("expected an UpdateIssue call moving the issue to Canceled, got %v", updates)
+	}
+}
+
+func TestTriageDuplicateOf(t *testing.T) {
+	t.Parallel()
+
+	server, rec := newRecordingGraphQLServer(t, map[string]string{
+		"GetIssue":                getIssueWithIDsResponse,
+		"CreateDuplicateRelation": createDuplicateRelationResponse,
+		"ListWorkflowStates":      triageWorkflowStatesResponse,
+		"UpdateIssue":             updateIssueResponse,
+	})
+
+	result, done, err := cmd.TriageDuplicateOf(server.URL, "ENG-42", "ENG-7", "issue-7")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "duplicate of ENG-7, status → Duplicate"; result != want || !done {
+		t.Errorf("duplicate = %q, %v; want %q, true", result, done, want)
+	}
+	relations := rec.calls("CreateDuplicateRelation")
+	if len(relations) != 1 {
+		t.Fatalf("expected 1 CreateDuplicateRelation call, got %d", len(relations))
+	}
+	if vars := string(relations[0].Variables); !strings.Contains(vars, `"issueId":"issue-1"`) || !strings.Contains(vars, `"relatedIssueId":"issue-7"`) {
+		t.Errorf("CreateDuplicateRelation variables %s should relate issue-1 to issue-7", vars)
+	}
+	updates := rec.calls("UpdateIssue")
+	if len(updates) != 1 || !strings.Contains(string(updates[0].Variables), `"stateId":"state-duplicate"`) {
+		t.Errorf("expected an UpdateIssue call moving the issue to Duplicate, got %v", updates)
+	}
+}
+
+func TestFormatTriageSummary(t *testing.T) {
+	t.Parallel()
+
+	results := []*cmd.TriageResult{
+		{Identifier: "ENG-1", Title: "Login fails", Changes: []string{"priority → High", "status → Todo"}, Triaged: true},
+		{Identifier: "ENG-2", Title: "Slow search"},
+		{Identifier: "ENG-3", Title: "Typo", Changes: []string{"labels added: docs"}},
+	}
+	got := cmd.FormatTriageSummary(results, 5)
+	want := "ENG-1 Login fails: priority → High; status → Todo\n" +
+		"ENG-3 Typo: labels added: docs\n" +
+		"\n" +
+		"1 triaged, 1 updated, 1 skipped, 2 left\n"
+	if got != want {
+		t.Errorf("FormatTriageSummary() =\n%s\nwant\n%s", got, want)
+	}
+
+	if got := cmd.FormatTriageSummary(nil, 3); got != "0 triaged, 0 updated, 0 skipped, 3 left\n" {
+		t.Errorf("FormatTriageSummary(nil) = %q", got)
+	}
+}
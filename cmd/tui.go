@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/duboisf/linear/internal/config"
+	"github.com/duboisf/linear/internal/tui"
+)
+
+// _interactiveBackendEnv holds the interactive backend (see
+// interactive.backend). It is set from the config when the CLI starts and
+// passed to the hidden commands run by the issue browser, so their pickers
+// use the same backend.
+const _interactiveBackendEnv = "LINEAR_INTERACTIVE_BACKEND"
+
+// useBuiltinTUI reports whether the issue browser and pickers use the
+// builtin terminal UI rather than fzf: when LINEAR_INTERACTIVE_BACKEND is
+// "builtin", or when it's unset and fzf isn't installed.
+func useBuiltinTUI() bool {
+	switch os.Getenv(_interactiveBackendEnv) {
+	case config.BackendBuiltin:
+		return true
+	case config.BackendFzf:
+		return false
+	}
+	_, err := exec.LookPath("fzf")
+	return err != nil
+}
+
+// exportInteractiveBackend sets LINEAR_INTERACTIVE_BACKEND from
+// interactive.backend, unless the environment already sets it.
+func exportInteractiveBackend(cfg *config.Config) {
+	if cfg == nil || cfg.Interactive.Backend == "" || os.Getenv(_interactiveBackendEnv) != "" {
+		return
+	}
+	_ = os.Setenv(_interactiveBackendEnv, cfg.Interactive.Backend)
+}
+
+// tuiPickLines is the builtin counterpart of fzfPickValue and
+// fzfPickMultiValue: it shows lines below header and returns the picked
+// ones. Lines are tab-delimited; hideIDs hides their first field.
+func tuiPickLines(header string, lines []string, hideIDs, multi bool) ([]string, error) {
+	items := make([]tui.Item, len(lines))
+	for i, line := range lines {
+		items[i] = tui.Item{Value: line}
+		if _, display, ok := strings.Cut(line, "\t"); ok && hideIDs {
+			items[i].Display = display
+		}
+	}
+	picked, err := tui.Pick(tui.NewList(items, multi), []string{header})
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, len(picked))
+	for i, it := range picked {
+		values[i] = it.Value
+	}
+	return values, nil
+}
@@ -0,0 +1,407 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+
+	"github.com/duboisf/linear/internal/cache"
+	"github.com/duboisf/linear/internal/config"
+	"github.com/duboisf/linear/internal/format"
+	"github.com/duboisf/linear/internal/tui"
+)
+
+// tuiAction is what a binding does in the builtin issue browser: the
+// arguments of the hidden command it runs, and what to refresh once it
+// returns. It mirrors fzfBindContext.fzfAction.
+type tuiAction struct {
+	args []string
+	// silent runs the command without handing it the terminal.
+	silent bool
+	// reload refreshes the issue list.
+	reload bool
+	// deselect clears the selection.
+	deselect bool
+	// header re-reads the cycle header written by the cycle and view
+	// pickers.
+	header bool
+	// command reads the exec and after-actions files written by
+	// run-command.
+	command bool
+}
+
+// tuiAction returns the action run by binding b on the issues ids.
+func (c fzfBindContext) tuiAction(b fzfBinding, ids []string) tuiAction {
+	if name, ok := strings.CutPrefix(b.action, _commandActionPrefix); ok {
+		i := slices.IndexFunc(c.commands, func(cmd config.Command) bool { return cmd.Name == name })
+		silent := i >= 0 && (c.commands[i].Silent || c.commands[i].Output == config.OutputPreview) && !c.commands[i].Confirm
+		return tuiAction{args: c.runCommandArgs([]string{"--command", name}, ids), silent: silent, command: true}
+	}
+	switch b.action {
+	case actionEdit:
+		return tuiAction{args: append([]string{"issue", "edit-interactive"}, ids...), reload: true, deselect: true}
+	case actionStatus:
+		return tuiAction{args: append([]string{"issue", "edit-interactive", "--field", "Status"}, ids...), reload: true, deselect: true}
+	case actionSwitchCycle:
+		return tuiAction{args: []string{"issue", "pick-cycle", "--state-file", c.cycleStateFile}, reload: true, header: true}
+	case actionSwitchView:
+		return tuiAction{args: []string{"issue", "pick-view", "--state-file", c.cycleStateFile}, reload: true, header: true}
+	case actionCommand:
+		return tuiAction{args: c.runCommandArgs(nil, ids), command: true}
+	case actionOpen, actionCopyID:
+		return tuiAction{args: append([]string{"issue", "action", b.action}, ids...), silent: true}
+	case actionComment:
+		return tuiAction{args: append([]string{"issue", "action", b.action}, ids...), deselect: true}
+	case actionAssignMe:
+		return tuiAction{args: append([]string{"issue", "action", b.action}, ids...), reload: true, deselect: true}
+	}
+	return tuiAction{}
+}
+
+// runCommandArgs returns the arguments of run-command for the issues ids,
+// with flags before the files it uses (see commandAction).
+func (c fzfBindContext) runCommandArgs(flags, ids []string) []string {
+	args := append([]string{"issue", "run-command"}, flags...)
+	args = append(args,
+		"--exec-file", c.execFile,
+		"--issue-data-dir", c.issueDataDir,
+		"--after-actions-file", c.afterActionsFile,
+		"--",
+	)
+	return append(args, ids...)
+}
+
+// parseAfterActions reads the fzf actions run-command writes to the
+// after-actions file (see writeAfterActions): whether the list is
+// reloaded, and the file shown in the preview, if any.
+func parseAfterActions(actions string) (reload bool, previewFile string) {
+	reload = strings.HasPrefix(actions, "reload(")
+	if _, rest, ok := strings.Cut(actions, "preview(cat '"); ok {
+		previewFile, _, _ = strings.Cut(rest, "')")
+	}
+	return reload, previewFile
+}
+
+// tuiBrowseIssues is the builtin counterpart of fzfBrowseIssues, taking
+// the same arguments: it shows the issues in a terminal UI with a preview
+// pane, running the bound actions through the same hidden commands. Issues
+// are fetched while the UI starts. Returns the selected identifiers, or
+// nil if cancelled.
+func tuiBrowseIssues(ctx context.Context, client graphql.Client, fetchIssues func(context.Context) ([]*issueNode, error), c *cache.Cache, cycleHeader string, reloadCmd string, colSet *format.Columns, columns []string, session *browseSession, commands []config.Command, bindings []fzfBinding) ([]string, error) {
+	// Detect the terminal background before the UI takes over the
+	// terminal, as in fzfBrowseIssues.
+	_ = glamourStyle()
+
+	type fetchResult struct {
+		header string
+		lines  []string
+		err    error
+	}
+	fetchCh := make(chan fetchResult, 1)
+	fetchCtx, fetchCancel := context.WithCancel(ctx)
+	defer fetchCancel()
+	go func() {
+		nodes, err := fetchIssues(fetchCtx)
+		if err != nil {
+			fetchCh <- fetchResult{err: err}
+			return
+		}
+		if len(nodes) == 0 {
+			fetchCh <- fetchResult{err: fmt.Errorf("no issues to browse")}
+			return
+		}
+		identifiers := make([]string, len(nodes))
+		for i, n := range nodes {
+			identifiers[i] = n.Identifier
+		}
+		go prefetchIssueDetails(ctx, client, c, identifiers, len(commands) > 0)
+
+		cols := columns
+		if cols == nil {
+			cols = format.DefaultColumns(nodes)
+		}
+		header, lines := format.FormatFzfLines(nodes, colSet, cols)
+		fetchCh <- fetchResult{header: header, lines: lines}
+	}()
+
+	self, _ := os.Executable()
+	help := browserHelp(bindings)
+	bindCtx, err := newFzfBindContext(session, c, self, reloadCmd, strings.Join(help, `\n`), commands)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := tui.Open()
+	if err != nil {
+		// Surface fetch errors (e.g. API failure, empty list) over
+		// terminal errors.
+		if res := <-fetchCh; res.err != nil {
+			return nil, res.err
+		}
+		return nil, err
+	}
+	defer t.Close()
+
+	b := &tui.Browser{List: tui.NewList(nil, true), Status: "Loading issues…"}
+	setHeader := func(cycleHeader string) {
+		b.Header = help
+		if cycleHeader != "" {
+			b.Header = append([]string{cycleHeader}, help...)
+		}
+	}
+	setHeader(cycleHeader)
+	setLines := func(header string, lines []string) {
+		items := make([]tui.Item, len(lines))
+		for i, line := range lines {
+			items[i] = tui.Item{Value: line}
+		}
+		b.List.SetHeader(header)
+		b.List.SetItems(items)
+	}
+	reload := func() {
+		out, err := exec.Command("/bin/sh", "-c", reloadCmd).Output()
+		if err != nil {
+			b.Status = fmt.Sprintf("reloading issues: %v", err)
+			return
+		}
+		header, lines, _ := strings.Cut(strings.TrimSuffix(string(out), "\n"), "\n")
+		var items []string
+		if lines != "" {
+			items = strings.Split(lines, "\n")
+		}
+		setLines(header, items)
+	}
+
+	loading := true
+	// previewID is the issue in the preview, and previewFile the output of
+	// a command shown instead of it until the cursor moves.
+	var previewID, previewFile string
+	previewMode := previewDetails
+	previews := newTUIPreviews(self)
+	for {
+		if loading {
+			select {
+			case res := <-fetchCh:
+				if res.err != nil {
+					return nil, res.err
+				}
+				setLines(res.header, res.lines)
+				loading, b.Status = false, ""
+			default:
+			}
+		}
+
+		var ids []string
+		for _, it := range b.List.Picked() {
+			ids = append(ids, fzfSelectedID(it.Value))
+		}
+		current := ""
+		if it, ok := b.List.Current(); ok {
+			current = fzfSelectedID(it.Value)
+		}
+		if current != previewID {
+			previewID, previewFile = current, ""
+		}
+		b.SetPreview(previews.preview(previewMode, previewID, previewFile))
+
+		width, height := t.Size()
+		lines, halfPage := b.Frame(width, height)
+		if err := t.Draw(lines); err != nil {
+			return nil, err
+		}
+
+		// Poll so issues and previews show up as they are fetched.
+		k, ok, err := t.ReadKey(100 * time.Millisecond)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		b.Status = ""
+
+		switch k.Name {
+		case "enter":
+			return ids, nil
+		case "esc", "ctrl-c", "ctrl-g":
+			return nil, nil
+		case "ctrl-d":
+			b.ScrollPreview(halfPage)
+			continue
+		case "ctrl-u":
+			b.ScrollPreview(-halfPage)
+			continue
+		case "shift-down":
+			b.ScrollPreview(1)
+			continue
+		case "shift-up":
+			b.ScrollPreview(-1)
+			continue
+		}
+
+		i := slices.IndexFunc(bindings, func(bd fzfBinding) bool { return bd.key == k.Name })
+		if i < 0 {
+			b.List.HandleKey(k)
+			continue
+		}
+		switch bindings[i].action {
+		case actionNextPreview:
+			previewMode, previewFile = stepPreviewMode(previewMode, 1), ""
+			continue
+		case actionPrevPreview:
+			previewMode, previewFile = stepPreviewMode(previewMode, -1), ""
+			continue
+		}
+		if loading || (len(ids) == 0 && bindings[i].action != actionSwitchCycle && bindings[i].action != actionSwitchView) {
+			continue
+		}
+
+		a := bindCtx.tuiAction(bindings[i], ids)
+		if len(a.args) == 0 {
+			continue
+		}
+		if err := runTUIAction(t, self, a); err != nil {
+			b.Status = fmt.Sprintf("%s: %v", bindings[i].help(), err)
+		}
+
+		doReload := a.reload
+		if a.command {
+			// An exec command replaces the process, like in fzfBrowseIssues.
+			if data, err := os.ReadFile(bindCtx.execFile); err == nil {
+				t.Close()
+				session.Close()
+				return nil, syscall.Exec("/bin/sh", []string{"sh", "-c", string(data)}, os.Environ())
+			}
+			if actions, err := os.ReadFile(bindCtx.afterActionsFile); err == nil {
+				doReload, previewFile = parseAfterActions(string(actions))
+				a.deselect = true
+			}
+		}
+		if a.header {
+			header, _ := os.ReadFile(bindCtx.cycleStateFile + ".header")
+			setHeader(string(header))
+		}
+		if doReload && reloadCmd != "" {
+			reload()
+		}
+		if a.reload || a.deselect {
+			// The issues may have changed; their previews are rendered
+			// again from the cache the command updated.
+			previews.reset()
+		}
+		if a.deselect {
+			b.List.DeselectAll()
+		}
+	}
+}
+
+// runTUIAction runs the hidden command of a, handing it the terminal
+// unless it is silent. The command's pickers use the builtin UI too.
+func runTUIAction(t *tui.Terminal, self string, a tuiAction) error {
+	cmd := exec.Command(self, a.args...)
+	cmd.Env = append(os.Environ(),
+		_glamourStyleEnv+"="+glamourStyle(),
+		_interactiveBackendEnv+"="+config.BackendBuiltin,
+	)
+	if !a.silent {
+		return t.Run(cmd)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%s", msg)
+		}
+		return err
+	}
+	return nil
+}
+
+// tuiPreviews renders the builtin browser's previews in the background with
+// the hidden issue preview command, as fzf does, keeping them until reset.
+type tuiPreviews struct {
+	self string
+	mu   sync.Mutex
+	// rendered holds the previews by mode and issue, and pending those
+	// being rendered.
+	rendered map[string]string
+	pending  map[string]bool
+	// gen is bumped by reset, so renders started before are dropped.
+	gen int
+}
+
+func newTUIPreviews(self string) *tuiPreviews {
+	return &tuiPreviews{self: self, rendered: make(map[string]string), pending: make(map[string]bool)}
+}
+
+// preview returns the preview of issue id in mode, starting to render it
+// when needed: the file of a command's output when set, else the rendered
+// preview or a placeholder until it's ready.
+func (p *tuiPreviews) preview(mode, id, file string) string {
+	if id == "" {
+		return ""
+	}
+	if file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "\033[2mLoading preview…\033[0m"
+		}
+		return string(data)
+	}
+
+	key := mode + "/" + id
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if content, ok := p.rendered[key]; ok {
+		return content
+	}
+	if !p.pending[key] {
+		p.pending[key] = true
+		go p.render(p.gen, key, mode, id)
+	}
+	return previewTabs(id, mode) + "\033[2mLoading preview…\033[0m"
+}
+
+// render runs issue preview for issue id in mode and stores its output,
+// or its error, under key.
+func (p *tuiPreviews) render(gen int, key, mode, id string) {
+	cmd := exec.Command(p.self, "issue", "preview", "--mode", mode, id)
+	cmd.Env = append(os.Environ(), _glamourStyleEnv+"="+glamourStyle())
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	content := string(out)
+	if err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		content = previewTabs(id, mode) + "\033[31m" + msg + "\033[0m"
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if gen != p.gen {
+		return
+	}
+	delete(p.pending, key)
+	p.rendered[key] = content
+}
+
+// reset drops the rendered previews, so they are rendered again.
+func (p *tuiPreviews) reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.gen++
+	clear(p.rendered)
+	clear(p.pending)
+}
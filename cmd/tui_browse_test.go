@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/duboisf/linear/internal/config"
+)
+
+func TestFzfBindContext_TUIAction(t *testing.T) {
+	t.Parallel()
+
+	c := fzfBindContext{
+		commands: []config.Command{
+			{Name: "Claude Code", Command: "claude"},
+			{Name: "Summary", Command: "summarize", Output: config.OutputPreview},
+		},
+		cycleStateFile:   "/tmp/cycle",
+		execFile:         "/cache/exec-command",
+		issueDataDir:     "/cache/issue-data",
+		afterActionsFile: "/cache/after-actions",
+	}
+	ids := []string{"ENG-1", "ENG-2"}
+	runCommand := []string{"--exec-file", "/cache/exec-command", "--issue-data-dir", "/cache/issue-data", "--after-actions-file", "/cache/after-actions", "--", "ENG-1", "ENG-2"}
+	tests := []struct {
+		action string
+		want   tuiAction
+	}{
+		{actionEdit, tuiAction{args: []string{"issue", "edit-interactive", "ENG-1", "ENG-2"}, reload: true, deselect: true}},
+		{actionStatus, tuiAction{args: []string{"issue", "edit-interactive", "--field", "Status", "ENG-1", "ENG-2"}, reload: true, deselect: true}},
+		{actionSwitchCycle, tuiAction{args: []string{"issue", "pick-cycle", "--state-file", "/tmp/cycle"}, reload: true, header: true}},
+		{actionOpen, tuiAction{args: []string{"issue", "action", "open", "ENG-1", "ENG-2"}, silent: true}},
+		{actionComment, tuiAction{args: []string{"issue", "action", "comment", "ENG-1", "ENG-2"}, deselect: true}},
+		{actionCommand, tuiAction{args: append([]string{"issue", "run-command"}, runCommand...), command: true}},
+		{"command:Claude Code", tuiAction{args: append([]string{"issue", "run-command", "--command", "Claude Code"}, runCommand...), command: true}},
+		{"command:Summary", tuiAction{args: append([]string{"issue", "run-command", "--command", "Summary"}, runCommand...), silent: true, command: true}},
+	}
+	for _, tt := range tests {
+		if got := c.tuiAction(fzfBinding{key: "ctrl-x", action: tt.action}, ids); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("tuiAction(%s) =\n%+v\nwant\n%+v", tt.action, got, tt.want)
+		}
+	}
+}
+
+func TestParseAfterActions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		actions     string
+		wantReload  bool
+		wantPreview string
+	}{
+		{"deselect-all+refresh-preview", false, ""},
+		{"reload(linear issue list --fzf-data --label 'a+b')+deselect-all+refresh-preview", true, ""},
+		{"deselect-all+preview(cat '/cache/command-output')", false, "/cache/command-output"},
+		{"reload(list)+deselect-all+preview(cat '/cache/command-output')", true, "/cache/command-output"},
+	}
+	for _, tt := range tests {
+		reload, preview := parseAfterActions(tt.actions)
+		if reload != tt.wantReload || preview != tt.wantPreview {
+			t.Errorf("parseAfterActions(%q) = %v, %q, want %v, %q", tt.actions, reload, preview, tt.wantReload, tt.wantPreview)
+		}
+	}
+}
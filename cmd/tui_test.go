@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUseBuiltinTUI(t *testing.T) {
+	withFzf := t.TempDir()
+	if err := os.WriteFile(filepath.Join(withFzf, "fzf"), []byte("#!/bin/sh\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	withoutFzf := t.TempDir()
+
+	tests := []struct {
+		name    string
+		backend string
+		path    string
+		want    bool
+	}{
+		{"fzf installed", "", withFzf, false},
+		{"fzf missing", "", withoutFzf, true},
+		{"builtin chosen", "builtin", withFzf, true},
+		{"fzf chosen", "fzf", withoutFzf, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(_interactiveBackendEnv, tt.backend)
+			t.Setenv("PATH", tt.path)
+			if got := useBuiltinTUI(); got != tt.want {
+				t.Errorf("useBuiltinTUI() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/spf13/cobra"
 
@@ -12,6 +13,8 @@ import (
 // newUserGetCmd creates the "user get" subcommand that displays detailed
 // information for a specific user.
 func newUserGetCmd(opts Options) *cobra.Command {
+	var of outputFlags
+
 	cmd := &cobra.Command{
 		Use:     "get <username>",
 		Aliases: []string{"show", "view"},
@@ -20,6 +23,10 @@ func newUserGetCmd(opts Options) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			username := args[0]
 
+			if err := of.validate(); err != nil {
+				return err
+			}
+
 			client, err := resolveClient(cmd, opts)
 			if err != nil {
 				return err
@@ -34,7 +41,23 @@ func newUserGetCmd(opts Options) *cobra.Command {
 				return fmt.Errorf("user %q not found", username)
 			}
 
-			out := format.FormatUserDetail(resp.Users.Nodes[0], format.ColorEnabled(cmd.OutOrStdout()))
+			user := resp.Users.Nodes[0]
+			color := format.ColorEnabled(cmd.OutOrStdout())
+			if of.template != "" {
+				return of.writeTemplate(opts.Stdout, color, user)
+			}
+			if of.jq != "" {
+				return of.writeJQ(opts.Stdout, func(w io.Writer) error {
+					out, err := format.FormatUserDetailJSON(user)
+					if err != nil {
+						return err
+					}
+					_, err = io.WriteString(w, out)
+					return err
+				})
+			}
+
+			out := format.FormatUserDetail(user, color)
 			fmt.Fprint(opts.Stdout, out)
 
 			return nil
@@ -63,5 +86,7 @@ func newUserGetCmd(opts Options) *cobra.Command {
 		},
 	}
 
+	of.register(cmd)
+
 	return cmd
 }
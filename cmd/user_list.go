@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"slices"
 	"strings"
 
@@ -21,8 +22,11 @@ func isIntegrationUser(email string) bool {
 // in the organization.
 func newUserListCmd(opts Options) *cobra.Command {
 	var (
-		limit       int
-		includeBots bool
+		limit        int
+		includeBots  bool
+		outputFormat string
+		of           outputFlags
+		wide         bool
 	)
 
 	cmd := &cobra.Command{
@@ -36,6 +40,16 @@ func newUserListCmd(opts Options) *cobra.Command {
 			if limit <= 0 {
 				return fmt.Errorf("--limit must be greater than 0, got %d", limit)
 			}
+			if err := format.ValidateOutputFormat(outputFormat); err != nil {
+				return err
+			}
+			if err := of.validate(); err != nil {
+				return err
+			}
+			outputFormat, err := of.resolveFormat(cmd, outputFormat)
+			if err != nil {
+				return err
+			}
 
 			client, err := resolveClient(cmd, opts)
 			if err != nil {
@@ -69,7 +83,22 @@ func newUserListCmd(opts Options) *cobra.Command {
 				)
 			})
 
-			out := format.FormatUserList(users, format.ColorEnabled(cmd.OutOrStdout()))
+			switch {
+			case of.template != "":
+				items := make([]any, len(users))
+				for i, u := range users {
+					items[i] = u
+				}
+				return of.writeTemplate(opts.Stdout, format.ColorEnabled(cmd.OutOrStdout()), items...)
+			case of.jq != "":
+				return of.writeJQ(opts.Stdout, func(w io.Writer) error {
+					return format.WriteRecords(w, format.UserRecords(users), "json")
+				})
+			case outputFormat != "table":
+				return format.WriteRecords(opts.Stdout, format.UserRecords(users), outputFormat)
+			}
+
+			out := format.FormatUserList(users, format.ColorEnabled(cmd.OutOrStdout()), tableWidth(opts, cmd.OutOrStdout(), wide))
 			fmt.Fprint(opts.Stdout, out)
 
 			return nil
@@ -78,6 +107,13 @@ func newUserListCmd(opts Options) *cobra.Command {
 
 	cmd.Flags().IntVarP(&limit, "limit", "n", 50, "Maximum number of users to return")
 	cmd.Flags().BoolVar(&includeBots, "include-bots", false, "Include integration/bot users")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "Output format: table, csv, tsv, json, yaml, ndjson")
+	_ = cmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return format.OutputFormats, cobra.ShellCompDirectiveNoFileComp
+	})
+	of.register(cmd)
+	cmd.Flags().BoolVarP(&wide, "wide", "w", false, "Do not truncate names to fit the terminal width")
+	_ = cmd.RegisterFlagCompletionFunc("wide", cobra.NoFileCompletions)
 
 	return cmd
 }
@@ -1,6 +1,7 @@
 package cmd_test
 
 import (
+	"io"
 	"strings"
 	"testing"
 
@@ -307,3 +308,78 @@ func TestUserList_NilUsers(t *testing.T) {
 		t.Errorf("error %q should contain 'no users data'", err.Error())
 	}
 }
+
+func TestUserList_OutputTSV(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"ListUsers": listUsersResponse,
+	})
+
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"user", "list", "-o", "tsv"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("user list -o tsv returned error: %v", err)
+	}
+
+	want := "name\tdisplay_name\temail\tadmin\tactive\n" +
+		"Jane Doe\tJane Doe\tjane@example.com\ttrue\ttrue\n" +
+		"John Smith\tJohn Smith\tjohn@example.com\tfalse\ttrue\n"
+	if got := stdout.String(); got != want {
+		t.Errorf("tsv output =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestUserList_OutputYAML(t *testing.T) {
+	t.Parallel()
+
+	server := newMockGraphQLServer(t, map[string]string{
+		"ListUsers": listUsersResponse,
+	})
+
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"user", "list", "--output", "yaml"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("user list --output yaml returned error: %v", err)
+	}
+
+	output := stdout.String()
+	if !strings.HasPrefix(output, "- name: Jane Doe\n  display_name: Jane Doe\n") {
+		t.Errorf("unexpected yaml output:\n%s", output)
+	}
+	if !strings.Contains(output, "  admin: true\n") {
+		t.Errorf("expected raw boolean admin field, got:\n%s", output)
+	}
+}
+
+func TestUserList_Wide(t *testing.T) {
+	t.Parallel()
+
+	for _, wide := range []bool{false, true} {
+		server := newMockGraphQLServer(t, map[string]string{
+			"ListUsers": listUsersResponse,
+		})
+
+		opts, stdout, _ := testOptionsWithBuffers(t, server)
+		opts.TerminalWidth = func(io.Writer) int { return 40 }
+		root := cmd.NewRootCmd(opts)
+		args := []string{"user", "list"}
+		if wide {
+			args = append(args, "--wide")
+		}
+		root.SetArgs(args)
+
+		if err := root.Execute(); err != nil {
+			t.Fatalf("user list (wide=%v) returned error: %v", wide, err)
+		}
+
+		got := stdout.String()
+		if full := strings.Contains(got, "DISPLAY NAME"); full != wide {
+			t.Errorf("wide=%v: full header shown = %v, output:\n%s", wide, full, got)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/duboisf/linear/internal/config"
+	"github.com/duboisf/linear/internal/format"
+)
+
+// viewFlag is an "issue list" flag set by a saved view.
+type viewFlag struct {
+	name  string
+	value string
+}
+
+// _viewFlagNames are the "issue list" flags a view can set, in display order.
+var _viewFlagNames = []string{"status", "label", "cycle", "user", "sort", "column", "limit"}
+
+// viewFlags returns the flags set by v, in _viewFlagNames order.
+func viewFlags(v config.ViewConfig) []viewFlag {
+	var flags []viewFlag
+	add := func(name, value string) {
+		if value != "" {
+			flags = append(flags, viewFlag{name: name, value: value})
+		}
+	}
+	add("status", v.Status)
+	add("label", v.Label)
+	add("cycle", v.Cycle)
+	add("user", v.User)
+	add("sort", v.Sort)
+	add("column", v.Columns)
+	if v.Limit != 0 {
+		add("limit", strconv.Itoa(v.Limit))
+	}
+	return flags
+}
+
+// viewCommandLine renders the flags set by v as they would be typed.
+func viewCommandLine(v config.ViewConfig) string {
+	var parts []string
+	for _, f := range viewFlags(v) {
+		parts = append(parts, "--"+f.name, shellQuoteIfNeeded(f.value))
+	}
+	return strings.Join(parts, " ")
+}
+
+// shellQuoteIfNeeded quotes s only when it contains characters the shell
+// would interpret, keeping simple values readable.
+func shellQuoteIfNeeded(s string) string {
+	if s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune(",+-_.:/@", r))
+	}) < 0 {
+		return s
+	}
+	return shellQuote(s)
+}
+
+// applyView sets the "issue list" flags of the named view on cmd. Flags
+// already given on the command line win unless replace is true, in which case
+// the view replaces every filter flag except --cycle (used when switching
+// views in interactive mode, where the cycle comes from the state file).
+func applyView(cmd *cobra.Command, cfg *config.Config, name string, replace bool) error {
+	view, ok := cfg.View(name)
+	if !ok {
+		names := cfg.ViewNames()
+		if len(names) == 0 {
+			return fmt.Errorf("unknown view %q: no views are configured", name)
+		}
+		return fmt.Errorf("unknown view %q (available: %s)", name, strings.Join(names, ", "))
+	}
+
+	set := make(map[string]bool)
+	for _, f := range viewFlags(view) {
+		set[f.name] = true
+		if replace && f.name == "cycle" || !replace && cmd.Flags().Changed(f.name) {
+			continue
+		}
+		if err := cmd.Flags().Set(f.name, f.value); err != nil {
+			return fmt.Errorf("view %q: --%s: %w", name, f.name, err)
+		}
+	}
+	if !replace {
+		return nil
+	}
+	for _, flagName := range _viewFlagNames {
+		if set[flagName] || flagName == "cycle" {
+			continue
+		}
+		if err := cmd.Flags().Set(flagName, cmd.Flags().Lookup(flagName).DefValue); err != nil {
+			return fmt.Errorf("resetting --%s: %w", flagName, err)
+		}
+	}
+	return nil
+}
+
+// completeViewNames returns the configured view names for shell completion.
+func completeViewNames(opts Options) ([]string, cobra.ShellCompDirective) {
+	return opts.Config.ViewNames(), cobra.ShellCompDirectiveNoFileComp
+}
+
+// newViewCmd creates the "view" command, which runs "issue list" with a saved
+// view. It shares every "issue list" flag, so "view triage -i" works; without
+// a name it lists the configured views.
+func newViewCmd(opts Options) *cobra.Command {
+	cmd := newIssueListCmd(opts)
+	cmd.Use = "view [name]"
+	cmd.Aliases = nil
+	cmd.Short = "Run a saved issue list view"
+	cmd.Long = `Run "issue list" with a view from the views: section of the config file.
+Without a name, list the configured views. Flags override the view's settings.`
+	cmd.Args = cobra.MaximumNArgs(1)
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return completeViewNames(opts)
+	}
+	_ = cmd.Flags().MarkHidden("view")
+
+	runList := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return listViews(cmd, opts)
+		}
+		if err := cmd.Flags().Set("view", args[0]); err != nil {
+			return err
+		}
+		return runList(cmd, args)
+	}
+	return cmd
+}
+
+// listViews prints the configured views and the flags each one sets.
+func listViews(cmd *cobra.Command, opts Options) error {
+	if opts.Config == nil || len(opts.Config.Views) == 0 {
+		fmt.Fprintln(opts.Stderr, "No views configured. Add a views: section with 'linear config edit'.")
+		return nil
+	}
+	cols := []format.TableColumn{{Header: "NAME"}, {Header: "FLAGS", Flexible: true}}
+	rows := make([][]format.TableCell, len(opts.Config.Views))
+	for i, v := range opts.Config.Views {
+		rows[i] = []format.TableCell{{Text: v.Name}, {Text: viewCommandLine(v), Color: format.Gray}}
+	}
+	color := format.ColorEnabled(cmd.OutOrStdout())
+	fmt.Fprint(opts.Stdout, format.RenderTable(cols, rows, color, true, tableWidth(opts, cmd.OutOrStdout(), false)))
+	return nil
+}
@@ -0,0 +1,234 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/cmd"
+	"github.com/duboisf/linear/internal/config"
+)
+
+func viewTestConfig() *config.Config {
+	return &config.Config{Views: []config.ViewConfig{
+		{Name: "triage", Status: "started", Label: "bug", Cycle: "all", Sort: "priority", Columns: "id,title", Limit: 5},
+		{Name: "everything", Status: "all", Cycle: "all"},
+	}}
+}
+
+// listIssuesVars decodes the variables of the single ListMyIssues request.
+func listIssuesVars(t *testing.T, rec *graphqlRecorder) map[string]any {
+	t.Helper()
+	calls := rec.calls("ListMyIssues")
+	if len(calls) != 1 {
+		t.Fatalf("expected 1 ListMyIssues call, got %d", len(calls))
+	}
+	var vars map[string]any
+	if err := json.Unmarshal(calls[0].Variables, &vars); err != nil {
+		t.Fatalf("decoding variables: %v", err)
+	}
+	return vars
+}
+
+func TestIssueList_View(t *testing.T) {
+	t.Parallel()
+
+	server, rec := newRecordingGraphQLServer(t, map[string]string{
+		"ListMyIssues": listMyIssuesResponse,
+	})
+
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	opts.Config = viewTestConfig()
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "list", "--view", "triage", "-o", "csv"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue list --view returned error: %v", err)
+	}
+
+	vars := listIssuesVars(t, rec)
+	if vars["first"] != float64(5) {
+		t.Errorf("first = %v, want 5 from the view", vars["first"])
+	}
+	filter, _ := json.Marshal(vars["filter"])
+	for _, want := range []string{`"in":["started"]`, `"bug"`} {
+		if !strings.Contains(string(filter), want) {
+			t.Errorf("filter %s should contain %s", filter, want)
+		}
+	}
+	if strings.Contains(string(filter), "cycle") {
+		t.Errorf("filter %s should not filter by cycle", filter)
+	}
+	// Sorted by priority (ENG-101 is urgent) with the view's columns.
+	want := "id,title\nENG-101,Fix login bug\nENG-102,Add dark mode\n"
+	if got := stdout.String(); got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestIssueList_View_FlagsOverride(t *testing.T) {
+	t.Parallel()
+
+	server, rec := newRecordingGraphQLServer(t, map[string]string{
+		"ListMyIssues": listMyIssuesResponse,
+	})
+
+	opts, _, _ := testOptionsWithBuffers(t, server)
+	opts.Config = viewTestConfig()
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "list", "--view", "triage", "--limit", "2", "--status", "backlog", "-o", "csv"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue list --view returned error: %v", err)
+	}
+
+	vars := listIssuesVars(t, rec)
+	if vars["first"] != float64(2) {
+		t.Errorf("first = %v, want 2 from --limit", vars["first"])
+	}
+	filter, _ := json.Marshal(vars["filter"])
+	if !strings.Contains(string(filter), `"in":["backlog"]`) || !strings.Contains(string(filter), `"bug"`) {
+		t.Errorf("filter %s should use --status and the view's label", filter)
+	}
+}
+
+func TestIssueList_View_Unknown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cfg  *config.Config
+		want string
+	}{
+		{name: "no views", cfg: nil, want: "no views are configured"},
+		{name: "lists available", cfg: viewTestConfig(), want: "available: triage, everything"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, _, _ := testOptionsWithBuffers(t, nil)
+			opts.Config = tt.cfg
+			root := cmd.NewRootCmd(opts)
+			root.SetArgs([]string{"issue", "list", "--view", "nope"})
+
+			err := root.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestIssueList_ViewFile_ReplacesFlags(t *testing.T) {
+	t.Parallel()
+
+	server, rec := newRecordingGraphQLServer(t, map[string]string{
+		"ListMyIssues": listMyIssuesResponse,
+	})
+
+	viewFile := filepath.Join(t.TempDir(), "state.view")
+	if err := os.WriteFile(viewFile, []byte("everything\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	opts, _, _ := testOptionsWithBuffers(t, server)
+	opts.Config = viewTestConfig()
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"issue", "list", "--fzf-data", "--cycle", "all", "--status", "started", "--label", "bug", "--limit", "7", "--view-file", viewFile})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("issue list --view-file returned error: %v", err)
+	}
+
+	vars := listIssuesVars(t, rec)
+	if vars["first"] != float64(50) {
+		t.Errorf("first = %v, want the default 50", vars["first"])
+	}
+	if vars["filter"] != nil {
+		t.Errorf("filter = %v, want none for the everything view", vars["filter"])
+	}
+}
+
+func TestView_RunsNamedView(t *testing.T) {
+	t.Parallel()
+
+	server, rec := newRecordingGraphQLServer(t, map[string]string{
+		"ListMyIssues": listMyIssuesResponse,
+	})
+
+	opts, stdout, _ := testOptionsWithBuffers(t, server)
+	opts.Config = viewTestConfig()
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"view", "triage", "--output", "tsv"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("view triage returned error: %v", err)
+	}
+
+	if vars := listIssuesVars(t, rec); vars["first"] != float64(5) {
+		t.Errorf("first = %v, want 5 from the view", vars["first"])
+	}
+	if got := stdout.String(); !strings.HasPrefix(got, "id\ttitle\n") {
+		t.Errorf("expected the view's columns, got %q", got)
+	}
+}
+
+func TestView_ListsViews(t *testing.T) {
+	t.Parallel()
+
+	opts, stdout, _ := testOptionsWithBuffers(t, nil)
+	opts.Config = viewTestConfig()
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"view"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("view returned error: %v", err)
+	}
+
+	want := "NAME        FLAGS\n" +
+		"triage      --status started --label bug --cycle all --sort priority --column id,title --limit 5\n" +
+		"everything  --status all --cycle all\n"
+	if got := stdout.String(); got != want {
+		t.Errorf("output =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestView_NoViews(t *testing.T) {
+	t.Parallel()
+
+	opts, stdout, stderr := testOptionsWithBuffers(t, nil)
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"view"})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("view returned error: %v", err)
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("expected no stdout, got %q", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "No views configured") {
+		t.Errorf("expected a hint on stderr, got %q", stderr.String())
+	}
+}
+
+func TestView_Completion(t *testing.T) {
+	t.Parallel()
+
+	opts, stdout, _ := testOptionsWithBuffers(t, nil)
+	opts.Config = viewTestConfig()
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"__complete", "issue", "list", "--view", ""})
+
+	if err := root.Execute(); err != nil {
+		t.Fatalf("completion returned error: %v", err)
+	}
+	for _, want := range []string{"triage", "everything"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Errorf("completions %q should contain %q", stdout.String(), want)
+		}
+	}
+}
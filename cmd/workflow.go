@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+
+	"github.com/duboisf/linear/internal/api"
+)
+
+// Workflow events, named after their workflow config keys.
+const (
+	workflowEventWorktree = "worktree"
+	workflowEventCheckout = "checkout"
+	workflowEventPRCreate = "pr_create"
+)
+
+// _stateTypeRank orders workflow state types from earliest to latest. States
+// of the same type are ordered by their position in the team's workflow.
+var _stateTypeRank = map[string]int{
+	"triage":    0,
+	"backlog":   1,
+	"unstarted": 2,
+	"started":   3,
+	"completed": 4,
+	"canceled":  4,
+}
+
+// transitionIssue moves issue to the workflow state named target, writing an
+// audit line such as "ENG-42: Todo → In Progress (worktree)" to w. event names
+// the trigger for the audit line. Nothing happens when target is empty, the
+// issue is already in that state, or the move would go backwards.
+func transitionIssue(ctx context.Context, client graphql.Client, issue *api.GetIssueIssue, target, event string, w io.Writer) error {
+	if target == "" {
+		return nil
+	}
+	if issue.Team == nil {
+		return fmt.Errorf("issue %s has no team", issue.Identifier)
+	}
+
+	resp, err := api.ListWorkflowStates(ctx, client, 100, issue.Team.Id)
+	if err != nil {
+		return fmt.Errorf("listing workflow states: %w", err)
+	}
+	if resp.WorkflowStates == nil {
+		return fmt.Errorf("no workflow states found for team %s", issue.Team.Key)
+	}
+
+	var to, from *api.ListWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState
+	var names []string
+	for _, s := range resp.WorkflowStates.Nodes {
+		names = append(names, s.Name)
+		if strings.EqualFold(s.Name, target) {
+			to = s
+		}
+		if issue.State != nil && s.Id == issue.State.Id {
+			from = s
+		}
+	}
+	if to == nil {
+		return fmt.Errorf("workflow.%s: team %s has no state %q (available: %s)", event, issue.Team.Key, target, strings.Join(names, ", "))
+	}
+	if from != nil && !isLaterState(to, from) {
+		return nil
+	}
+
+	input := &api.IssueUpdateInput{StateId: &to.Id}
+	updateResp, err := api.UpdateIssue(ctx, client, issue.Id, input)
+	if err != nil {
+		return fmt.Errorf("updating status: %w", err)
+	}
+	if updateResp.IssueUpdate == nil || !updateResp.IssueUpdate.Success {
+		return fmt.Errorf("status update was not successful")
+	}
+
+	fromName := "(none)"
+	if issue.State != nil {
+		fromName = issue.State.Name
+	}
+	fmt.Fprintf(w, "%s: %s → %s (%s)\n", issue.Identifier, fromName, to.Name, event)
+	return nil
+}
+
+// isLaterState reports whether state a comes after state b in the workflow.
+func isLaterState(a, b *api.ListWorkflowStatesWorkflowStatesWorkflowStateConnectionNodesWorkflowState) bool {
+	ra, rb := _stateTypeRank[a.Type], _stateTypeRank[b.Type]
+	if ra != rb {
+		return ra > rb
+	}
+	return a.Position > b.Position
+}
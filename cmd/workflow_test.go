@@ -0,0 +1,161 @@
+package cmd_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/cmd"
+	"github.com/duboisf/linear/internal/config"
+)
+
+func TestIssueWorktree_WorkflowTransition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		target     string
+		args       []string
+		wantUpdate bool
+		wantAudit  string
+		wantWarn   string
+	}{
+		{
+			name:       "moves forward",
+			target:     "In Review",
+			wantUpdate: true,
+			wantAudit:  "ENG-42: In Progress → In Review (worktree)",
+		},
+		{name: "never moves backwards", target: "Todo"},
+		{name: "already in state", target: "in progress"},
+		{name: "no-transition flag", target: "In Review", args: []string{"--no-transition"}},
+		{name: "unknown state warns", target: "Shipping", wantWarn: `team ENG has no state "Shipping"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, rec := newRecordingGraphQLServer(t, map[string]string{
+				"ListTeams":          listTeamsResponse,
+				"GetIssue":           getIssueWithIDsResponse,
+				"ListWorkflowStates": listWorkflowStatesResponse,
+				"UpdateIssue":        updateIssueResponse,
+			})
+			mock := &mockGitWorktreeCreator{repoRoot: "/tmp/test-repo"}
+			opts, stdout, stderr := testOptionsWithBuffers(t, server)
+			opts.GitWorktreeCreator = mock
+			opts.Config = &config.Config{
+				Worktree: config.WorktreeConfig{PostCreate: []config.Hook{}},
+				Workflow: config.WorkflowConfig{Worktree: tt.target},
+			}
+
+			root := cmd.NewRootCmd(opts)
+			root.SetArgs(append([]string{"issue", "worktree", "ENG-42"}, tt.args...))
+			if err := root.Execute(); err != nil {
+				t.Fatalf("issue worktree returned error: %v", err)
+			}
+
+			updates := rec.calls("UpdateIssue")
+			if tt.wantUpdate {
+				if len(updates) != 1 || !strings.Contains(string(updates[0].Variables), `"stateId":"state-review"`) {
+					t.Fatalf("UpdateIssue calls = %+v, want one with stateId state-review", updates)
+				}
+			} else if len(updates) != 0 {
+				t.Fatalf("UpdateIssue should not be called, got %+v", updates)
+			}
+			if tt.wantAudit != "" && !strings.Contains(stderr.String(), tt.wantAudit) {
+				t.Errorf("stderr = %q, want audit line %q", stderr.String(), tt.wantAudit)
+			}
+			if tt.wantWarn != "" && !strings.Contains(stderr.String(), tt.wantWarn) {
+				t.Errorf("stderr = %q, want warning %q", stderr.String(), tt.wantWarn)
+			}
+			if !strings.HasSuffix(stdout.String(), "/tmp/eng-42/test-repo\n") {
+				t.Errorf("stdout = %q, want it to end with the worktree path", stdout.String())
+			}
+		})
+	}
+}
+
+func TestGitPostCheckout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		branch     string
+		flag       string
+		target     string
+		wantUpdate bool
+	}{
+		{name: "branch checkout", branch: "fred/eng-42-x", flag: "1", target: "In Review", wantUpdate: true},
+		{name: "file checkout", branch: "fred/eng-42-x", flag: "0", target: "In Review"},
+		{name: "not configured", branch: "fred/eng-42-x", flag: "1"},
+		{name: "no issue branch", branch: "main", flag: "1", target: "In Review"},
+		{name: "unknown team key", branch: "fred/utf-8-fix", flag: "1", target: "In Review"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, rec := newRecordingGraphQLServer(t, map[string]string{
+				"ListTeams":          listTeamsResponse,
+				"GetIssue":           getIssueWithIDsResponse,
+				"ListWorkflowStates": listWorkflowStatesResponse,
+				"UpdateIssue":        updateIssueResponse,
+			})
+			opts, _, stderr := testOptionsWithBuffers(t, server)
+			opts.GitRunner = &mockGitRunner{currentBranch: tt.branch}
+			opts.Config = &config.Config{Workflow: config.WorkflowConfig{Checkout: tt.target}}
+
+			root := cmd.NewRootCmd(opts)
+			root.SetArgs([]string{"git", "post-checkout", "abc", "def", tt.flag})
+			if err := root.Execute(); err != nil {
+				t.Fatalf("git post-checkout returned error: %v", err)
+			}
+
+			updates := rec.calls("UpdateIssue")
+			if tt.wantUpdate != (len(updates) == 1) {
+				t.Fatalf("UpdateIssue calls = %d, want update=%v", len(updates), tt.wantUpdate)
+			}
+			if tt.wantUpdate && !strings.Contains(stderr.String(), "(checkout)") {
+				t.Errorf("stderr = %q, want audit line", stderr.String())
+			}
+		})
+	}
+}
+
+func TestGitPostCheckout_MissingIssueIsSilent(t *testing.T) {
+	t.Parallel()
+
+	server := newIssueLookupServer(t, "ENG-42")
+	opts, _, stderr := testOptionsWithBuffers(t, server)
+	opts.GitRunner = &mockGitRunner{currentBranch: "eng-999-stale"}
+	opts.Config = &config.Config{Workflow: config.WorkflowConfig{Checkout: "In Progress"}}
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"git", "post-checkout", "abc", "def", "1"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("git post-checkout must not fail: %v", err)
+	}
+	if stderr.Len() != 0 {
+		t.Errorf("stderr = %q, want no output", stderr.String())
+	}
+}
+
+func TestGitPostCheckout_NeverFails(t *testing.T) {
+	t.Parallel()
+
+	server := newErrorGraphQLServer(t)
+	opts, _, stderr := testOptionsWithBuffers(t, server)
+	opts.GitRunner = &mockGitRunner{currentBranch: "eng-42"}
+	opts.Config = &config.Config{Workflow: config.WorkflowConfig{Checkout: "In Progress"}}
+
+	root := cmd.NewRootCmd(opts)
+	root.SetArgs([]string{"git", "post-checkout", "abc", "def", "1"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("git post-checkout must not fail: %v", err)
+	}
+	if !strings.Contains(stderr.String(), "test error") {
+		t.Errorf("stderr = %q, want the API error as a warning", stderr.String())
+	}
+}
@@ -259,6 +259,50 @@ func (v *AllActiveIssuesForCompletionResponse) GetIssues() *AllActiveIssuesForCo
 	return v.Issues
 }
 
+// AttachIssueURLAttachmentLinkURLAttachmentPayload includes the requested fields of the GraphQL type AttachmentPayload.
+type AttachIssueURLAttachmentLinkURLAttachmentPayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+	// The issue attachment that was created.
+	Attachment *AttachIssueURLAttachmentLinkURLAttachmentPayloadAttachment `json:"attachment"`
+}
+
+// GetSuccess returns AttachIssueURLAttachmentLinkURLAttachmentPayload.Success, and is useful for accessing the field via an interface.
+func (v *AttachIssueURLAttachmentLinkURLAttachmentPayload) GetSuccess() bool { return v.Success }
+
+// GetAttachment returns AttachIssueURLAttachmentLinkURLAttachmentPayload.Attachment, and is useful for accessing the field via an interface.
+func (v *AttachIssueURLAttachmentLinkURLAttachmentPayload) GetAttachment() *AttachIssueURLAttachmentLinkURLAttachmentPayloadAttachment {
+	return v.Attachment
+}
+
+// AttachIssueURLAttachmentLinkURLAttachmentPayloadAttachment includes the requested fields of the GraphQL type Attachment.
+// The GraphQL type's documentation follows.
+//
+// Issue attachment (e.g. support ticket, pull request).
+type AttachIssueURLAttachmentLinkURLAttachmentPayloadAttachment struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// Location of the attachment which is also used as an identifier.
+	Url string `json:"url"`
+}
+
+// GetId returns AttachIssueURLAttachmentLinkURLAttachmentPayloadAttachment.Id, and is useful for accessing the field via an interface.
+func (v *AttachIssueURLAttachmentLinkURLAttachmentPayloadAttachment) GetId() string { return v.Id }
+
+// GetUrl returns AttachIssueURLAttachmentLinkURLAttachmentPayloadAttachment.Url, and is useful for accessing the field via an interface.
+func (v *AttachIssueURLAttachmentLinkURLAttachmentPayloadAttachment) GetUrl() string { return v.Url }
+
+// AttachIssueURLResponse is returned by AttachIssueURL on success.
+type AttachIssueURLResponse struct {
+	// Link any url to an issue.
+	AttachmentLinkURL *AttachIssueURLAttachmentLinkURLAttachmentPayload `json:"attachmentLinkURL"`
+}
+
+// GetAttachmentLinkURL returns AttachIssueURLResponse.AttachmentLinkURL, and is useful for accessing the field via an interface.
+func (v *AttachIssueURLResponse) GetAttachmentLinkURL() *AttachIssueURLAttachmentLinkURLAttachmentPayload {
+	return v.AttachmentLinkURL
+}
+
 // Attachment collection filtering options.
 type AttachmentCollectionFilter struct {
 	// Compound filters, all of which need to be matched by the attachment.
@@ -567,7 +611,63 @@ func (v *ContentComparator) GetContains() *string { return v.Contains }
 // GetNotContains returns ContentComparator.NotContains, and is useful for accessing the field via an interface.
 func (v *ContentComparator) GetNotContains() *string { return v.NotContains }
 
+// CreateCommentCommentCreateCommentPayload includes the requested fields of the GraphQL type CommentPayload.
+type CreateCommentCommentCreateCommentPayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns CreateCommentCommentCreateCommentPayload.Success, and is useful for accessing the field via an interface.
+func (v *CreateCommentCommentCreateCommentPayload) GetSuccess() bool { return v.Success }
+
+// CreateCommentResponse is returned by CreateComment on success.
+type CreateCommentResponse struct {
+	// Creates a new comment.
+	CommentCreate *CreateCommentCommentCreateCommentPayload `json:"commentCreate"`
+}
+
+// GetCommentCreate returns CreateCommentResponse.CommentCreate, and is useful for accessing the field via an interface.
+func (v *CreateCommentResponse) GetCommentCreate() *CreateCommentCommentCreateCommentPayload {
+	return v.CommentCreate
+}
+
+// CreateDuplicateRelationIssueRelationCreateIssueRelationPayload includes the requested fields of the GraphQL type IssueRelationPayload.
+type CreateDuplicateRelationIssueRelationCreateIssueRelationPayload struct {
+	// Whether the operation was successful.
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns CreateDuplicateRelationIssueRelationCreateIssueRelationPayload.Success, and is useful for accessing the field via an interface.
+func (v *CreateDuplicateRelationIssueRelationCreateIssueRelationPayload) GetSuccess() bool {
+	return v.Success
+}
+
+// CreateDuplicateRelationResponse is returned by CreateDuplicateRelation on success.
+type CreateDuplicateRelationResponse struct {
+	// Creates a new issue relation.
+	IssueRelationCreate *CreateDuplicateRelationIssueRelationCreateIssueRelationPayload `json:"issueRelationCreate"`
+}
+
+// GetIssueRelationCreate returns CreateDuplicateRelationResponse.IssueRelationCreate, and is useful for accessing the field via an interface.
+func (v *CreateDuplicateRelationResponse) GetIssueRelationCreate() *CreateDuplicateRelationIssueRelationCreateIssueRelationPayload {
+	return v.IssueRelationCreate
+}
+
 // Customer needs filtering options.
+// Issue creation date sorting options.
+type CreatedAtSort struct {
+	// Whether nulls should be sorted first or last
+	Nulls *PaginationNulls `json:"nulls,omitempty"`
+	// The order for the individual sort
+	Order *PaginationSortOrder `json:"order,omitempty"`
+}
+
+// GetNulls returns CreatedAtSort.Nulls, and is useful for accessing the field via an interface.
+func (v *CreatedAtSort) GetNulls() *PaginationNulls { return v.Nulls }
+
+// GetOrder returns CreatedAtSort.Order, and is useful for accessing the field via an interface.
+func (v *CreatedAtSort) GetOrder() *PaginationSortOrder { return v.Order }
+
 type CustomerNeedCollectionFilter struct {
 	// Compound filters, all of which need to be matched by the customer needs.
 	And []*CustomerNeedCollectionFilter `json:"and,omitempty"`
@@ -1918,6 +2018,316 @@ func (v *IssueCollectionFilter) GetTriagedAt() *NullableDateComparator { return
 // GetUpdatedAt returns IssueCollectionFilter.UpdatedAt, and is useful for accessing the field via an interface.
 func (v *IssueCollectionFilter) GetUpdatedAt() *DateComparator { return v.UpdatedAt }
 
+// IssueChildrenAndCommentsIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type IssueChildrenAndCommentsIssue struct {
+	// Children of the issue.
+	Children *IssueChildrenAndCommentsIssueChildrenIssueConnection `json:"children"`
+	// Comments associated with the issue.
+	Comments *IssueChildrenAndCommentsIssueCommentsCommentConnection `json:"comments"`
+}
+
+// GetChildren returns IssueChildrenAndCommentsIssue.Children, and is useful for accessing the field via an interface.
+func (v *IssueChildrenAndCommentsIssue) GetChildren() *IssueChildrenAndCommentsIssueChildrenIssueConnection {
+	return v.Children
+}
+
+// GetComments returns IssueChildrenAndCommentsIssue.Comments, and is useful for accessing the field via an interface.
+func (v *IssueChildrenAndCommentsIssue) GetComments() *IssueChildrenAndCommentsIssueCommentsCommentConnection {
+	return v.Comments
+}
+
+// IssueChildrenAndCommentsIssueChildrenIssueConnection includes the requested fields of the GraphQL type IssueConnection.
+type IssueChildrenAndCommentsIssueChildrenIssueConnection struct {
+	Nodes []*IssueChildrenAndCommentsIssueChildrenIssueConnectionNodesIssue `json:"nodes"`
+}
+
+// GetNodes returns IssueChildrenAndCommentsIssueChildrenIssueConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *IssueChildrenAndCommentsIssueChildrenIssueConnection) GetNodes() []*IssueChildrenAndCommentsIssueChildrenIssueConnectionNodesIssue {
+	return v.Nodes
+}
+
+// IssueChildrenAndCommentsIssueChildrenIssueConnectionNodesIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type IssueChildrenAndCommentsIssueChildrenIssueConnectionNodesIssue struct {
+	// Issue's human readable identifier (e.g. ENG-123).
+	Identifier string `json:"identifier"`
+}
+
+// GetIdentifier returns IssueChildrenAndCommentsIssueChildrenIssueConnectionNodesIssue.Identifier, and is useful for accessing the field via an interface.
+func (v *IssueChildrenAndCommentsIssueChildrenIssueConnectionNodesIssue) GetIdentifier() string {
+	return v.Identifier
+}
+
+// IssueChildrenAndCommentsIssueCommentsCommentConnection includes the requested fields of the GraphQL type CommentConnection.
+type IssueChildrenAndCommentsIssueCommentsCommentConnection struct {
+	Nodes []*IssueChildrenAndCommentsIssueCommentsCommentConnectionNodesComment `json:"nodes"`
+}
+
+// GetNodes returns IssueChildrenAndCommentsIssueCommentsCommentConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *IssueChildrenAndCommentsIssueCommentsCommentConnection) GetNodes() []*IssueChildrenAndCommentsIssueCommentsCommentConnectionNodesComment {
+	return v.Nodes
+}
+
+// IssueChildrenAndCommentsIssueCommentsCommentConnectionNodesComment includes the requested fields of the GraphQL type Comment.
+// The GraphQL type's documentation follows.
+//
+// A comment associated with an issue.
+type IssueChildrenAndCommentsIssueCommentsCommentConnectionNodesComment struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns IssueChildrenAndCommentsIssueCommentsCommentConnectionNodesComment.Id, and is useful for accessing the field via an interface.
+func (v *IssueChildrenAndCommentsIssueCommentsCommentConnectionNodesComment) GetId() string {
+	return v.Id
+}
+
+// IssueChildrenAndCommentsResponse is returned by IssueChildrenAndComments on success.
+type IssueChildrenAndCommentsResponse struct {
+	// One specific issue.
+	Issue *IssueChildrenAndCommentsIssue `json:"issue"`
+}
+
+// GetIssue returns IssueChildrenAndCommentsResponse.Issue, and is useful for accessing the field via an interface.
+func (v *IssueChildrenAndCommentsResponse) GetIssue() *IssueChildrenAndCommentsIssue { return v.Issue }
+
+// IssueCommentsIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type IssueCommentsIssue struct {
+	// Comments associated with the issue.
+	Comments *IssueCommentsIssueCommentsCommentConnection `json:"comments"`
+}
+
+// GetComments returns IssueCommentsIssue.Comments, and is useful for accessing the field via an interface.
+func (v *IssueCommentsIssue) GetComments() *IssueCommentsIssueCommentsCommentConnection {
+	return v.Comments
+}
+
+// IssueCommentsIssueCommentsCommentConnection includes the requested fields of the GraphQL type CommentConnection.
+type IssueCommentsIssueCommentsCommentConnection struct {
+	Nodes []*IssueCommentsIssueCommentsCommentConnectionNodesComment `json:"nodes"`
+}
+
+// GetNodes returns IssueCommentsIssueCommentsCommentConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *IssueCommentsIssueCommentsCommentConnection) GetNodes() []*IssueCommentsIssueCommentsCommentConnectionNodesComment {
+	return v.Nodes
+}
+
+// IssueCommentsIssueCommentsCommentConnectionNodesComment includes the requested fields of the GraphQL type Comment.
+// The GraphQL type's documentation follows.
+//
+// A comment associated with an issue.
+type IssueCommentsIssueCommentsCommentConnectionNodesComment struct {
+	// The comment content in markdown format.
+	Body string `json:"body"`
+	// The time at which the entity was created.
+	CreatedAt string `json:"createdAt"`
+	// The user who wrote the comment.
+	User *IssueCommentsIssueCommentsCommentConnectionNodesCommentUser `json:"user"`
+}
+
+// GetBody returns IssueCommentsIssueCommentsCommentConnectionNodesComment.Body, and is useful for accessing the field via an interface.
+func (v *IssueCommentsIssueCommentsCommentConnectionNodesComment) GetBody() string { return v.Body }
+
+// GetCreatedAt returns IssueCommentsIssueCommentsCommentConnectionNodesComment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *IssueCommentsIssueCommentsCommentConnectionNodesComment) GetCreatedAt() string {
+	return v.CreatedAt
+}
+
+// GetUser returns IssueCommentsIssueCommentsCommentConnectionNodesComment.User, and is useful for accessing the field via an interface.
+func (v *IssueCommentsIssueCommentsCommentConnectionNodesComment) GetUser() *IssueCommentsIssueCommentsCommentConnectionNodesCommentUser {
+	return v.User
+}
+
+// IssueCommentsIssueCommentsCommentConnectionNodesCommentUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type IssueCommentsIssueCommentsCommentConnectionNodesCommentUser struct {
+	// The user's full name.
+	Name string `json:"name"`
+}
+
+// GetName returns IssueCommentsIssueCommentsCommentConnectionNodesCommentUser.Name, and is useful for accessing the field via an interface.
+func (v *IssueCommentsIssueCommentsCommentConnectionNodesCommentUser) GetName() string { return v.Name }
+
+// IssueCommentsResponse is returned by IssueComments on success.
+type IssueCommentsResponse struct {
+	// One specific issue.
+	Issue *IssueCommentsIssue `json:"issue"`
+}
+
+// GetIssue returns IssueCommentsResponse.Issue, and is useful for accessing the field via an interface.
+func (v *IssueCommentsResponse) GetIssue() *IssueCommentsIssue { return v.Issue }
+
+// IssueContextRelatedIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type IssueContextRelatedIssue struct {
+	// Children of the issue.
+	Children *IssueContextRelatedIssueChildrenIssueConnection `json:"children"`
+	// Comments associated with the issue.
+	Comments *IssueContextRelatedIssueCommentsCommentConnection `json:"comments"`
+}
+
+// GetChildren returns IssueContextRelatedIssue.Children, and is useful for accessing the field via an interface.
+func (v *IssueContextRelatedIssue) GetChildren() *IssueContextRelatedIssueChildrenIssueConnection {
+	return v.Children
+}
+
+// GetComments returns IssueContextRelatedIssue.Comments, and is useful for accessing the field via an interface.
+func (v *IssueContextRelatedIssue) GetComments() *IssueContextRelatedIssueCommentsCommentConnection {
+	return v.Comments
+}
+
+// IssueContextRelatedIssueChildrenIssueConnection includes the requested fields of the GraphQL type IssueConnection.
+type IssueContextRelatedIssueChildrenIssueConnection struct {
+	Nodes []*IssueContextRelatedIssueChildrenIssueConnectionNodesIssue `json:"nodes"`
+}
+
+// GetNodes returns IssueContextRelatedIssueChildrenIssueConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *IssueContextRelatedIssueChildrenIssueConnection) GetNodes() []*IssueContextRelatedIssueChildrenIssueConnectionNodesIssue {
+	return v.Nodes
+}
+
+// IssueContextRelatedIssueChildrenIssueConnectionNodesIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type IssueContextRelatedIssueChildrenIssueConnectionNodesIssue struct {
+	// Issue's human readable identifier (e.g. ENG-123).
+	Identifier string `json:"identifier"`
+	// The issue's title.
+	Title string `json:"title"`
+	// The workflow state that the issue is associated with.
+	State *IssueContextRelatedIssueChildrenIssueConnectionNodesIssueStateWorkflowState `json:"state"`
+}
+
+// GetIdentifier returns IssueContextRelatedIssueChildrenIssueConnectionNodesIssue.Identifier, and is useful for accessing the field via an interface.
+func (v *IssueContextRelatedIssueChildrenIssueConnectionNodesIssue) GetIdentifier() string {
+	return v.Identifier
+}
+
+// GetTitle returns IssueContextRelatedIssueChildrenIssueConnectionNodesIssue.Title, and is useful for accessing the field via an interface.
+func (v *IssueContextRelatedIssueChildrenIssueConnectionNodesIssue) GetTitle() string { return v.Title }
+
+// GetState returns IssueContextRelatedIssueChildrenIssueConnectionNodesIssue.State, and is useful for accessing the field via an interface.
+func (v *IssueContextRelatedIssueChildrenIssueConnectionNodesIssue) GetState() *IssueContextRelatedIssueChildrenIssueConnectionNodesIssueStateWorkflowState {
+	return v.State
+}
+
+// IssueContextRelatedIssueChildrenIssueConnectionNodesIssueStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type IssueContextRelatedIssueChildrenIssueConnectionNodesIssueStateWorkflowState struct {
+	// The state's name.
+	Name string `json:"name"`
+	// The type of the state. One of "triage", "backlog", "unstarted", "started", "completed", "canceled".
+	Type string `json:"type"`
+}
+
+// GetName returns IssueContextRelatedIssueChildrenIssueConnectionNodesIssueStateWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *IssueContextRelatedIssueChildrenIssueConnectionNodesIssueStateWorkflowState) GetName() string {
+	return v.Name
+}
+
+// GetType returns IssueContextRelatedIssueChildrenIssueConnectionNodesIssueStateWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *IssueContextRelatedIssueChildrenIssueConnectionNodesIssueStateWorkflowState) GetType() string {
+	return v.Type
+}
+
+// IssueContextRelatedIssueCommentsCommentConnection includes the requested fields of the GraphQL type CommentConnection.
+type IssueContextRelatedIssueCommentsCommentConnection struct {
+	Nodes    []*IssueContextRelatedIssueCommentsCommentConnectionNodesComment `json:"nodes"`
+	PageInfo *IssueContextRelatedIssueCommentsCommentConnectionPageInfo       `json:"pageInfo"`
+}
+
+// GetNodes returns IssueContextRelatedIssueCommentsCommentConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *IssueContextRelatedIssueCommentsCommentConnection) GetNodes() []*IssueContextRelatedIssueCommentsCommentConnectionNodesComment {
+	return v.Nodes
+}
+
+// GetPageInfo returns IssueContextRelatedIssueCommentsCommentConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *IssueContextRelatedIssueCommentsCommentConnection) GetPageInfo() *IssueContextRelatedIssueCommentsCommentConnectionPageInfo {
+	return v.PageInfo
+}
+
+// IssueContextRelatedIssueCommentsCommentConnectionNodesComment includes the requested fields of the GraphQL type Comment.
+// The GraphQL type's documentation follows.
+//
+// A comment associated with an issue.
+type IssueContextRelatedIssueCommentsCommentConnectionNodesComment struct {
+	// The comment content in markdown format.
+	Body string `json:"body"`
+	// The time at which the entity was created.
+	CreatedAt string `json:"createdAt"`
+	// The user who wrote the comment.
+	User *IssueContextRelatedIssueCommentsCommentConnectionNodesCommentUser `json:"user"`
+}
+
+// GetBody returns IssueContextRelatedIssueCommentsCommentConnectionNodesComment.Body, and is useful for accessing the field via an interface.
+func (v *IssueContextRelatedIssueCommentsCommentConnectionNodesComment) GetBody() string {
+	return v.Body
+}
+
+// GetCreatedAt returns IssueContextRelatedIssueCommentsCommentConnectionNodesComment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *IssueContextRelatedIssueCommentsCommentConnectionNodesComment) GetCreatedAt() string {
+	return v.CreatedAt
+}
+
+// GetUser returns IssueContextRelatedIssueCommentsCommentConnectionNodesComment.User, and is useful for accessing the field via an interface.
+func (v *IssueContextRelatedIssueCommentsCommentConnectionNodesComment) GetUser() *IssueContextRelatedIssueCommentsCommentConnectionNodesCommentUser {
+	return v.User
+}
+
+// IssueContextRelatedIssueCommentsCommentConnectionNodesCommentUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type IssueContextRelatedIssueCommentsCommentConnectionNodesCommentUser struct {
+	// The user's full name.
+	Name string `json:"name"`
+}
+
+// GetName returns IssueContextRelatedIssueCommentsCommentConnectionNodesCommentUser.Name, and is useful for accessing the field via an interface.
+func (v *IssueContextRelatedIssueCommentsCommentConnectionNodesCommentUser) GetName() string {
+	return v.Name
+}
+
+// IssueContextRelatedIssueCommentsCommentConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type IssueContextRelatedIssueCommentsCommentConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+// GetHasNextPage returns IssueContextRelatedIssueCommentsCommentConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *IssueContextRelatedIssueCommentsCommentConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// IssueContextResponse is returned by IssueContext on success.
+type IssueContextResponse struct {
+	// One specific issue.
+	Issue *GetIssueIssue `json:"issue"`
+	// One specific issue.
+	Related *IssueContextRelatedIssue `json:"related"`
+}
+
+// GetIssue returns IssueContextResponse.Issue, and is useful for accessing the field via an interface.
+func (v *IssueContextResponse) GetIssue() *GetIssueIssue { return v.Issue }
+
+// GetRelated returns IssueContextResponse.Related, and is useful for accessing the field via an interface.
+func (v *IssueContextResponse) GetRelated() *IssueContextRelatedIssue { return v.Related }
+
 // Issue filtering options.
 type IssueFilter struct {
 	// [Internal] Comparator for the issue's accumulatedStateUpdatedAt date.
@@ -2269,43 +2679,272 @@ func (v *IssueFilter) GetTriagedAt() *NullableDateComparator { return v.TriagedA
 // GetUpdatedAt returns IssueFilter.UpdatedAt, and is useful for accessing the field via an interface.
 func (v *IssueFilter) GetUpdatedAt() *DateComparator { return v.UpdatedAt }
 
-// Comparator for issue identifiers.
-type IssueIDComparator struct {
-	// Equals constraint.
-	Eq *string `json:"eq"`
-	// In-array constraint.
-	In []string `json:"in"`
-	// Not-equals constraint.
-	Neq *string `json:"neq"`
-	// Not-in-array constraint.
-	Nin []string `json:"nin"`
+// IssueHistoryIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type IssueHistoryIssue struct {
+	// History entries associated with the issue.
+	History *IssueHistoryIssueHistoryIssueHistoryConnection `json:"history"`
 }
 
-// GetEq returns IssueIDComparator.Eq, and is useful for accessing the field via an interface.
-func (v *IssueIDComparator) GetEq() *string { return v.Eq }
+// GetHistory returns IssueHistoryIssue.History, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssue) GetHistory() *IssueHistoryIssueHistoryIssueHistoryConnection {
+	return v.History
+}
 
-// GetIn returns IssueIDComparator.In, and is useful for accessing the field via an interface.
-func (v *IssueIDComparator) GetIn() []string { return v.In }
+// IssueHistoryIssueHistoryIssueHistoryConnection includes the requested fields of the GraphQL type IssueHistoryConnection.
+type IssueHistoryIssueHistoryIssueHistoryConnection struct {
+	Nodes []*IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory `json:"nodes"`
+}
 
-// GetNeq returns IssueIDComparator.Neq, and is useful for accessing the field via an interface.
-func (v *IssueIDComparator) GetNeq() *string { return v.Neq }
+// GetNodes returns IssueHistoryIssueHistoryIssueHistoryConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnection) GetNodes() []*IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory {
+	return v.Nodes
+}
 
-// GetNin returns IssueIDComparator.Nin, and is useful for accessing the field via an interface.
-func (v *IssueIDComparator) GetNin() []string { return v.Nin }
+// IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory includes the requested fields of the GraphQL type IssueHistory.
+// The GraphQL type's documentation follows.
+//
+// A record of changes to an issue.
+type IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory struct {
+	// The time at which the entity was created.
+	CreatedAt string `json:"createdAt"`
+	// The user who made these changes. If null, possibly means that the change made by an integration.
+	Actor *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryActorUser `json:"actor"`
+	// The previous workflow state of the issue.
+	FromState *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryFromStateWorkflowState `json:"fromState"`
+	// The new workflow state of the issue.
+	ToState *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryToStateWorkflowState `json:"toState"`
+	// The user from whom the issue was re-assigned from.
+	FromAssignee *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryFromAssigneeUser `json:"fromAssignee"`
+	// The user to whom the issue was assigned to.
+	ToAssignee *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryToAssigneeUser `json:"toAssignee"`
+	// What the priority was changed from.
+	FromPriority *float64 `json:"fromPriority"`
+	// What the priority was changed to.
+	ToPriority *float64 `json:"toPriority"`
+	// What the title was changed from.
+	FromTitle *string `json:"fromTitle"`
+	// What the title was changed to.
+	ToTitle *string `json:"toTitle"`
+	// The labels that were added to the issue.
+	AddedLabels []*IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryAddedLabelsIssueLabel `json:"addedLabels"`
+	// The labels that were removed from the issue.
+	RemovedLabels []*IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryRemovedLabelsIssueLabel `json:"removedLabels"`
+	// Whether the issue's description was updated.
+	UpdatedDescription *bool `json:"updatedDescription"`
+}
+
+// GetCreatedAt returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory.CreatedAt, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory) GetCreatedAt() string {
+	return v.CreatedAt
+}
 
-// Issue label filtering options.
-type IssueLabelCollectionFilter struct {
-	// Compound filters, all of which need to be matched by the label.
-	And []*IssueLabelCollectionFilter `json:"and,omitempty"`
-	// Comparator for the created at date.
-	CreatedAt *DateComparator `json:"createdAt,omitempty"`
-	// Filters that the issue labels creator must satisfy.
-	Creator *NullableUserFilter `json:"creator,omitempty"`
-	// Filters that needs to be matched by all issue labels.
-	Every *IssueLabelFilter `json:"every,omitempty"`
-	// Comparator for the identifier.
-	Id *IDComparator `json:"id,omitempty"`
-	// Comparator for whether the label is a group label.
+// GetActor returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory.Actor, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory) GetActor() *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryActorUser {
+	return v.Actor
+}
+
+// GetFromState returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory.FromState, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory) GetFromState() *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryFromStateWorkflowState {
+	return v.FromState
+}
+
+// GetToState returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory.ToState, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory) GetToState() *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryToStateWorkflowState {
+	return v.ToState
+}
+
+// GetFromAssignee returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory.FromAssignee, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory) GetFromAssignee() *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryFromAssigneeUser {
+	return v.FromAssignee
+}
+
+// GetToAssignee returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory.ToAssignee, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory) GetToAssignee() *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryToAssigneeUser {
+	return v.ToAssignee
+}
+
+// GetFromPriority returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory.FromPriority, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory) GetFromPriority() *float64 {
+	return v.FromPriority
+}
+
+// GetToPriority returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory.ToPriority, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory) GetToPriority() *float64 {
+	return v.ToPriority
+}
+
+// GetFromTitle returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory.FromTitle, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory) GetFromTitle() *string {
+	return v.FromTitle
+}
+
+// GetToTitle returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory.ToTitle, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory) GetToTitle() *string {
+	return v.ToTitle
+}
+
+// GetAddedLabels returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory.AddedLabels, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory) GetAddedLabels() []*IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryAddedLabelsIssueLabel {
+	return v.AddedLabels
+}
+
+// GetRemovedLabels returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory.RemovedLabels, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory) GetRemovedLabels() []*IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryRemovedLabelsIssueLabel {
+	return v.RemovedLabels
+}
+
+// GetUpdatedDescription returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory.UpdatedDescription, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory) GetUpdatedDescription() *bool {
+	return v.UpdatedDescription
+}
+
+// IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryActorUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryActorUser struct {
+	// The user's full name.
+	Name string `json:"name"`
+}
+
+// GetName returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryActorUser.Name, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryActorUser) GetName() string {
+	return v.Name
+}
+
+// IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryAddedLabelsIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryAddedLabelsIssueLabel struct {
+	// The label's name.
+	Name string `json:"name"`
+}
+
+// GetName returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryAddedLabelsIssueLabel.Name, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryAddedLabelsIssueLabel) GetName() string {
+	return v.Name
+}
+
+// IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryFromAssigneeUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryFromAssigneeUser struct {
+	// The user's full name.
+	Name string `json:"name"`
+}
+
+// GetName returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryFromAssigneeUser.Name, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryFromAssigneeUser) GetName() string {
+	return v.Name
+}
+
+// IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryFromStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryFromStateWorkflowState struct {
+	// The state's name.
+	Name string `json:"name"`
+}
+
+// GetName returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryFromStateWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryFromStateWorkflowState) GetName() string {
+	return v.Name
+}
+
+// IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryRemovedLabelsIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryRemovedLabelsIssueLabel struct {
+	// The label's name.
+	Name string `json:"name"`
+}
+
+// GetName returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryRemovedLabelsIssueLabel.Name, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryRemovedLabelsIssueLabel) GetName() string {
+	return v.Name
+}
+
+// IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryToAssigneeUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryToAssigneeUser struct {
+	// The user's full name.
+	Name string `json:"name"`
+}
+
+// GetName returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryToAssigneeUser.Name, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryToAssigneeUser) GetName() string {
+	return v.Name
+}
+
+// IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryToStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryToStateWorkflowState struct {
+	// The state's name.
+	Name string `json:"name"`
+}
+
+// GetName returns IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryToStateWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryToStateWorkflowState) GetName() string {
+	return v.Name
+}
+
+// IssueHistoryResponse is returned by IssueHistory on success.
+type IssueHistoryResponse struct {
+	// One specific issue.
+	Issue *IssueHistoryIssue `json:"issue"`
+}
+
+// GetIssue returns IssueHistoryResponse.Issue, and is useful for accessing the field via an interface.
+func (v *IssueHistoryResponse) GetIssue() *IssueHistoryIssue { return v.Issue }
+
+// Comparator for issue identifiers.
+type IssueIDComparator struct {
+	// Equals constraint.
+	Eq *string `json:"eq"`
+	// In-array constraint.
+	In []string `json:"in"`
+	// Not-equals constraint.
+	Neq *string `json:"neq"`
+	// Not-in-array constraint.
+	Nin []string `json:"nin"`
+}
+
+// GetEq returns IssueIDComparator.Eq, and is useful for accessing the field via an interface.
+func (v *IssueIDComparator) GetEq() *string { return v.Eq }
+
+// GetIn returns IssueIDComparator.In, and is useful for accessing the field via an interface.
+func (v *IssueIDComparator) GetIn() []string { return v.In }
+
+// GetNeq returns IssueIDComparator.Neq, and is useful for accessing the field via an interface.
+func (v *IssueIDComparator) GetNeq() *string { return v.Neq }
+
+// GetNin returns IssueIDComparator.Nin, and is useful for accessing the field via an interface.
+func (v *IssueIDComparator) GetNin() []string { return v.Nin }
+
+// Issue label filtering options.
+type IssueLabelCollectionFilter struct {
+	// Compound filters, all of which need to be matched by the label.
+	And []*IssueLabelCollectionFilter `json:"and,omitempty"`
+	// Comparator for the created at date.
+	CreatedAt *DateComparator `json:"createdAt,omitempty"`
+	// Filters that the issue labels creator must satisfy.
+	Creator *NullableUserFilter `json:"creator,omitempty"`
+	// Filters that needs to be matched by all issue labels.
+	Every *IssueLabelFilter `json:"every,omitempty"`
+	// Comparator for the identifier.
+	Id *IDComparator `json:"id,omitempty"`
+	// Comparator for whether the label is a group label.
 	IsGroup *BooleanComparator `json:"isGroup,omitempty"`
 	// Comparator for the collection length.
 	Length *NumberComparator `json:"length,omitempty"`
@@ -2421,112 +3060,421 @@ func (v *IssueLabelFilter) GetTeam() *NullableTeamFilter { return v.Team }
 // GetUpdatedAt returns IssueLabelFilter.UpdatedAt, and is useful for accessing the field via an interface.
 func (v *IssueLabelFilter) GetUpdatedAt() *DateComparator { return v.UpdatedAt }
 
-// IssueSuggestion collection filtering options.
-type IssueSuggestionCollectionFilter struct {
-	// Compound filters, all of which need to be matched by the suggestion.
-	And []*IssueSuggestionCollectionFilter `json:"and,omitempty"`
-	// Comparator for the created at date.
-	CreatedAt *DateComparator `json:"createdAt,omitempty"`
-	// Filters that needs to be matched by all suggestions.
-	Every *IssueSuggestionFilter `json:"every,omitempty"`
-	// Comparator for the identifier.
-	Id *IDComparator `json:"id,omitempty"`
-	// Comparator for the collection length.
-	Length *NumberComparator `json:"length,omitempty"`
-	// Compound filters, one of which need to be matched by the suggestion.
-	Or []*IssueSuggestionCollectionFilter `json:"or,omitempty"`
-	// Filters that needs to be matched by some suggestions.
-	Some *IssueSuggestionFilter `json:"some,omitempty"`
-	// Comparator for the suggestion state.
-	State *StringComparator `json:"state,omitempty"`
-	// Filters that the suggested label must satisfy.
-	SuggestedLabel *IssueLabelFilter `json:"suggestedLabel,omitempty"`
-	// Filters that the suggested project must satisfy.
-	SuggestedProject *NullableProjectFilter `json:"suggestedProject,omitempty"`
-	// Filters that the suggested team must satisfy.
-	SuggestedTeam *NullableTeamFilter `json:"suggestedTeam,omitempty"`
-	// Filters that the suggested user must satisfy.
-	SuggestedUser *NullableUserFilter `json:"suggestedUser,omitempty"`
-	// Comparator for the suggestion type.
-	Type *StringComparator `json:"type,omitempty"`
-	// Comparator for the updated at date.
-	UpdatedAt *DateComparator `json:"updatedAt,omitempty"`
+// IssueRelationsIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type IssueRelationsIssue struct {
+	// The parent of the issue.
+	Parent *IssueRelationsIssueParentIssue `json:"parent"`
+	// Children of the issue.
+	Children *IssueRelationsIssueChildrenIssueConnection `json:"children"`
+	// Relations associated with this issue.
+	Relations *IssueRelationsIssueRelationsIssueRelationConnection `json:"relations"`
+	// Inverse relations associated with this issue.
+	InverseRelations *IssueRelationsIssueInverseRelationsIssueRelationConnection `json:"inverseRelations"`
 }
 
-// GetAnd returns IssueSuggestionCollectionFilter.And, and is useful for accessing the field via an interface.
-func (v *IssueSuggestionCollectionFilter) GetAnd() []*IssueSuggestionCollectionFilter { return v.And }
-
-// GetCreatedAt returns IssueSuggestionCollectionFilter.CreatedAt, and is useful for accessing the field via an interface.
-func (v *IssueSuggestionCollectionFilter) GetCreatedAt() *DateComparator { return v.CreatedAt }
+// GetParent returns IssueRelationsIssue.Parent, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssue) GetParent() *IssueRelationsIssueParentIssue { return v.Parent }
 
-// GetEvery returns IssueSuggestionCollectionFilter.Every, and is useful for accessing the field via an interface.
-func (v *IssueSuggestionCollectionFilter) GetEvery() *IssueSuggestionFilter { return v.Every }
+// GetChildren returns IssueRelationsIssue.Children, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssue) GetChildren() *IssueRelationsIssueChildrenIssueConnection {
+	return v.Children
+}
 
-// GetId returns IssueSuggestionCollectionFilter.Id, and is useful for accessing the field via an interface.
-func (v *IssueSuggestionCollectionFilter) GetId() *IDComparator { return v.Id }
+// GetRelations returns IssueRelationsIssue.Relations, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssue) GetRelations() *IssueRelationsIssueRelationsIssueRelationConnection {
+	return v.Relations
+}
 
-// GetLength returns IssueSuggestionCollectionFilter.Length, and is useful for accessing the field via an interface.
-func (v *IssueSuggestionCollectionFilter) GetLength() *NumberComparator { return v.Length }
+// GetInverseRelations returns IssueRelationsIssue.InverseRelations, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssue) GetInverseRelations() *IssueRelationsIssueInverseRelationsIssueRelationConnection {
+	return v.InverseRelations
+}
 
-// GetOr returns IssueSuggestionCollectionFilter.Or, and is useful for accessing the field via an interface.
-func (v *IssueSuggestionCollectionFilter) GetOr() []*IssueSuggestionCollectionFilter { return v.Or }
+// IssueRelationsIssueChildrenIssueConnection includes the requested fields of the GraphQL type IssueConnection.
+type IssueRelationsIssueChildrenIssueConnection struct {
+	Nodes []*IssueRelationsIssueChildrenIssueConnectionNodesIssue `json:"nodes"`
+}
 
-// GetSome returns IssueSuggestionCollectionFilter.Some, and is useful for accessing the field via an interface.
-func (v *IssueSuggestionCollectionFilter) GetSome() *IssueSuggestionFilter { return v.Some }
+// GetNodes returns IssueRelationsIssueChildrenIssueConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueChildrenIssueConnection) GetNodes() []*IssueRelationsIssueChildrenIssueConnectionNodesIssue {
+	return v.Nodes
+}
 
-// GetState returns IssueSuggestionCollectionFilter.State, and is useful for accessing the field via an interface.
-func (v *IssueSuggestionCollectionFilter) GetState() *StringComparator { return v.State }
+// IssueRelationsIssueChildrenIssueConnectionNodesIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type IssueRelationsIssueChildrenIssueConnectionNodesIssue struct {
+	// Issue's human readable identifier (e.g. ENG-123).
+	Identifier string `json:"identifier"`
+	// The issue's title.
+	Title string `json:"title"`
+	// The workflow state that the issue is associated with.
+	State *IssueRelationsIssueChildrenIssueConnectionNodesIssueStateWorkflowState `json:"state"`
+}
 
-// GetSuggestedLabel returns IssueSuggestionCollectionFilter.SuggestedLabel, and is useful for accessing the field via an interface.
-func (v *IssueSuggestionCollectionFilter) GetSuggestedLabel() *IssueLabelFilter {
-	return v.SuggestedLabel
+// GetIdentifier returns IssueRelationsIssueChildrenIssueConnectionNodesIssue.Identifier, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueChildrenIssueConnectionNodesIssue) GetIdentifier() string {
+	return v.Identifier
 }
 
-// GetSuggestedProject returns IssueSuggestionCollectionFilter.SuggestedProject, and is useful for accessing the field via an interface.
-func (v *IssueSuggestionCollectionFilter) GetSuggestedProject() *NullableProjectFilter {
-	return v.SuggestedProject
+// GetTitle returns IssueRelationsIssueChildrenIssueConnectionNodesIssue.Title, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueChildrenIssueConnectionNodesIssue) GetTitle() string { return v.Title }
+
+// GetState returns IssueRelationsIssueChildrenIssueConnectionNodesIssue.State, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueChildrenIssueConnectionNodesIssue) GetState() *IssueRelationsIssueChildrenIssueConnectionNodesIssueStateWorkflowState {
+	return v.State
 }
 
-// GetSuggestedTeam returns IssueSuggestionCollectionFilter.SuggestedTeam, and is useful for accessing the field via an interface.
-func (v *IssueSuggestionCollectionFilter) GetSuggestedTeam() *NullableTeamFilter {
-	return v.SuggestedTeam
+// IssueRelationsIssueChildrenIssueConnectionNodesIssueStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type IssueRelationsIssueChildrenIssueConnectionNodesIssueStateWorkflowState struct {
+	// The state's name.
+	Name string `json:"name"`
+	// The type of the state. One of "triage", "backlog", "unstarted", "started", "completed", "canceled".
+	Type string `json:"type"`
 }
 
-// GetSuggestedUser returns IssueSuggestionCollectionFilter.SuggestedUser, and is useful for accessing the field via an interface.
-func (v *IssueSuggestionCollectionFilter) GetSuggestedUser() *NullableUserFilter {
-	return v.SuggestedUser
+// GetName returns IssueRelationsIssueChildrenIssueConnectionNodesIssueStateWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueChildrenIssueConnectionNodesIssueStateWorkflowState) GetName() string {
+	return v.Name
 }
 
-// GetType returns IssueSuggestionCollectionFilter.Type, and is useful for accessing the field via an interface.
-func (v *IssueSuggestionCollectionFilter) GetType() *StringComparator { return v.Type }
+// GetType returns IssueRelationsIssueChildrenIssueConnectionNodesIssueStateWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueChildrenIssueConnectionNodesIssueStateWorkflowState) GetType() string {
+	return v.Type
+}
 
-// GetUpdatedAt returns IssueSuggestionCollectionFilter.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *IssueSuggestionCollectionFilter) GetUpdatedAt() *DateComparator { return v.UpdatedAt }
+// IssueRelationsIssueInverseRelationsIssueRelationConnection includes the requested fields of the GraphQL type IssueRelationConnection.
+type IssueRelationsIssueInverseRelationsIssueRelationConnection struct {
+	Nodes []*IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelation `json:"nodes"`
+}
 
-// IssueSuggestion filtering options.
-type IssueSuggestionFilter struct {
-	// Compound filters, all of which need to be matched by the suggestion.
-	And []*IssueSuggestionFilter `json:"and,omitempty"`
-	// Comparator for the created at date.
-	CreatedAt *DateComparator `json:"createdAt,omitempty"`
-	// Comparator for the identifier.
-	Id *IDComparator `json:"id,omitempty"`
-	// Compound filters, one of which need to be matched by the suggestion.
-	Or []*IssueSuggestionFilter `json:"or,omitempty"`
-	// Comparator for the suggestion state.
-	State *StringComparator `json:"state,omitempty"`
-	// Filters that the suggested label must satisfy.
-	SuggestedLabel *IssueLabelFilter `json:"suggestedLabel,omitempty"`
-	// Filters that the suggested project must satisfy.
-	SuggestedProject *NullableProjectFilter `json:"suggestedProject,omitempty"`
-	// Filters that the suggested team must satisfy.
-	SuggestedTeam *NullableTeamFilter `json:"suggestedTeam,omitempty"`
-	// Filters that the suggested user must satisfy.
-	SuggestedUser *NullableUserFilter `json:"suggestedUser,omitempty"`
-	// Comparator for the suggestion type.
-	Type *StringComparator `json:"type,omitempty"`
-	// Comparator for the updated at date.
-	UpdatedAt *DateComparator `json:"updatedAt,omitempty"`
+// GetNodes returns IssueRelationsIssueInverseRelationsIssueRelationConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueInverseRelationsIssueRelationConnection) GetNodes() []*IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelation {
+	return v.Nodes
+}
+
+// IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelation includes the requested fields of the GraphQL type IssueRelation.
+// The GraphQL type's documentation follows.
+//
+// A relation between two issues.
+type IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelation struct {
+	// The relationship of the issue with the related issue.
+	Type string `json:"type"`
+	// The issue whose relationship is being described.
+	Issue *IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue `json:"issue"`
+}
+
+// GetType returns IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelation.Type, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelation) GetType() string {
+	return v.Type
+}
+
+// GetIssue returns IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelation.Issue, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelation) GetIssue() *IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue {
+	return v.Issue
+}
+
+// IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue struct {
+	// Issue's human readable identifier (e.g. ENG-123).
+	Identifier string `json:"identifier"`
+	// The issue's title.
+	Title string `json:"title"`
+	// The workflow state that the issue is associated with.
+	State *IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueStateWorkflowState `json:"state"`
+}
+
+// GetIdentifier returns IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue.Identifier, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue) GetIdentifier() string {
+	return v.Identifier
+}
+
+// GetTitle returns IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue.Title, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue) GetTitle() string {
+	return v.Title
+}
+
+// GetState returns IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue.State, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue) GetState() *IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueStateWorkflowState {
+	return v.State
+}
+
+// IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueStateWorkflowState struct {
+	// The state's name.
+	Name string `json:"name"`
+	// The type of the state. One of "triage", "backlog", "unstarted", "started", "completed", "canceled".
+	Type string `json:"type"`
+}
+
+// GetName returns IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueStateWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueStateWorkflowState) GetName() string {
+	return v.Name
+}
+
+// GetType returns IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueStateWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueStateWorkflowState) GetType() string {
+	return v.Type
+}
+
+// IssueRelationsIssueParentIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type IssueRelationsIssueParentIssue struct {
+	// Issue's human readable identifier (e.g. ENG-123).
+	Identifier string `json:"identifier"`
+	// The issue's title.
+	Title string `json:"title"`
+	// The workflow state that the issue is associated with.
+	State *IssueRelationsIssueParentIssueStateWorkflowState `json:"state"`
+}
+
+// GetIdentifier returns IssueRelationsIssueParentIssue.Identifier, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueParentIssue) GetIdentifier() string { return v.Identifier }
+
+// GetTitle returns IssueRelationsIssueParentIssue.Title, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueParentIssue) GetTitle() string { return v.Title }
+
+// GetState returns IssueRelationsIssueParentIssue.State, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueParentIssue) GetState() *IssueRelationsIssueParentIssueStateWorkflowState {
+	return v.State
+}
+
+// IssueRelationsIssueParentIssueStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type IssueRelationsIssueParentIssueStateWorkflowState struct {
+	// The state's name.
+	Name string `json:"name"`
+	// The type of the state. One of "triage", "backlog", "unstarted", "started", "completed", "canceled".
+	Type string `json:"type"`
+}
+
+// GetName returns IssueRelationsIssueParentIssueStateWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueParentIssueStateWorkflowState) GetName() string { return v.Name }
+
+// GetType returns IssueRelationsIssueParentIssueStateWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueParentIssueStateWorkflowState) GetType() string { return v.Type }
+
+// IssueRelationsIssueRelationsIssueRelationConnection includes the requested fields of the GraphQL type IssueRelationConnection.
+type IssueRelationsIssueRelationsIssueRelationConnection struct {
+	Nodes []*IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelation `json:"nodes"`
+}
+
+// GetNodes returns IssueRelationsIssueRelationsIssueRelationConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueRelationsIssueRelationConnection) GetNodes() []*IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelation {
+	return v.Nodes
+}
+
+// IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelation includes the requested fields of the GraphQL type IssueRelation.
+// The GraphQL type's documentation follows.
+//
+// A relation between two issues.
+type IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelation struct {
+	// The relationship of the issue with the related issue.
+	Type string `json:"type"`
+	// The related issue.
+	RelatedIssue *IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssue `json:"relatedIssue"`
+}
+
+// GetType returns IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelation.Type, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelation) GetType() string {
+	return v.Type
+}
+
+// GetRelatedIssue returns IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelation.RelatedIssue, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelation) GetRelatedIssue() *IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssue {
+	return v.RelatedIssue
+}
+
+// IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssue struct {
+	// Issue's human readable identifier (e.g. ENG-123).
+	Identifier string `json:"identifier"`
+	// The issue's title.
+	Title string `json:"title"`
+	// The workflow state that the issue is associated with.
+	State *IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssueStateWorkflowState `json:"state"`
+}
+
+// GetIdentifier returns IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssue.Identifier, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssue) GetIdentifier() string {
+	return v.Identifier
+}
+
+// GetTitle returns IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssue.Title, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssue) GetTitle() string {
+	return v.Title
+}
+
+// GetState returns IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssue.State, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssue) GetState() *IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssueStateWorkflowState {
+	return v.State
+}
+
+// IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssueStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssueStateWorkflowState struct {
+	// The state's name.
+	Name string `json:"name"`
+	// The type of the state. One of "triage", "backlog", "unstarted", "started", "completed", "canceled".
+	Type string `json:"type"`
+}
+
+// GetName returns IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssueStateWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssueStateWorkflowState) GetName() string {
+	return v.Name
+}
+
+// GetType returns IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssueStateWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssueStateWorkflowState) GetType() string {
+	return v.Type
+}
+
+// IssueRelationsResponse is returned by IssueRelations on success.
+type IssueRelationsResponse struct {
+	// One specific issue.
+	Issue *IssueRelationsIssue `json:"issue"`
+}
+
+// GetIssue returns IssueRelationsResponse.Issue, and is useful for accessing the field via an interface.
+func (v *IssueRelationsResponse) GetIssue() *IssueRelationsIssue { return v.Issue }
+
+// Issue sorting options.
+type IssueSortInput struct {
+	// Sort by issue creation date
+	CreatedAt *CreatedAtSort `json:"createdAt,omitempty"`
+}
+
+// GetCreatedAt returns IssueSortInput.CreatedAt, and is useful for accessing the field via an interface.
+func (v *IssueSortInput) GetCreatedAt() *CreatedAtSort { return v.CreatedAt }
+
+// IssueSuggestion collection filtering options.
+type IssueSuggestionCollectionFilter struct {
+	// Compound filters, all of which need to be matched by the suggestion.
+	And []*IssueSuggestionCollectionFilter `json:"and,omitempty"`
+	// Comparator for the created at date.
+	CreatedAt *DateComparator `json:"createdAt,omitempty"`
+	// Filters that needs to be matched by all suggestions.
+	Every *IssueSuggestionFilter `json:"every,omitempty"`
+	// Comparator for the identifier.
+	Id *IDComparator `json:"id,omitempty"`
+	// Comparator for the collection length.
+	Length *NumberComparator `json:"length,omitempty"`
+	// Compound filters, one of which need to be matched by the suggestion.
+	Or []*IssueSuggestionCollectionFilter `json:"or,omitempty"`
+	// Filters that needs to be matched by some suggestions.
+	Some *IssueSuggestionFilter `json:"some,omitempty"`
+	// Comparator for the suggestion state.
+	State *StringComparator `json:"state,omitempty"`
+	// Filters that the suggested label must satisfy.
+	SuggestedLabel *IssueLabelFilter `json:"suggestedLabel,omitempty"`
+	// Filters that the suggested project must satisfy.
+	SuggestedProject *NullableProjectFilter `json:"suggestedProject,omitempty"`
+	// Filters that the suggested team must satisfy.
+	SuggestedTeam *NullableTeamFilter `json:"suggestedTeam,omitempty"`
+	// Filters that the suggested user must satisfy.
+	SuggestedUser *NullableUserFilter `json:"suggestedUser,omitempty"`
+	// Comparator for the suggestion type.
+	Type *StringComparator `json:"type,omitempty"`
+	// Comparator for the updated at date.
+	UpdatedAt *DateComparator `json:"updatedAt,omitempty"`
+}
+
+// GetAnd returns IssueSuggestionCollectionFilter.And, and is useful for accessing the field via an interface.
+func (v *IssueSuggestionCollectionFilter) GetAnd() []*IssueSuggestionCollectionFilter { return v.And }
+
+// GetCreatedAt returns IssueSuggestionCollectionFilter.CreatedAt, and is useful for accessing the field via an interface.
+func (v *IssueSuggestionCollectionFilter) GetCreatedAt() *DateComparator { return v.CreatedAt }
+
+// GetEvery returns IssueSuggestionCollectionFilter.Every, and is useful for accessing the field via an interface.
+func (v *IssueSuggestionCollectionFilter) GetEvery() *IssueSuggestionFilter { return v.Every }
+
+// GetId returns IssueSuggestionCollectionFilter.Id, and is useful for accessing the field via an interface.
+func (v *IssueSuggestionCollectionFilter) GetId() *IDComparator { return v.Id }
+
+// GetLength returns IssueSuggestionCollectionFilter.Length, and is useful for accessing the field via an interface.
+func (v *IssueSuggestionCollectionFilter) GetLength() *NumberComparator { return v.Length }
+
+// GetOr returns IssueSuggestionCollectionFilter.Or, and is useful for accessing the field via an interface.
+func (v *IssueSuggestionCollectionFilter) GetOr() []*IssueSuggestionCollectionFilter { return v.Or }
+
+// GetSome returns IssueSuggestionCollectionFilter.Some, and is useful for accessing the field via an interface.
+func (v *IssueSuggestionCollectionFilter) GetSome() *IssueSuggestionFilter { return v.Some }
+
+// GetState returns IssueSuggestionCollectionFilter.State, and is useful for accessing the field via an interface.
+func (v *IssueSuggestionCollectionFilter) GetState() *StringComparator { return v.State }
+
+// GetSuggestedLabel returns IssueSuggestionCollectionFilter.SuggestedLabel, and is useful for accessing the field via an interface.
+func (v *IssueSuggestionCollectionFilter) GetSuggestedLabel() *IssueLabelFilter {
+	return v.SuggestedLabel
+}
+
+// GetSuggestedProject returns IssueSuggestionCollectionFilter.SuggestedProject, and is useful for accessing the field via an interface.
+func (v *IssueSuggestionCollectionFilter) GetSuggestedProject() *NullableProjectFilter {
+	return v.SuggestedProject
+}
+
+// GetSuggestedTeam returns IssueSuggestionCollectionFilter.SuggestedTeam, and is useful for accessing the field via an interface.
+func (v *IssueSuggestionCollectionFilter) GetSuggestedTeam() *NullableTeamFilter {
+	return v.SuggestedTeam
+}
+
+// GetSuggestedUser returns IssueSuggestionCollectionFilter.SuggestedUser, and is useful for accessing the field via an interface.
+func (v *IssueSuggestionCollectionFilter) GetSuggestedUser() *NullableUserFilter {
+	return v.SuggestedUser
+}
+
+// GetType returns IssueSuggestionCollectionFilter.Type, and is useful for accessing the field via an interface.
+func (v *IssueSuggestionCollectionFilter) GetType() *StringComparator { return v.Type }
+
+// GetUpdatedAt returns IssueSuggestionCollectionFilter.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *IssueSuggestionCollectionFilter) GetUpdatedAt() *DateComparator { return v.UpdatedAt }
+
+// IssueSuggestion filtering options.
+type IssueSuggestionFilter struct {
+	// Compound filters, all of which need to be matched by the suggestion.
+	And []*IssueSuggestionFilter `json:"and,omitempty"`
+	// Comparator for the created at date.
+	CreatedAt *DateComparator `json:"createdAt,omitempty"`
+	// Comparator for the identifier.
+	Id *IDComparator `json:"id,omitempty"`
+	// Compound filters, one of which need to be matched by the suggestion.
+	Or []*IssueSuggestionFilter `json:"or,omitempty"`
+	// Comparator for the suggestion state.
+	State *StringComparator `json:"state,omitempty"`
+	// Filters that the suggested label must satisfy.
+	SuggestedLabel *IssueLabelFilter `json:"suggestedLabel,omitempty"`
+	// Filters that the suggested project must satisfy.
+	SuggestedProject *NullableProjectFilter `json:"suggestedProject,omitempty"`
+	// Filters that the suggested team must satisfy.
+	SuggestedTeam *NullableTeamFilter `json:"suggestedTeam,omitempty"`
+	// Filters that the suggested user must satisfy.
+	SuggestedUser *NullableUserFilter `json:"suggestedUser,omitempty"`
+	// Comparator for the suggestion type.
+	Type *StringComparator `json:"type,omitempty"`
+	// Comparator for the updated at date.
+	UpdatedAt *DateComparator `json:"updatedAt,omitempty"`
 }
 
 // GetAnd returns IssueSuggestionFilter.And, and is useful for accessing the field via an interface.
@@ -2815,6 +3763,8 @@ type ListIssuesIssuesIssueConnectionNodesIssue struct {
 	Identifier string `json:"identifier"`
 	// The issue's title.
 	Title string `json:"title"`
+	// Suggested branch name for the issue.
+	BranchName string `json:"branchName"`
 	// The workflow state that the issue is associated with.
 	State *ListIssuesIssuesIssueConnectionNodesIssueStateWorkflowState `json:"state"`
 	// The priority of the issue. 0 = No priority, 1 = Urgent, 2 = High, 3 = Normal, 4 = Low.
@@ -2836,6 +3786,8 @@ type ListIssuesIssuesIssueConnectionNodesIssue struct {
 	Project *ListIssuesIssuesIssueConnectionNodesIssueProject `json:"project"`
 	// Labels associated with this issue.
 	Labels *ListIssuesIssuesIssueConnectionNodesIssueLabelsIssueLabelConnection `json:"labels"`
+	// The parent of the issue.
+	Parent *ListIssuesIssuesIssueConnectionNodesIssueParentIssue `json:"parent"`
 }
 
 // GetId returns ListIssuesIssuesIssueConnectionNodesIssue.Id, and is useful for accessing the field via an interface.
@@ -2847,6 +3799,9 @@ func (v *ListIssuesIssuesIssueConnectionNodesIssue) GetIdentifier() string { ret
 // GetTitle returns ListIssuesIssuesIssueConnectionNodesIssue.Title, and is useful for accessing the field via an interface.
 func (v *ListIssuesIssuesIssueConnectionNodesIssue) GetTitle() string { return v.Title }
 
+// GetBranchName returns ListIssuesIssuesIssueConnectionNodesIssue.BranchName, and is useful for accessing the field via an interface.
+func (v *ListIssuesIssuesIssueConnectionNodesIssue) GetBranchName() string { return v.BranchName }
+
 // GetState returns ListIssuesIssuesIssueConnectionNodesIssue.State, and is useful for accessing the field via an interface.
 func (v *ListIssuesIssuesIssueConnectionNodesIssue) GetState() *ListIssuesIssuesIssueConnectionNodesIssueStateWorkflowState {
 	return v.State
@@ -2887,6 +3842,11 @@ func (v *ListIssuesIssuesIssueConnectionNodesIssue) GetLabels() *ListIssuesIssue
 	return v.Labels
 }
 
+// GetParent returns ListIssuesIssuesIssueConnectionNodesIssue.Parent, and is useful for accessing the field via an interface.
+func (v *ListIssuesIssuesIssueConnectionNodesIssue) GetParent() *ListIssuesIssuesIssueConnectionNodesIssueParentIssue {
+	return v.Parent
+}
+
 // ListIssuesIssuesIssueConnectionNodesIssueAssigneeUser includes the requested fields of the GraphQL type User.
 // The GraphQL type's documentation follows.
 //
@@ -2940,6 +3900,20 @@ func (v *ListIssuesIssuesIssueConnectionNodesIssueLabelsIssueLabelConnectionNode
 	return v.Name
 }
 
+// ListIssuesIssuesIssueConnectionNodesIssueParentIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type ListIssuesIssuesIssueConnectionNodesIssueParentIssue struct {
+	// Issue's human readable identifier (e.g. ENG-123).
+	Identifier string `json:"identifier"`
+}
+
+// GetIdentifier returns ListIssuesIssuesIssueConnectionNodesIssueParentIssue.Identifier, and is useful for accessing the field via an interface.
+func (v *ListIssuesIssuesIssueConnectionNodesIssueParentIssue) GetIdentifier() string {
+	return v.Identifier
+}
+
 // ListIssuesIssuesIssueConnectionNodesIssueProject includes the requested fields of the GraphQL type Project.
 // The GraphQL type's documentation follows.
 //
@@ -3080,6 +4054,8 @@ type ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue struct {
 	Identifier string `json:"identifier"`
 	// The issue's title.
 	Title string `json:"title"`
+	// Suggested branch name for the issue.
+	BranchName string `json:"branchName"`
 	// The workflow state that the issue is associated with.
 	State *ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueStateWorkflowState `json:"state"`
 	// The priority of the issue. 0 = No priority, 1 = Urgent, 2 = High, 3 = Normal, 4 = Low.
@@ -3101,6 +4077,8 @@ type ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue struct {
 	Project *ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueProject `json:"project"`
 	// Labels associated with this issue.
 	Labels *ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueLabelsIssueLabelConnection `json:"labels"`
+	// The parent of the issue.
+	Parent *ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueParentIssue `json:"parent"`
 }
 
 // GetId returns ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue.Id, and is useful for accessing the field via an interface.
@@ -3116,6 +4094,11 @@ func (v *ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue) GetTitle
 	return v.Title
 }
 
+// GetBranchName returns ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue.BranchName, and is useful for accessing the field via an interface.
+func (v *ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue) GetBranchName() string {
+	return v.BranchName
+}
+
 // GetState returns ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue.State, and is useful for accessing the field via an interface.
 func (v *ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue) GetState() *ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueStateWorkflowState {
 	return v.State
@@ -3166,6 +4149,11 @@ func (v *ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue) GetLabel
 	return v.Labels
 }
 
+// GetParent returns ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue.Parent, and is useful for accessing the field via an interface.
+func (v *ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue) GetParent() *ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueParentIssue {
+	return v.Parent
+}
+
 // ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueAssigneeUser includes the requested fields of the GraphQL type User.
 // The GraphQL type's documentation follows.
 //
@@ -3225,6 +4213,20 @@ func (v *ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueLabelsIssu
 	return v.Name
 }
 
+// ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueParentIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueParentIssue struct {
+	// Issue's human readable identifier (e.g. ENG-123).
+	Identifier string `json:"identifier"`
+}
+
+// GetIdentifier returns ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueParentIssue.Identifier, and is useful for accessing the field via an interface.
+func (v *ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueParentIssue) GetIdentifier() string {
+	return v.Identifier
+}
+
 // ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueProject includes the requested fields of the GraphQL type Project.
 // The GraphQL type's documentation follows.
 //
@@ -3316,6 +4318,47 @@ func (v *ListProjectsResponse) GetProjects() *ListProjectsProjectsProjectConnect
 	return v.Projects
 }
 
+// ListTeamsResponse is returned by ListTeams on success.
+type ListTeamsResponse struct {
+	// All teams whose issues can be accessed by the user. This might be different from `administrableTeams`, which also includes teams whose settings can be changed by the user.
+	Teams *ListTeamsTeamsTeamConnection `json:"teams"`
+}
+
+// GetTeams returns ListTeamsResponse.Teams, and is useful for accessing the field via an interface.
+func (v *ListTeamsResponse) GetTeams() *ListTeamsTeamsTeamConnection { return v.Teams }
+
+// ListTeamsTeamsTeamConnection includes the requested fields of the GraphQL type TeamConnection.
+type ListTeamsTeamsTeamConnection struct {
+	Nodes []*ListTeamsTeamsTeamConnectionNodesTeam `json:"nodes"`
+}
+
+// GetNodes returns ListTeamsTeamsTeamConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListTeamsTeamsTeamConnection) GetNodes() []*ListTeamsTeamsTeamConnectionNodesTeam {
+	return v.Nodes
+}
+
+// ListTeamsTeamsTeamConnectionNodesTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type ListTeamsTeamsTeamConnectionNodesTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The team's unique key. The key is used in URLs.
+	Key string `json:"key"`
+	// The team's name.
+	Name string `json:"name"`
+}
+
+// GetId returns ListTeamsTeamsTeamConnectionNodesTeam.Id, and is useful for accessing the field via an interface.
+func (v *ListTeamsTeamsTeamConnectionNodesTeam) GetId() string { return v.Id }
+
+// GetKey returns ListTeamsTeamsTeamConnectionNodesTeam.Key, and is useful for accessing the field via an interface.
+func (v *ListTeamsTeamsTeamConnectionNodesTeam) GetKey() string { return v.Key }
+
+// GetName returns ListTeamsTeamsTeamConnectionNodesTeam.Name, and is useful for accessing the field via an interface.
+func (v *ListTeamsTeamsTeamConnectionNodesTeam) GetName() string { return v.Name }
+
 // ListUsersResponse is returned by ListUsers on success.
 type ListUsersResponse struct {
 	// All users for the organization.
@@ -5003,6 +6046,32 @@ func (v *NumberComparator) GetNeq() *float64 { return v.Neq }
 // GetNin returns NumberComparator.Nin, and is useful for accessing the field via an interface.
 func (v *NumberComparator) GetNin() []float64 { return v.Nin }
 
+// How to treat NULL values, whether they should appear first or last
+type PaginationNulls string
+
+const (
+	PaginationNullsFirst PaginationNulls = "first"
+	PaginationNullsLast  PaginationNulls = "last"
+)
+
+var AllPaginationNulls = []PaginationNulls{
+	PaginationNullsFirst,
+	PaginationNullsLast,
+}
+
+// Whether to sort in ascending or descending order
+type PaginationSortOrder string
+
+const (
+	PaginationSortOrderAscending  PaginationSortOrder = "Ascending"
+	PaginationSortOrderDescending PaginationSortOrder = "Descending"
+)
+
+var AllPaginationSortOrder = []PaginationSortOrder{
+	PaginationSortOrderAscending,
+	PaginationSortOrderDescending,
+}
+
 // Project filtering options.
 type ProjectCollectionFilter struct {
 	// Filters that the project's team must satisfy.
@@ -7297,6 +8366,46 @@ type __AllActiveIssuesForCompletionInput struct {
 // GetFirst returns __AllActiveIssuesForCompletionInput.First, and is useful for accessing the field via an interface.
 func (v *__AllActiveIssuesForCompletionInput) GetFirst() int { return v.First }
 
+// __AttachIssueURLInput is used internally by genqlient
+type __AttachIssueURLInput struct {
+	IssueId string `json:"issueId"`
+	Url     string `json:"url"`
+	Title   string `json:"title"`
+}
+
+// GetIssueId returns __AttachIssueURLInput.IssueId, and is useful for accessing the field via an interface.
+func (v *__AttachIssueURLInput) GetIssueId() string { return v.IssueId }
+
+// GetUrl returns __AttachIssueURLInput.Url, and is useful for accessing the field via an interface.
+func (v *__AttachIssueURLInput) GetUrl() string { return v.Url }
+
+// GetTitle returns __AttachIssueURLInput.Title, and is useful for accessing the field via an interface.
+func (v *__AttachIssueURLInput) GetTitle() string { return v.Title }
+
+// __CreateCommentInput is used internally by genqlient
+type __CreateCommentInput struct {
+	IssueId string `json:"issueId"`
+	Body    string `json:"body"`
+}
+
+// GetIssueId returns __CreateCommentInput.IssueId, and is useful for accessing the field via an interface.
+func (v *__CreateCommentInput) GetIssueId() string { return v.IssueId }
+
+// GetBody returns __CreateCommentInput.Body, and is useful for accessing the field via an interface.
+func (v *__CreateCommentInput) GetBody() string { return v.Body }
+
+// __CreateDuplicateRelationInput is used internally by genqlient
+type __CreateDuplicateRelationInput struct {
+	IssueId        string `json:"issueId"`
+	RelatedIssueId string `json:"relatedIssueId"`
+}
+
+// GetIssueId returns __CreateDuplicateRelationInput.IssueId, and is useful for accessing the field via an interface.
+func (v *__CreateDuplicateRelationInput) GetIssueId() string { return v.IssueId }
+
+// GetRelatedIssueId returns __CreateDuplicateRelationInput.RelatedIssueId, and is useful for accessing the field via an interface.
+func (v *__CreateDuplicateRelationInput) GetRelatedIssueId() string { return v.RelatedIssueId }
+
 // __GetIssueInput is used internally by genqlient
 type __GetIssueInput struct {
 	Id string `json:"id"`
@@ -7313,6 +8422,46 @@ type __GetUserByDisplayNameInput struct {
 // GetDisplayName returns __GetUserByDisplayNameInput.DisplayName, and is useful for accessing the field via an interface.
 func (v *__GetUserByDisplayNameInput) GetDisplayName() string { return v.DisplayName }
 
+// __IssueChildrenAndCommentsInput is used internally by genqlient
+type __IssueChildrenAndCommentsInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __IssueChildrenAndCommentsInput.Id, and is useful for accessing the field via an interface.
+func (v *__IssueChildrenAndCommentsInput) GetId() string { return v.Id }
+
+// __IssueCommentsInput is used internally by genqlient
+type __IssueCommentsInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __IssueCommentsInput.Id, and is useful for accessing the field via an interface.
+func (v *__IssueCommentsInput) GetId() string { return v.Id }
+
+// __IssueContextInput is used internally by genqlient
+type __IssueContextInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __IssueContextInput.Id, and is useful for accessing the field via an interface.
+func (v *__IssueContextInput) GetId() string { return v.Id }
+
+// __IssueHistoryInput is used internally by genqlient
+type __IssueHistoryInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __IssueHistoryInput.Id, and is useful for accessing the field via an interface.
+func (v *__IssueHistoryInput) GetId() string { return v.Id }
+
+// __IssueRelationsInput is used internally by genqlient
+type __IssueRelationsInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __IssueRelationsInput.Id, and is useful for accessing the field via an interface.
+func (v *__IssueRelationsInput) GetId() string { return v.Id }
+
 // __ListCyclesInput is used internally by genqlient
 type __ListCyclesInput struct {
 	First int `json:"first"`
@@ -7323,9 +8472,10 @@ func (v *__ListCyclesInput) GetFirst() int { return v.First }
 
 // __ListIssuesInput is used internally by genqlient
 type __ListIssuesInput struct {
-	First  int          `json:"first"`
-	After  *string      `json:"after"`
-	Filter *IssueFilter `json:"filter,omitempty"`
+	First  int               `json:"first"`
+	After  *string           `json:"after"`
+	Filter *IssueFilter      `json:"filter,omitempty"`
+	Sort   []*IssueSortInput `json:"sort,omitempty"`
 }
 
 // GetFirst returns __ListIssuesInput.First, and is useful for accessing the field via an interface.
@@ -7337,6 +8487,9 @@ func (v *__ListIssuesInput) GetAfter() *string { return v.After }
 // GetFilter returns __ListIssuesInput.Filter, and is useful for accessing the field via an interface.
 func (v *__ListIssuesInput) GetFilter() *IssueFilter { return v.Filter }
 
+// GetSort returns __ListIssuesInput.Sort, and is useful for accessing the field via an interface.
+func (v *__ListIssuesInput) GetSort() []*IssueSortInput { return v.Sort }
+
 // __ListLabelsInput is used internally by genqlient
 type __ListLabelsInput struct {
 	First int `json:"first"`
@@ -7369,6 +8522,14 @@ type __ListProjectsInput struct {
 // GetFirst returns __ListProjectsInput.First, and is useful for accessing the field via an interface.
 func (v *__ListProjectsInput) GetFirst() int { return v.First }
 
+// __ListTeamsInput is used internally by genqlient
+type __ListTeamsInput struct {
+	First int `json:"first"`
+}
+
+// GetFirst returns __ListTeamsInput.First, and is useful for accessing the field via an interface.
+func (v *__ListTeamsInput) GetFirst() int { return v.First }
+
 // __ListUsersInput is used internally by genqlient
 type __ListUsersInput struct {
 	First int     `json:"first"`
@@ -7417,59 +8578,380 @@ func (v *__UpdateIssueInput) GetId() string { return v.Id }
 // GetInput returns __UpdateIssueInput.Input, and is useful for accessing the field via an interface.
 func (v *__UpdateIssueInput) GetInput() *IssueUpdateInput { return v.Input }
 
-// __UserIssuesForCompletionInput is used internally by genqlient
-type __UserIssuesForCompletionInput struct {
-	First        int    `json:"first"`
-	AssigneeName string `json:"assigneeName"`
+// __UserIssuesForCompletionInput is used internally by genqlient
+type __UserIssuesForCompletionInput struct {
+	First        int    `json:"first"`
+	AssigneeName string `json:"assigneeName"`
+}
+
+// GetFirst returns __UserIssuesForCompletionInput.First, and is useful for accessing the field via an interface.
+func (v *__UserIssuesForCompletionInput) GetFirst() int { return v.First }
+
+// GetAssigneeName returns __UserIssuesForCompletionInput.AssigneeName, and is useful for accessing the field via an interface.
+func (v *__UserIssuesForCompletionInput) GetAssigneeName() string { return v.AssigneeName }
+
+// __UsersForCompletionInput is used internally by genqlient
+type __UsersForCompletionInput struct {
+	First int `json:"first"`
+}
+
+// GetFirst returns __UsersForCompletionInput.First, and is useful for accessing the field via an interface.
+func (v *__UsersForCompletionInput) GetFirst() int { return v.First }
+
+// The query executed by ActiveIssuesForCompletion.
+const ActiveIssuesForCompletion_Operation = `
+query ActiveIssuesForCompletion ($first: Int!) {
+	viewer {
+		assignedIssues(first: $first, filter: {state:{type:{nin:["completed","canceled"]}}}) {
+			nodes {
+				identifier
+				title
+				state {
+					name
+					type
+				}
+				priority
+			}
+		}
+	}
+}
+`
+
+func ActiveIssuesForCompletion(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	first int,
+) (data_ *ActiveIssuesForCompletionResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ActiveIssuesForCompletion",
+		Query:  ActiveIssuesForCompletion_Operation,
+		Variables: &__ActiveIssuesForCompletionInput{
+			First: first,
+		},
+	}
+
+	data_ = &ActiveIssuesForCompletionResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by AllActiveIssuesForCompletion.
+const AllActiveIssuesForCompletion_Operation = `
+query AllActiveIssuesForCompletion ($first: Int!) {
+	issues(first: $first, filter: {state:{type:{nin:["completed","canceled"]}}}) {
+		nodes {
+			identifier
+			title
+			state {
+				name
+				type
+			}
+			priority
+		}
+	}
+}
+`
+
+func AllActiveIssuesForCompletion(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	first int,
+) (data_ *AllActiveIssuesForCompletionResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "AllActiveIssuesForCompletion",
+		Query:  AllActiveIssuesForCompletion_Operation,
+		Variables: &__AllActiveIssuesForCompletionInput{
+			First: first,
+		},
+	}
+
+	data_ = &AllActiveIssuesForCompletionResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by AttachIssueURL.
+const AttachIssueURL_Operation = `
+mutation AttachIssueURL ($issueId: String!, $url: String!, $title: String!) {
+	attachmentLinkURL(issueId: $issueId, url: $url, title: $title) {
+		success
+		attachment {
+			id
+			url
+		}
+	}
+}
+`
+
+func AttachIssueURL(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	issueId string,
+	url string,
+	title string,
+) (data_ *AttachIssueURLResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "AttachIssueURL",
+		Query:  AttachIssueURL_Operation,
+		Variables: &__AttachIssueURLInput{
+			IssueId: issueId,
+			Url:     url,
+			Title:   title,
+		},
+	}
+
+	data_ = &AttachIssueURLResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreateComment.
+const CreateComment_Operation = `
+mutation CreateComment ($issueId: String!, $body: String!) {
+	commentCreate(input: {issueId:$issueId,body:$body}) {
+		success
+	}
+}
+`
+
+func CreateComment(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	issueId string,
+	body string,
+) (data_ *CreateCommentResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateComment",
+		Query:  CreateComment_Operation,
+		Variables: &__CreateCommentInput{
+			IssueId: issueId,
+			Body:    body,
+		},
+	}
+
+	data_ = &CreateCommentResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreateDuplicateRelation.
+const CreateDuplicateRelation_Operation = `
+mutation CreateDuplicateRelation ($issueId: String!, $relatedIssueId: String!) {
+	issueRelationCreate(input: {issueId:$issueId,relatedIssueId:$relatedIssueId,type:duplicate}) {
+		success
+	}
+}
+`
+
+func CreateDuplicateRelation(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	issueId string,
+	relatedIssueId string,
+) (data_ *CreateDuplicateRelationResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateDuplicateRelation",
+		Query:  CreateDuplicateRelation_Operation,
+		Variables: &__CreateDuplicateRelationInput{
+			IssueId:        issueId,
+			RelatedIssueId: relatedIssueId,
+		},
+	}
+
+	data_ = &CreateDuplicateRelationResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetIssue.
+const GetIssue_Operation = `
+query GetIssue ($id: String!) {
+	issue(id: $id) {
+		id
+		identifier
+		title
+		description
+		url
+		priority
+		estimate
+		dueDate
+		createdAt
+		updatedAt
+		branchName
+		state {
+			id
+			name
+			type
+		}
+		assignee {
+			id
+			name
+			email
+		}
+		team {
+			id
+			name
+			key
+		}
+		cycle {
+			id
+			number
+			name
+			startsAt
+			endsAt
+		}
+		project {
+			id
+			name
+		}
+		labels {
+			nodes {
+				id
+				name
+			}
+		}
+		parent {
+			identifier
+			title
+		}
+	}
+}
+`
+
+func GetIssue(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *GetIssueResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetIssue",
+		Query:  GetIssue_Operation,
+		Variables: &__GetIssueInput{
+			Id: id,
+		},
+	}
+
+	data_ = &GetIssueResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetUserByDisplayName.
+const GetUserByDisplayName_Operation = `
+query GetUserByDisplayName ($displayName: String!) {
+	users(first: 1, filter: {displayName:{eqIgnoreCase:$displayName}}) {
+		nodes {
+			id
+			name
+			displayName
+			email
+			active
+			admin
+			isMe
+		}
+	}
 }
+`
 
-// GetFirst returns __UserIssuesForCompletionInput.First, and is useful for accessing the field via an interface.
-func (v *__UserIssuesForCompletionInput) GetFirst() int { return v.First }
+func GetUserByDisplayName(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	displayName string,
+) (data_ *GetUserByDisplayNameResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetUserByDisplayName",
+		Query:  GetUserByDisplayName_Operation,
+		Variables: &__GetUserByDisplayNameInput{
+			DisplayName: displayName,
+		},
+	}
 
-// GetAssigneeName returns __UserIssuesForCompletionInput.AssigneeName, and is useful for accessing the field via an interface.
-func (v *__UserIssuesForCompletionInput) GetAssigneeName() string { return v.AssigneeName }
+	data_ = &GetUserByDisplayNameResponse{}
+	resp_ := &graphql.Response{Data: data_}
 
-// __UsersForCompletionInput is used internally by genqlient
-type __UsersForCompletionInput struct {
-	First int `json:"first"`
-}
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
 
-// GetFirst returns __UsersForCompletionInput.First, and is useful for accessing the field via an interface.
-func (v *__UsersForCompletionInput) GetFirst() int { return v.First }
+	return data_, err_
+}
 
-// The query executed by ActiveIssuesForCompletion.
-const ActiveIssuesForCompletion_Operation = `
-query ActiveIssuesForCompletion ($first: Int!) {
-	viewer {
-		assignedIssues(first: $first, filter: {state:{type:{nin:["completed","canceled"]}}}) {
+// The query executed by IssueChildrenAndComments.
+const IssueChildrenAndComments_Operation = `
+query IssueChildrenAndComments ($id: String!) {
+	issue(id: $id) {
+		children(first: 50) {
 			nodes {
 				identifier
-				title
-				state {
-					name
-					type
-				}
-				priority
+			}
+		}
+		comments(first: 250) {
+			nodes {
+				id
 			}
 		}
 	}
 }
 `
 
-func ActiveIssuesForCompletion(
+func IssueChildrenAndComments(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	first int,
-) (data_ *ActiveIssuesForCompletionResponse, err_ error) {
+	id string,
+) (data_ *IssueChildrenAndCommentsResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "ActiveIssuesForCompletion",
-		Query:  ActiveIssuesForCompletion_Operation,
-		Variables: &__ActiveIssuesForCompletionInput{
-			First: first,
+		OpName: "IssueChildrenAndComments",
+		Query:  IssueChildrenAndComments_Operation,
+		Variables: &__IssueChildrenAndCommentsInput{
+			Id: id,
 		},
 	}
 
-	data_ = &ActiveIssuesForCompletionResponse{}
+	data_ = &IssueChildrenAndCommentsResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -7481,37 +8963,37 @@ func ActiveIssuesForCompletion(
 	return data_, err_
 }
 
-// The query executed by AllActiveIssuesForCompletion.
-const AllActiveIssuesForCompletion_Operation = `
-query AllActiveIssuesForCompletion ($first: Int!) {
-	issues(first: $first, filter: {state:{type:{nin:["completed","canceled"]}}}) {
-		nodes {
-			identifier
-			title
-			state {
-				name
-				type
+// The query executed by IssueComments.
+const IssueComments_Operation = `
+query IssueComments ($id: String!) {
+	issue(id: $id) {
+		comments(first: 50) {
+			nodes {
+				body
+				createdAt
+				user {
+					name
+				}
 			}
-			priority
 		}
 	}
 }
 `
 
-func AllActiveIssuesForCompletion(
+func IssueComments(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	first int,
-) (data_ *AllActiveIssuesForCompletionResponse, err_ error) {
+	id string,
+) (data_ *IssueCommentsResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "AllActiveIssuesForCompletion",
-		Query:  AllActiveIssuesForCompletion_Operation,
-		Variables: &__AllActiveIssuesForCompletionInput{
-			First: first,
+		OpName: "IssueComments",
+		Query:  IssueComments_Operation,
+		Variables: &__IssueCommentsInput{
+			Id: id,
 		},
 	}
 
-	data_ = &AllActiveIssuesForCompletionResponse{}
+	data_ = &IssueCommentsResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -7523,9 +9005,9 @@ func AllActiveIssuesForCompletion(
 	return data_, err_
 }
 
-// The query executed by GetIssue.
-const GetIssue_Operation = `
-query GetIssue ($id: String!) {
+// The query executed by IssueContext.
+const IssueContext_Operation = `
+query IssueContext ($id: String!) {
 	issue(id: $id) {
 		id
 		identifier
@@ -7575,23 +9057,49 @@ query GetIssue ($id: String!) {
 			title
 		}
 	}
+	related: issue(id: $id) {
+		children(first: 50) {
+			nodes {
+				identifier
+				title
+				state {
+					name
+					type
+				}
+			}
+		}
+		comments(first: 50, orderBy: createdAt) {
+			nodes {
+				body
+				createdAt
+				user {
+					name
+				}
+			}
+			pageInfo {
+				hasNextPage
+			}
+		}
+	}
 }
 `
 
-func GetIssue(
+// IssueContext fetches an issue with its sub-issues and latest comments in
+// one request, for "issue prompt".
+func IssueContext(
 	ctx_ context.Context,
 	client_ graphql.Client,
 	id string,
-) (data_ *GetIssueResponse, err_ error) {
+) (data_ *IssueContextResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "GetIssue",
-		Query:  GetIssue_Operation,
-		Variables: &__GetIssueInput{
+		OpName: "IssueContext",
+		Query:  IssueContext_Operation,
+		Variables: &__IssueContextInput{
 			Id: id,
 		},
 	}
 
-	data_ = &GetIssueResponse{}
+	data_ = &IssueContextResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -7603,37 +9111,136 @@ func GetIssue(
 	return data_, err_
 }
 
-// The query executed by GetUserByDisplayName.
-const GetUserByDisplayName_Operation = `
-query GetUserByDisplayName ($displayName: String!) {
-	users(first: 1, filter: {displayName:{eqIgnoreCase:$displayName}}) {
-		nodes {
-			id
-			name
-			displayName
-			email
-			active
-			admin
-			isMe
+// The query executed by IssueHistory.
+const IssueHistory_Operation = `
+query IssueHistory ($id: String!) {
+	issue(id: $id) {
+		history(first: 50) {
+			nodes {
+				createdAt
+				actor {
+					name
+				}
+				fromState {
+					name
+				}
+				toState {
+					name
+				}
+				fromAssignee {
+					name
+				}
+				toAssignee {
+					name
+				}
+				fromPriority
+				toPriority
+				fromTitle
+				toTitle
+				addedLabels {
+					name
+				}
+				removedLabels {
+					name
+				}
+				updatedDescription
+			}
 		}
 	}
 }
 `
 
-func GetUserByDisplayName(
+func IssueHistory(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	displayName string,
-) (data_ *GetUserByDisplayNameResponse, err_ error) {
+	id string,
+) (data_ *IssueHistoryResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "GetUserByDisplayName",
-		Query:  GetUserByDisplayName_Operation,
-		Variables: &__GetUserByDisplayNameInput{
-			DisplayName: displayName,
+		OpName: "IssueHistory",
+		Query:  IssueHistory_Operation,
+		Variables: &__IssueHistoryInput{
+			Id: id,
 		},
 	}
 
-	data_ = &GetUserByDisplayNameResponse{}
+	data_ = &IssueHistoryResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by IssueRelations.
+const IssueRelations_Operation = `
+query IssueRelations ($id: String!) {
+	issue(id: $id) {
+		parent {
+			identifier
+			title
+			state {
+				name
+				type
+			}
+		}
+		children(first: 50) {
+			nodes {
+				identifier
+				title
+				state {
+					name
+					type
+				}
+			}
+		}
+		relations(first: 50) {
+			nodes {
+				type
+				relatedIssue {
+					identifier
+					title
+					state {
+						name
+						type
+					}
+				}
+			}
+		}
+		inverseRelations(first: 50) {
+			nodes {
+				type
+				issue {
+					identifier
+					title
+					state {
+						name
+						type
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func IssueRelations(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *IssueRelationsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "IssueRelations",
+		Query:  IssueRelations_Operation,
+		Variables: &__IssueRelationsInput{
+			Id: id,
+		},
+	}
+
+	data_ = &IssueRelationsResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -7692,12 +9299,13 @@ func ListCycles(
 
 // The query executed by ListIssues.
 const ListIssues_Operation = `
-query ListIssues ($first: Int!, $after: String, $filter: IssueFilter) {
-	issues(first: $first, after: $after, filter: $filter) {
+query ListIssues ($first: Int!, $after: String, $filter: IssueFilter, $sort: [IssueSortInput!]) {
+	issues(first: $first, after: $after, filter: $filter, sort: $sort) {
 		nodes {
 			id
 			identifier
 			title
+			branchName
 			state {
 				name
 				type
@@ -7722,6 +9330,9 @@ query ListIssues ($first: Int!, $after: String, $filter: IssueFilter) {
 					name
 				}
 			}
+			parent {
+				identifier
+			}
 		}
 		pageInfo {
 			hasNextPage
@@ -7737,6 +9348,7 @@ func ListIssues(
 	first int,
 	after *string,
 	filter *IssueFilter,
+	sort []*IssueSortInput,
 ) (data_ *ListIssuesResponse, err_ error) {
 	req_ := &graphql.Request{
 		OpName: "ListIssues",
@@ -7745,6 +9357,7 @@ func ListIssues(
 			First:  first,
 			After:  after,
 			Filter: filter,
+			Sort:   sort,
 		},
 	}
 
@@ -7806,6 +9419,7 @@ query ListMyIssues ($first: Int!, $after: String, $filter: IssueFilter) {
 				id
 				identifier
 				title
+				branchName
 				state {
 					name
 					type
@@ -7830,6 +9444,9 @@ query ListMyIssues ($first: Int!, $after: String, $filter: IssueFilter) {
 						name
 					}
 				}
+				parent {
+					identifier
+				}
 			}
 			pageInfo {
 				hasNextPage
@@ -7906,6 +9523,44 @@ func ListProjects(
 	return data_, err_
 }
 
+// The query executed by ListTeams.
+const ListTeams_Operation = `
+query ListTeams ($first: Int!) {
+	teams(first: $first) {
+		nodes {
+			id
+			key
+			name
+		}
+	}
+}
+`
+
+func ListTeams(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	first int,
+) (data_ *ListTeamsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListTeams",
+		Query:  ListTeams_Operation,
+		Variables: &__ListTeamsInput{
+			First: first,
+		},
+	}
+
+	data_ = &ListTeamsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The query executed by ListUsers.
 const ListUsers_Operation = `
 query ListUsers ($first: Int!, $after: String) {
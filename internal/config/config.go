@@ -14,18 +14,314 @@ type Command struct {
 	Name    string `yaml:"name"`
 	Command string `yaml:"command"`
 	Exec    bool   `yaml:"exec"`
+	// Multi runs the command once for all selected issues, with
+	// {{.Identifiers}} and {{.Issues}} in the template, instead of once per
+	// issue.
+	Multi bool `yaml:"multi"`
+	// Key binds the command to an fzf key (e.g. "alt-c"), skipping the
+	// ctrl-o picker.
+	Key string `yaml:"key"`
+	// Confirm asks y/N before running the command.
+	Confirm bool `yaml:"confirm"`
+	// Reload refreshes the issue list after the command.
+	Reload bool `yaml:"reload"`
+	// Silent runs a key-bound command without handing it the terminal.
+	Silent bool `yaml:"silent"`
+	// Output is where the command's output goes: OutputTerminal (default)
+	// or OutputPreview.
+	Output string `yaml:"output"`
 }
 
+// Command outputs.
+const (
+	// OutputTerminal hands the terminal to the command.
+	OutputTerminal = "terminal"
+	// OutputPreview shows the command's output in the preview pane.
+	OutputPreview = "preview"
+)
+
 // Config holds all user configuration loaded from config.yaml.
 type Config struct {
 	Interactive InteractiveConfig `yaml:"interactive"`
+	Worktree    WorktreeConfig    `yaml:"worktree"`
+	Git         GitConfig         `yaml:"git"`
+	Workflow    WorkflowConfig    `yaml:"workflow"`
+	PR          PRConfig          `yaml:"pr"`
+	Columns     []ColumnConfig    `yaml:"columns"`
+	Views       []ViewConfig      `yaml:"views"`
+	Prompts     []PromptConfig    `yaml:"prompts"`
+	// Defaults overrides flag defaults per command, keyed by command path
+	// and then flag name, e.g. {"issue list": {"sort": "priority"}}.
+	// Flags given on the command line still win.
+	Defaults map[string]map[string]string `yaml:"defaults"`
+	// Aliases maps new top-level command names to expansions, e.g.
+	// {"bugs": "issue list --label bug"}. "$1"-style placeholders take
+	// positional arguments; expansions starting with "!" run in sh.
+	Aliases map[string]string `yaml:"aliases"`
+}
+
+// ViewConfig is a named set of "issue list" flags, run with
+// "issue list --view NAME" or "view NAME". Empty fields keep the flag's
+// default; flags given on the command line override the view.
+type ViewConfig struct {
+	Name string `yaml:"name"`
+	// Status, Label, Cycle, User and Sort take the same values as the
+	// matching "issue list" flags.
+	Status string `yaml:"status"`
+	Label  string `yaml:"label"`
+	Cycle  string `yaml:"cycle"`
+	User   string `yaml:"user"`
+	Sort   string `yaml:"sort"`
+	// Columns is a --column spec, e.g. "id,status,title" or "+updated".
+	Columns string `yaml:"columns"`
+	// Limit is the maximum number of issues; 0 keeps the default.
+	Limit int `yaml:"limit"`
+}
+
+// View returns the view with the given name. It is safe to call on a nil
+// Config.
+func (c *Config) View(name string) (ViewConfig, bool) {
+	if c == nil {
+		return ViewConfig{}, false
+	}
+	for _, v := range c.Views {
+		if v.Name == name {
+			return v, true
+		}
+	}
+	return ViewConfig{}, false
+}
+
+// ViewNames returns the names of the configured views in config order. It is
+// safe to call on a nil Config.
+func (c *Config) ViewNames() []string {
+	if c == nil {
+		return nil
+	}
+	names := make([]string, len(c.Views))
+	for i, v := range c.Views {
+		names[i] = v.Name
+	}
+	return names
+}
+
+// PromptConfig is a named Markdown template for "issue prompt". It receives
+// prompt.ContextData: the issue's fields, unquoted, plus its sub-issues,
+// comments and acceptance criteria. The prompt named "default" replaces the
+// built-in template.
+type PromptConfig struct {
+	Name     string `yaml:"name"`
+	Template string `yaml:"template"`
+}
+
+// Prompt returns the prompt with the given name. It is safe to call on a
+// nil Config.
+func (c *Config) Prompt(name string) (PromptConfig, bool) {
+	if c == nil {
+		return PromptConfig{}, false
+	}
+	for _, p := range c.Prompts {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return PromptConfig{}, false
+}
+
+// PromptNames returns the names of the configured prompts in config order.
+// It is safe to call on a nil Config.
+func (c *Config) PromptNames() []string {
+	if c == nil {
+		return nil
+	}
+	names := make([]string, len(c.Prompts))
+	for i, p := range c.Prompts {
+		names[i] = p.Name
+	}
+	return names
+}
+
+// Command returns the interactive command with the given name. It is safe
+// to call on a nil Config.
+func (c *Config) Command(name string) (Command, bool) {
+	if c == nil {
+		return Command{}, false
+	}
+	for _, cmd := range c.Interactive.Commands {
+		if cmd.Name == name {
+			return cmd, true
+		}
+	}
+	return Command{}, false
+}
+
+// CommandNames returns the names of the interactive commands in config
+// order. It is safe to call on a nil Config.
+func (c *Config) CommandNames() []string {
+	if c == nil {
+		return nil
+	}
+	names := make([]string, len(c.Interactive.Commands))
+	for i, cmd := range c.Interactive.Commands {
+		names[i] = cmd.Name
+	}
+	return names
+}
+
+// ColumnConfig defines an extra column for "issue list" (see prompt.Column).
+// Templates receive the issue's prompt.IssueData.
+type ColumnConfig struct {
+	Name     string `yaml:"name"`
+	Header   string `yaml:"header"`
+	Template string `yaml:"template"`
+	// Color is a color name, "state", "priority", or a template rendering one.
+	Color string `yaml:"color"`
+	// Width truncates longer values; 0 means no limit.
+	Width int `yaml:"width"`
 }
 
 // InteractiveConfig holds settings for interactive (fzf) mode.
 type InteractiveConfig struct {
 	Commands []Command `yaml:"commands"`
+	// Bindings maps fzf keys (e.g. "alt-o") to browser actions: a built-in
+	// action such as "edit" or "open", "command:NAME" to run a command
+	// directly, or "none" to remove a default binding.
+	Bindings map[string]string `yaml:"bindings"`
+	// Backend is the program showing the issue browser and pickers:
+	// BackendFzf or BackendBuiltin. Empty uses fzf when it is installed.
+	Backend string `yaml:"backend"`
+}
+
+// Interactive backends.
+const (
+	// BackendFzf runs the external fzf program.
+	BackendFzf = "fzf"
+	// BackendBuiltin uses the terminal UI built into the CLI.
+	BackendBuiltin = "builtin"
+)
+
+// WorktreeConfig holds settings for "issue worktree".
+type WorktreeConfig struct {
+	// Path is a Go template for the worktree directory. Relative paths are
+	// resolved against the repository root. Empty uses DefaultWorktreePath.
+	Path string `yaml:"path"`
+	// Remote is the git remote to fetch the base branch from. Empty uses "origin".
+	Remote string `yaml:"remote"`
+	// BaseBranch is the branch new issue branches start from. Empty means
+	// auto-detect the remote's default branch (falling back to "main").
+	BaseBranch string `yaml:"base_branch"`
+	// Track controls whether new branches track the base branch upstream.
+	// Nil leaves git's branch.autoSetupMerge behavior unchanged.
+	Track *bool `yaml:"track"`
+	// PostCreate lists hooks run in the new worktree after it is created.
+	// Nil uses DefaultPostCreateHooks; an empty list disables all hooks.
+	PostCreate []Hook `yaml:"post_create"`
 }
 
+// Hook is a command run after a worktree is created. Exactly one of Command
+// or Builtin should be set.
+type Hook struct {
+	// Name is shown while the hook runs. Empty uses the command itself.
+	Name string `yaml:"name"`
+	// Command is a shell command using Go template syntax for issue fields.
+	Command string `yaml:"command"`
+	// Builtin names a hook shipped with the CLI (see BuiltinHooks).
+	Builtin string `yaml:"builtin"`
+	// OnFailure is one of HookAbort (default), HookWarn or HookIgnore.
+	OnFailure string `yaml:"on_failure"`
+}
+
+// Hook failure policies.
+const (
+	// HookAbort stops running hooks and fails the command.
+	HookAbort = "abort"
+	// HookWarn prints a warning and continues with the next hook.
+	HookWarn = "warn"
+	// HookIgnore silently continues with the next hook.
+	HookIgnore = "ignore"
+)
+
+// BuiltinHooks maps built-in hook names to their shell commands.
+var BuiltinHooks = map[string]string{
+	"mise-trust": "if command -v mise >/dev/null 2>&1; then mise trust; fi",
+}
+
+// DefaultPostCreateHooks are used when worktree.post_create is not set.
+var DefaultPostCreateHooks = []Hook{{Builtin: "mise-trust"}}
+
+// GitConfig holds settings for the "git" commands.
+type GitConfig struct {
+	CommitMsg CommitMsgConfig `yaml:"commit_msg"`
+}
+
+// CommitMsgConfig controls how the commit-msg hook links commits to issues.
+type CommitMsgConfig struct {
+	// Mode is where the branch's issue identifier is added when the message
+	// doesn't mention it: CommitMsgPrefix (default), CommitMsgAppend or
+	// CommitMsgNone.
+	Mode string `yaml:"mode"`
+	// MagicWord, when set (e.g. "Fixes"), adds a "<MagicWord> AIS-42" line so
+	// that merging the commit closes the issue.
+	MagicWord string `yaml:"magic_word"`
+	// Validate checks that issue identifiers in the message exist. Nil means true.
+	Validate *bool `yaml:"validate"`
+}
+
+// Commit message modes.
+const (
+	// CommitMsgPrefix adds "AIS-42: " before the subject line.
+	CommitMsgPrefix = "prefix"
+	// CommitMsgAppend adds " (AIS-42)" after the subject line.
+	CommitMsgAppend = "append"
+	// CommitMsgNone leaves the subject line unchanged.
+	CommitMsgNone = "none"
+)
+
+// WorkflowConfig maps git activity to workflow state transitions. Each field
+// names the state (e.g. "In Progress") an issue moves to when the event
+// happens; empty disables the transition. Issues are never moved backwards.
+type WorkflowConfig struct {
+	// Worktree applies when "issue worktree" creates a worktree for the issue.
+	Worktree string `yaml:"worktree"`
+	// Checkout applies when the post-checkout hook sees an issue branch checked out.
+	Checkout string `yaml:"checkout"`
+	// PRCreate applies when "pr create" opens a pull request for the issue.
+	PRCreate string `yaml:"pr_create"`
+}
+
+// PRConfig holds settings for "pr create".
+type PRConfig struct {
+	// Title is a Go template for the pull request title. Empty uses DefaultPRTitle.
+	Title string `yaml:"title"`
+	// Body is a Go template for the pull request body. Empty uses DefaultPRBody.
+	Body string `yaml:"body"`
+	// Base is the branch the pull request merges into. Empty falls back to
+	// worktree.base_branch, then to the repository's default branch.
+	Base string `yaml:"base"`
+	// Draft opens pull requests as drafts.
+	Draft bool `yaml:"draft"`
+}
+
+// DefaultPRTitle is the pull request title template used when pr.title is unset.
+const DefaultPRTitle = "{{.Identifier}}: {{.Title}}"
+
+// DefaultPRBody is the pull request body template used when pr.body is unset.
+const DefaultPRBody = `{{with .Description}}{{.}}
+
+{{end}}---
+Linear: [{{.Identifier}}]({{.URL}})
+{{- with .Parent}}
+Parent: {{.}}{{end}}
+{{- with .Labels}}
+Labels: {{join . ", "}}{{end}}
+`
+
+// DefaultWorktreePath places worktrees at <parent-of-repo>/<lowercase-id>/<repo-name>.
+const DefaultWorktreePath = "{{.RepoParent}}/{{lower .Identifier}}/{{.RepoName}}"
+
+// DefaultWorktreeRemote is the remote used when worktree.remote is unset.
+const DefaultWorktreeRemote = "origin"
+
 // Load reads config from $XDG_CONFIG_HOME/linear/config.yaml.
 // If the file does not exist, default values are returned (not an error).
 // configDir overrides the config directory resolution; nil uses os.UserConfigDir.
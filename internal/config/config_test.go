@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 )
 
@@ -95,3 +96,216 @@ func TestLoad_EmptyCommands(t *testing.T) {
 		t.Fatalf("got %d commands, want 0", len(cfg.Interactive.Commands))
 	}
 }
+
+func TestLoad_Worktree(t *testing.T) {
+	dir := t.TempDir()
+	configDir := filepath.Join(dir, "linear")
+	if err := os.MkdirAll(configDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	content := `worktree:
+  path: "~/worktrees/{{lower .Identifier}}"
+  remote: upstream
+  base_branch: develop
+  track: true
+`
+	if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load(func() (string, error) { return dir, nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wt := cfg.Worktree
+	if wt.Path != "~/worktrees/{{lower .Identifier}}" {
+		t.Errorf("Path = %q", wt.Path)
+	}
+	if wt.Remote != "upstream" || wt.BaseBranch != "develop" {
+		t.Errorf("Remote/BaseBranch = %q/%q, want upstream/develop", wt.Remote, wt.BaseBranch)
+	}
+	if wt.Track == nil || !*wt.Track {
+		t.Errorf("Track = %v, want true", wt.Track)
+	}
+}
+
+func TestLoad_WorktreePostCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []Hook
+		wantNil bool
+	}{
+		{
+			name: "hooks",
+			content: `worktree:
+  post_create:
+    - builtin: mise-trust
+    - name: Install
+      command: npm install
+      on_failure: warn
+`,
+			want: []Hook{{Builtin: "mise-trust"}, {Name: "Install", Command: "npm install", OnFailure: HookWarn}},
+		},
+		{name: "empty list disables hooks", content: "worktree:\n  post_create: []\n", want: []Hook{}},
+		{name: "unset", content: "worktree:\n  remote: origin\n", wantNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			configDir := filepath.Join(dir, "linear")
+			if err := os.MkdirAll(configDir, 0700); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(tt.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg, err := Load(func() (string, error) { return dir, nil })
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := cfg.Worktree.PostCreate
+			if tt.wantNil {
+				if got != nil {
+					t.Errorf("PostCreate = %+v, want nil", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("PostCreate = nil, want non-nil")
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PostCreate = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoad_Columns(t *testing.T) {
+	dir := t.TempDir()
+	configDir := filepath.Join(dir, "linear")
+	if err := os.MkdirAll(configDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	content := `columns:
+  - name: age
+    header: AGE
+    template: "{{daysSince .UpdatedAt}}d"
+    color: gray
+    width: 5
+`
+	if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load(func() (string, error) { return dir, nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := ColumnConfig{Name: "age", Header: "AGE", Template: "{{daysSince .UpdatedAt}}d", Color: "gray", Width: 5}
+	if len(cfg.Columns) != 1 || cfg.Columns[0] != want {
+		t.Errorf("Columns = %+v, want [%+v]", cfg.Columns, want)
+	}
+}
+
+func TestLoad_Views(t *testing.T) {
+	dir := t.TempDir()
+	configDir := filepath.Join(dir, "linear")
+	if err := os.MkdirAll(configDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	content := `views:
+  - name: triage
+    status: triage,backlog
+    label: bug+frontend
+    cycle: all
+    sort: priority
+    columns: +updated
+    limit: 20
+  - name: next
+    cycle: next
+`
+	if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load(func() (string, error) { return dir, nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := ViewConfig{Name: "triage", Status: "triage,backlog", Label: "bug+frontend", Cycle: "all", Sort: "priority", Columns: "+updated", Limit: 20}
+	if got, ok := cfg.View("triage"); !ok || got != want {
+		t.Errorf("View(triage) = %+v, %v; want %+v", got, ok, want)
+	}
+	if _, ok := cfg.View("missing"); ok {
+		t.Error("View(missing) should not be found")
+	}
+	if got := cfg.ViewNames(); len(got) != 2 || got[0] != "triage" || got[1] != "next" {
+		t.Errorf("ViewNames() = %v, want [triage next]", got)
+	}
+}
+
+func TestConfig_View_NilConfig(t *testing.T) {
+	var cfg *Config
+	if _, ok := cfg.View("triage"); ok {
+		t.Error("View on nil config should not be found")
+	}
+	if names := cfg.ViewNames(); names != nil {
+		t.Errorf("ViewNames on nil config = %v, want nil", names)
+	}
+}
+
+func TestConfig_Command(t *testing.T) {
+	cfg := &Config{Interactive: InteractiveConfig{Commands: []Command{
+		{Name: "Claude", Command: "claude {{.Title}}", Exec: true},
+		{Name: "Open", Command: "xdg-open {{.Raw.URL}}"},
+	}}}
+	if got, ok := cfg.Command("Open"); !ok || got.Command != "xdg-open {{.Raw.URL}}" {
+		t.Errorf("Command(Open) = %+v, %v", got, ok)
+	}
+	if _, ok := cfg.Command("missing"); ok {
+		t.Error("Command(missing) should not be found")
+	}
+	if got := cfg.CommandNames(); len(got) != 2 || got[0] != "Claude" || got[1] != "Open" {
+		t.Errorf("CommandNames() = %v, want [Claude Open]", got)
+	}
+
+	var nilCfg *Config
+	if _, ok := nilCfg.Command("Claude"); ok {
+		t.Error("Command on nil config should not be found")
+	}
+	if names := nilCfg.CommandNames(); names != nil {
+		t.Errorf("CommandNames on nil config = %v, want nil", names)
+	}
+}
+
+func TestLoad_Defaults(t *testing.T) {
+	dir := t.TempDir()
+	configDir := filepath.Join(dir, "linear")
+	if err := os.MkdirAll(configDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	content := `defaults:
+  issue list:
+    sort: priority
+    limit: 20
+  user list:
+    include-bots: true
+`
+	if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load(func() (string, error) { return dir, nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := cfg.Defaults["issue list"]; got["sort"] != "priority" || got["limit"] != "20" {
+		t.Errorf(`Defaults["issue list"] = %v, want sort=priority limit=20`, got)
+	}
+	if got := cfg.Defaults["user list"]["include-bots"]; got != "true" {
+		t.Errorf(`Defaults["user list"]["include-bots"] = %q, want "true"`, got)
+	}
+}
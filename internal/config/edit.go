@@ -0,0 +1,143 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SetAlias sets aliases.<name> to expansion in the config file at path,
+// creating the file and the aliases section if needed. The rest of the file,
+// including comments, is kept.
+func SetAlias(path, name, expansion string) error {
+	return updateFile(path, func(doc *yaml.Node) error {
+		section, err := mappingSection(doc, "aliases", true)
+		if err != nil {
+			return err
+		}
+		value := &yaml.Node{Kind: yaml.ScalarNode, Value: expansion}
+		if i := mappingIndex(section, name); i >= 0 {
+			section.Content[i+1] = value
+			return nil
+		}
+		key := &yaml.Node{Kind: yaml.ScalarNode, Value: name}
+		section.Content = append(section.Content, key, value)
+		return nil
+	})
+}
+
+// DeleteAlias removes aliases.<name> from the config file at path. It
+// reports whether the alias existed.
+func DeleteAlias(path, name string) (bool, error) {
+	found := false
+	err := updateFile(path, func(doc *yaml.Node) error {
+		section, err := mappingSection(doc, "aliases", false)
+		if err != nil {
+			return err
+		}
+		i := -1
+		if section != nil {
+			i = mappingIndex(section, name)
+		}
+		if i < 0 {
+			return errUnchanged
+		}
+		section.Content = append(section.Content[:i], section.Content[i+2:]...)
+		found = true
+		return nil
+	})
+	return found, err
+}
+
+// errUnchanged is returned by an updateFile edit to skip writing the file.
+var errUnchanged = errors.New("unchanged")
+
+// updateFile parses the YAML file at path (a missing or empty file is an
+// empty document), applies edit to the document node and writes it back,
+// unless edit returns errUnchanged.
+func updateFile(path string, edit func(doc *yaml.Node) error) error {
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("reading config file: %w", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("parsing config file: %w", err)
+	}
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, HeadComment: string(bytes.TrimSpace(data))}
+	}
+	if len(doc.Content) == 0 {
+		doc.Content = []*yaml.Node{{Kind: yaml.MappingNode}}
+	}
+	if doc.Content[0].Kind != yaml.MappingNode {
+		return fmt.Errorf("config file: top level must be a mapping")
+	}
+
+	if err := edit(&doc); err != nil {
+		if errors.Is(err, errUnchanged) {
+			return nil
+		}
+		return err
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return fmt.Errorf("encoding config file: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("encoding config file: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating config directory: %w", err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("writing config file: %w", err)
+	}
+	return nil
+}
+
+// mappingSection returns the mapping under the top-level key of doc. When
+// the key is missing (or null) it is added if create is true, otherwise nil
+// is returned.
+func mappingSection(doc *yaml.Node, key string, create bool) (*yaml.Node, error) {
+	root := doc.Content[0]
+	if i := mappingIndex(root, key); i >= 0 {
+		section := root.Content[i+1]
+		switch {
+		case section.Kind == yaml.MappingNode:
+			return section, nil
+		case section.Kind == yaml.ScalarNode && section.Tag == "!!null":
+			if !create {
+				return nil, nil
+			}
+			*section = yaml.Node{Kind: yaml.MappingNode}
+			return section, nil
+		default:
+			return nil, fmt.Errorf("config file: %s must be a mapping (line %d)", key, section.Line)
+		}
+	}
+	if !create {
+		return nil, nil
+	}
+	section := &yaml.Node{Kind: yaml.MappingNode}
+	root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, section)
+	return section, nil
+}
+
+// mappingIndex returns the index of key's key node in mapping m, or -1.
+func mappingIndex(m *yaml.Node, key string) int {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,148 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSetAlias(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial string
+		want    []string
+	}{
+		{
+			name:    "missing file",
+			initial: "",
+			want:    []string{"aliases:\n  bugs: issue list --label bug\n"},
+		},
+		{
+			name:    "comment-only file",
+			initial: string(DefaultConfigContent),
+			want:    []string{"# Linear CLI configuration", "aliases:\n  bugs: issue list --label bug\n"},
+		},
+		{
+			name:    "keeps other settings and comments",
+			initial: "# my config\npr:\n  draft: true # always\naliases:\n  mine: issue list --user $1\n",
+			want:    []string{"# my config", "draft: true # always", "mine: issue list --user $1", "bugs: issue list --label bug"},
+		},
+		{
+			name:    "replaces existing alias",
+			initial: "aliases:\n  bugs: issue list\n",
+			want:    []string{"aliases:\n  bugs: issue list --label bug\n"},
+		},
+		{
+			name:    "null section",
+			initial: "aliases:\n",
+			want:    []string{"aliases:\n  bugs: issue list --label bug\n"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "linear", "config.yaml")
+			if tt.initial != "" {
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(tt.initial), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := SetAlias(path, "bugs", "issue list --label bug"); err != nil {
+				t.Fatalf("SetAlias: %v", err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(data), want) {
+					t.Errorf("config file missing %q:\n%s", want, data)
+				}
+			}
+			if strings.Count(string(data), "bugs:") != 1 {
+				t.Errorf("expected exactly one bugs alias:\n%s", data)
+			}
+		})
+	}
+}
+
+func TestSetAlias_QuotesSpecialValues(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := SetAlias(path, "open", "!xdg-open $1"); err != nil {
+		t.Fatalf("SetAlias: %v", err)
+	}
+	cfg, err := loadFile(t, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.Aliases["open"]; got != "!xdg-open $1" {
+		t.Errorf("alias round-trip = %q, want %q", got, "!xdg-open $1")
+	}
+}
+
+func TestSetAlias_InvalidSection(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("aliases: [a, b]\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	err := SetAlias(path, "bugs", "issue list")
+	if err == nil || !strings.Contains(err.Error(), "aliases must be a mapping") {
+		t.Fatalf("expected mapping error, got %v", err)
+	}
+}
+
+func TestDeleteAlias(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	initial := "# top\naliases:\n  bugs: issue list --label bug\n  mine: issue list --user $1\n"
+	if err := os.WriteFile(path, []byte(initial), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := DeleteAlias(path, "bugs")
+	if err != nil || !found {
+		t.Fatalf("DeleteAlias(bugs) = %v, %v; want true, nil", found, err)
+	}
+	data, _ := os.ReadFile(path)
+	if strings.Contains(string(data), "bugs") || !strings.Contains(string(data), "mine:") || !strings.Contains(string(data), "# top") {
+		t.Errorf("unexpected config after delete:\n%s", data)
+	}
+
+	found, err = DeleteAlias(path, "bugs")
+	if err != nil || found {
+		t.Errorf("second DeleteAlias(bugs) = %v, %v; want false, nil", found, err)
+	}
+}
+
+func TestDeleteAlias_MissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	found, err := DeleteAlias(path, "bugs")
+	if err != nil || found {
+		t.Errorf("DeleteAlias on missing file = %v, %v; want false, nil", found, err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("DeleteAlias should not create the config file, stat err = %v", err)
+	}
+}
+
+// loadFile loads the config at path through Load.
+func loadFile(t *testing.T, path string) (*Config, error) {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "linear"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "linear", "config.yaml"), data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return Load(func() (string, error) { return dir, nil })
+}
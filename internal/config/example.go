@@ -9,13 +9,18 @@ import (
 // ExampleContent is the canonical config.example.yaml content.
 var ExampleContent = []byte(`# Linear CLI configuration
 #
-# Commands are available via ctrl-o in interactive mode (linear issue list).
+# Commands are available via ctrl-o in interactive mode (linear issue list),
+# and from scripts with "linear run NAME ID" (--print shows the shell only).
 # They receive the selected issue's data as Go template fields.
 #
 # Set exec: true on a command to exit fzf and replace the process with the
 # command (useful for long-running sessions like Claude). Without exec, the
 # command runs inside fzf and returns to the issue list when done.
 #
+# Select several issues with tab: the command then runs once per issue, or
+# once for all of them with multi: true, where {{.Identifiers}} holds every
+# identifier and {{range .Issues}} iterates over the issues.
+#
 # All fields are shell-quoted by default (safe for use in shell commands).
 # Use {{.Raw.Field}} to get the unquoted value for display-only contexts.
 #
@@ -35,13 +40,22 @@ var ExampleContent = []byte(`# Linear CLI configuration
 #   {{.Labels}}      - Label names (slice)
 #   {{.DueDate}}     - Due date string
 #   {{.Parent}}      - Parent issue identifier
+#   {{.ParentTitle}}, {{.Children}} (sub-issue identifiers), {{.Estimate}},
+#   {{.AssigneeEmail}}, {{.CreatedAt}}, {{.UpdatedAt}}, {{.CycleNumber}},
+#   {{.CycleStartsAt}}, {{.CycleEndsAt}}, {{.CommentCount}} (up to 250), and
+#   the current {{.RepoRoot}} and the issue's {{.WorktreePath}}
+#
+# Helper funcs keep values quoted: lower, slug, trunc, join, default, date,
+# env and shellquote, e.g. {{.Title | slug | trunc 40}} or
+# {{.Assignee | default "nobody"}}.
 
 interactive:
   commands:
-    # Ask Claude Code to work on the issue (exec: exits fzf first)
+    # Ask Claude Code to work on the issue (exec: exits fzf first), passing
+    # the Markdown context rendered by "linear issue prompt" (see prompts:)
     - name: "Claude"
       exec: true
-      command: "claude {{.Title}}"
+      command: "claude \"$(linear issue prompt {{.Identifier}})\""
 
     # Open the issue in your browser
     - name: "Open in browser"
@@ -57,14 +71,26 @@ interactive:
       exec: true
       command: "git worktree add ../{{.Raw.BranchName}} -b {{.Raw.BranchName}} 2>/dev/null || git worktree add ../{{.Raw.BranchName}} {{.Raw.BranchName}}"
 
+    # Open all selected issues in your browser at once
+    - name: "Open all in browser"
+      multi: true
+      command: "for url in{{range .Issues}} {{.URL}}{{end}}; do xdg-open \"$url\"; done"
+
+    # Show the branch's recent commits in the preview pane with alt-g
+    # (key binds a command directly; also: confirm, reload and silent)
+    - name: "Branch log"
+      key: alt-g
+      output: preview
+      command: "git log --oneline -10 {{.Raw.BranchName}}"
+
     # Copy the issue identifier to clipboard (Linux)
     - name: "Copy ID"
       command: "printf '%s' {{.Identifier}} | xclip -selection clipboard && echo Copied {{.Identifier}}"
 
-    # Create a PR linking back to the Linear issue
+    # Push the issue branch and open a PR linked back to the Linear issue
     - name: "Create PR"
       exec: true
-      command: "gh pr create --title {{.Title}} --body {{.URL}}"
+      command: "linear pr create {{.Identifier}}"
 
     # Start a commit message pre-filled with the issue ID
     - name: "Commit"
@@ -97,6 +123,136 @@ interactive:
         printf 'Labels:      %s\n' {{.Labels}}
         printf 'DueDate:     %s\n' {{.DueDate}}
         printf 'Parent:      %s\n' {{.Parent}}; } | less
+
+  # Keys of the issue browser. Defaults: ctrl-e edit, ctrl-y switch-cycle,
+  # ctrl-v switch-view (with views), ctrl-o command, and shift-right and
+  # shift-left next-preview and prev-preview (preview tabs). Other actions:
+  # open, copy-id, comment, assign-me and status; "command:NAME" runs a
+  # command above directly and "none" removes a default.
+  # bindings:
+  #   alt-o: open
+  #   alt-c: copy-id
+  #   alt-m: comment
+  #   alt-a: assign-me
+  #   alt-s: status
+  #   alt-l: "command:Claude"
+
+  # Program showing the issue browser and pickers: fzf, or builtin for the
+  # terminal UI built into linear. Default: fzf when installed, else builtin.
+  # backend: builtin
+
+# Settings for "linear issue worktree".
+# worktree:
+#   # Go template for the worktree directory (not shell-quoted). Extra fields: {{.RepoRoot}},
+#   # {{.RepoName}}, {{.RepoParent}}. Relative paths resolve against the repo.
+#   path: "{{.RepoParent}}/{{lower .Identifier}}/{{.RepoName}}"
+#   # Remote to fetch the base branch from
+#   remote: origin
+#   # Base branch for new issue branches (default: the remote's default branch)
+#   base_branch: main
+#   # Whether new branches track the base branch (default: git's behavior)
+#   track: false
+#   # Commands run in the new worktree after it is created. Templates work
+#   # like interactive commands (shell-quoted fields). $LINEAR_REPO_ROOT,
+#   # $LINEAR_WORKTREE, $LINEAR_ISSUE and $LINEAR_BRANCH are also set.
+#   # on_failure: abort (default), warn or ignore. Default: [builtin: mise-trust]
+#   post_create:
+#     - builtin: mise-trust
+#     - name: "Copy .env"
+#       command: "cp \"$LINEAR_REPO_ROOT/.env\" ."
+#       on_failure: warn
+#     - command: "npm install"
+
+# Settings for the commit-msg hook installed by "linear git install-hooks".
+# git:
+#   commit_msg:
+#     # Where to add the branch's issue ID when the message doesn't mention it:
+#     # prefix ("AIS-42: subject", default), append ("subject (AIS-42)") or none
+#     mode: prefix
+#     # Add a "Fixes AIS-42" line so merging closes the issue (default: off)
+#     magic_word: Fixes
+#     # Reject messages referencing issues that don't exist (default: true)
+#     validate: true
+
+# Move issues to a workflow state when git activity happens. Values are state
+# names of the issue's team. Issues are never moved backwards. Skip a single
+# transition with --no-transition.
+# workflow:
+#   worktree: "In Progress"   # linear issue worktree
+#   checkout: "In Progress"   # git checkout of an issue branch (post-checkout hook)
+#   pr_create: "In Review"    # linear pr create
+
+# Settings for "linear pr create". Templates use the issue fields above
+# (not shell-quoted) plus the join function for {{.Labels}}.
+# pr:
+#   title: "{{.Identifier}}: {{.Title}}"
+#   body: |
+#     {{.Description}}
+#
+#     Fixes {{.Identifier}}
+#   # Branch to merge into (default: worktree.base_branch, then the repo default)
+#   base: main
+#   draft: true
+
+# Extra columns for "linear issue list --column". Templates receive the fields
+# of command templates ({{.Identifier}}, {{.UpdatedAt}}, {{.Parent}}, ...) and
+# can use the --template funcs plus daysSince.
+# color: a color name (red, green, yellow, cyan, gray, bold), "state",
+# "priority", or a template that renders one. width truncates long values.
+# columns:
+#   - name: age
+#     header: AGE
+#     template: "{{daysSince .UpdatedAt}}d"
+#     color: '{{if gt (daysSince .UpdatedAt) 14}}red{{else}}gray{{end}}'
+#   - name: parent
+#     template: "{{.Parent}}"
+
+# Saved "issue list" filters. Run with "linear view triage" or
+# "linear issue list --view triage"; flags on the command line override the
+# view. Press ctrl-v in interactive mode to switch views.
+# views:
+#   - name: triage
+#     status: triage,backlog
+#     label: bug+frontend
+#     cycle: all
+#     sort: priority
+#     columns: +updated
+#     limit: 20
+#   - name: next
+#     status: started,todo
+#     cycle: next
+
+# Markdown templates for "linear issue prompt [NAME] ID", which hands an issue
+# to coding assistants. Fields are not shell-quoted. Besides the command fields
+# above: {{range .SubIssues}} ({{.Identifier}}, {{.Title}}, {{.State}}),
+# {{range .Comments}} ({{.Author}}, {{.CreatedAt}}, {{.Body}}) and
+# {{.AcceptanceCriteria}}, the list items of the description's "Acceptance
+# criteria" section. A prompt named "default" replaces the built-in template.
+# prompts:
+#   - name: review
+#     template: |
+#       Review the changes on branch {{.BranchName}} against {{.Identifier}}:
+#       {{.Title}}
+#
+#       {{range .AcceptanceCriteria}}- [ ] {{.}}
+#       {{end}}
+
+# Default flag values per command, keyed by command path. Flags given on the
+# command line (and views) take precedence; --help shows the new defaults.
+# defaults:
+#   issue list:
+#     sort: priority
+#     column: +updated
+#   user list:
+#     include-bots: true
+
+# Command aliases, run as "linear <name> [args]". $1, $2, ... are replaced by
+# positional arguments; remaining arguments are appended. Expansions starting
+# with "!" run in sh with the arguments as "$@". Manage with "linear alias".
+# aliases:
+#   bugs: issue list --label bug --status '!completed' --cycle all
+#   mine: issue list --user $1 --sort priority
+#   open: '!linear issue get "$1" --output json | jq -r .url | xargs xdg-open'
 `)
 
 // DefaultConfigContent is written to config.yaml when it doesn't exist yet.
@@ -0,0 +1,128 @@
+package config
+
+import (
+	"encoding/json"
+	"maps"
+	"reflect"
+	"slices"
+)
+
+// _schemaDescriptions documents config fields in the JSON Schema, keyed by
+// Go type name and YAML key.
+var _schemaDescriptions = map[string]string{
+	"Config.interactive":         "Settings for interactive (fzf) mode.",
+	"Config.worktree":            `Settings for "linear issue worktree".`,
+	"Config.git":                 `Settings for the git hooks installed by "linear git install-hooks".`,
+	"Config.workflow":            "Workflow states issues move to when git activity happens.",
+	"Config.pr":                  `Settings for "linear pr create".`,
+	"Config.columns":             `Extra columns for "linear issue list --column".`,
+	"Config.views":               `Saved "issue list" filters, run with "linear view NAME".`,
+	"Config.prompts":             `Markdown templates for "linear issue prompt NAME ID".`,
+	"Config.defaults":            `Flag defaults per command path (e.g. "issue list"), keyed by flag name.`,
+	"Config.aliases":             `Command aliases run as "linear NAME". "$1" takes a positional argument; "!" runs in sh.`,
+	"InteractiveConfig.commands": "Commands offered by ctrl-o, rendered with the selected issue's fields.",
+	"InteractiveConfig.bindings": `Keys mapped to actions: edit, switch-cycle, switch-view, command, open, copy-id, comment, assign-me, status, next-preview, prev-preview, "command:NAME" or "none".`,
+	"InteractiveConfig.backend":  "Program showing the issue browser and pickers (default: fzf when installed, else builtin).",
+	"Command.name":               "Name shown in the command picker.",
+	"Command.command":            "Shell command; a Go template with shell-quoted issue fields.",
+	"Command.exec":               "Exit fzf and replace the process with the command.",
+	"Command.key":                `An fzf key running the command directly, e.g. "alt-c".`,
+	"Command.confirm":            "Ask y/N before running the command.",
+	"Command.reload":             "Refresh the issue list after the command.",
+	"Command.silent":             "Run a key-bound command without handing it the terminal.",
+	"Command.output":             "Where the command's output goes (default terminal).",
+	"Command.multi":              "Run once for all selected issues ({{.Identifiers}}, {{.Issues}}) instead of once per issue.",
+	"WorktreeConfig.path":        "Go template for the worktree directory.",
+	"WorktreeConfig.remote":      `Remote to fetch the base branch from (default "origin").`,
+	"WorktreeConfig.base_branch": "Branch new issue branches start from (default: the remote's default branch).",
+	"WorktreeConfig.track":       "Whether new branches track the base branch.",
+	"WorktreeConfig.post_create": "Hooks run in a new worktree.",
+	"Hook.name":                  "Name shown while the hook runs.",
+	"Hook.command":               "Shell command; a Go template with shell-quoted issue fields.",
+	"Hook.builtin":               "A hook shipped with the CLI.",
+	"Hook.on_failure":            "What to do when the hook fails (default abort).",
+	"CommitMsgConfig.mode":       "Where to add the branch's issue ID (default prefix).",
+	"CommitMsgConfig.magic_word": `Adds a "<magic_word> ID" line, e.g. "Fixes".`,
+	"CommitMsgConfig.validate":   "Reject messages referencing issues that don't exist (default true).",
+	"WorkflowConfig.worktree":    `State to move to on "linear issue worktree".`,
+	"WorkflowConfig.checkout":    "State to move to when an issue branch is checked out.",
+	"WorkflowConfig.pr_create":   `State to move to on "linear pr create".`,
+	"PRConfig.title":             "Go template for the pull request title.",
+	"PRConfig.body":              "Go template for the pull request body.",
+	"PRConfig.base":              "Branch the pull request merges into.",
+	"PRConfig.draft":             "Open pull requests as drafts.",
+	"ColumnConfig.name":          "Name used with --column.",
+	"ColumnConfig.header":        "Table header (default: the upper-cased name).",
+	"ColumnConfig.template":      "Go template rendering the cell from the issue list node.",
+	"ColumnConfig.color":         `A color name, "state", "priority", or a template rendering one.`,
+	"ColumnConfig.width":         "Truncate longer values (0: no limit).",
+	"ViewConfig.name":            "Name used with --view and \"linear view\".",
+	"ViewConfig.columns":         `A --column spec, e.g. "id,status,title" or "+updated".`,
+	"ViewConfig.limit":           "Maximum number of issues (0: the default).",
+	"PromptConfig.name":          `Name used with "linear issue prompt"; "default" replaces the built-in template.`,
+	"PromptConfig.template":      "Go template rendering the Markdown document from the issue's context.",
+}
+
+// _schemaEnums lists the valid values of enum fields, keyed like
+// _schemaDescriptions.
+var _schemaEnums = map[string][]string{
+	"Command.output":            {OutputTerminal, OutputPreview},
+	"InteractiveConfig.backend": {BackendFzf, BackendBuiltin},
+	"Hook.builtin":              slices.Sorted(maps.Keys(BuiltinHooks)),
+	"Hook.on_failure":           {HookAbort, HookWarn, HookIgnore},
+	"CommitMsgConfig.mode":      {CommitMsgPrefix, CommitMsgAppend, CommitMsgNone},
+}
+
+// _schemaRequired lists the required fields of list items, by Go type name.
+var _schemaRequired = map[string][]string{
+	"Command":      {"name", "command"},
+	"ViewConfig":   {"name"},
+	"ColumnConfig": {"name", "template"},
+	"PromptConfig": {"name", "template"},
+}
+
+// Schema returns a JSON Schema (draft 2020-12) describing config.yaml, for
+// editor completion and validation, e.g. with the YAML language server.
+func Schema() ([]byte, error) {
+	schema := schemaFor(reflect.TypeFor[Config]())
+	schema["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	schema["title"] = "linear CLI configuration"
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+// schemaFor returns the JSON Schema of Go type t.
+func schemaFor(t reflect.Type) map[string]any {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		props := make(map[string]any)
+		for _, f := range yamlFields(t) {
+			prop := schemaFor(t.Field(f.index).Type)
+			key := t.Name() + "." + f.name
+			if d, ok := _schemaDescriptions[key]; ok {
+				prop["description"] = d
+			}
+			if e, ok := _schemaEnums[key]; ok {
+				prop["enum"] = e
+			}
+			props[f.name] = prop
+		}
+		s := map[string]any{"type": "object", "properties": props, "additionalProperties": false}
+		if req, ok := _schemaRequired[t.Name()]; ok {
+			s["required"] = req
+		}
+		return s
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": schemaFor(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": schemaFor(t.Elem())}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int64:
+		return map[string]any{"type": "integer", "minimum": 0}
+	default:
+		return map[string]any{"type": "string"}
+	}
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestSchema(t *testing.T) {
+	data, err := Schema()
+	if err != nil {
+		t.Fatalf("Schema() returned error: %v", err)
+	}
+
+	var schema struct {
+		Properties           map[string]json.RawMessage `json:"properties"`
+		AdditionalProperties bool                       `json:"additionalProperties"`
+	}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("schema is not valid JSON: %v", err)
+	}
+	if schema.AdditionalProperties {
+		t.Error("unknown top-level fields should be rejected")
+	}
+	for _, f := range yamlFields(reflect.TypeFor[Config]()) {
+		if _, ok := schema.Properties[f.name]; !ok {
+			t.Errorf("schema is missing %q", f.name)
+		}
+	}
+
+	var git struct {
+		Properties struct {
+			CommitMsg struct {
+				Properties struct {
+					Mode struct {
+						Enum []string `json:"enum"`
+					} `json:"mode"`
+				} `json:"properties"`
+			} `json:"commit_msg"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(schema.Properties["git"], &git); err != nil {
+		t.Fatal(err)
+	}
+	if got := git.Properties.CommitMsg.Properties.Mode.Enum; !slices.Equal(got, []string{"prefix", "append", "none"}) {
+		t.Errorf("git.commit_msg.mode enum = %v", got)
+	}
+}
@@ -0,0 +1,338 @@
+package config
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Diagnostic is a problem found in a config file. Line and Column are
+// 1-based; they are 0 when the position is unknown.
+type Diagnostic struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+// String formats d as "line:column: message", the format editors and
+// compilers use.
+func (d Diagnostic) String() string {
+	switch {
+	case d.Line == 0:
+		return d.Message
+	case d.Column == 0:
+		return fmt.Sprintf("%d: %s", d.Line, d.Message)
+	}
+	return fmt.Sprintf("%d:%d: %s", d.Line, d.Column, d.Message)
+}
+
+// File is a config file parsed by Parse. It keeps the YAML nodes so problems
+// found later can be reported at the right position.
+type File struct {
+	// Config is decoded on a best-effort basis: values with the wrong type
+	// are left empty.
+	Config *Config
+	root   *yaml.Node
+}
+
+var _yamlErrLineRe = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+// Parse strictly parses config file data. Unlike Load, which ignores what it
+// doesn't understand, it reports unknown fields, values of the wrong type,
+// duplicate names and invalid enum values, all at once. A nil File is
+// returned only when data isn't valid YAML.
+func Parse(data []byte) (*File, []Diagnostic) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		msg := strings.TrimPrefix(err.Error(), "yaml: ")
+		if m := _yamlErrLineRe.FindStringSubmatch(err.Error()); m != nil {
+			line, _ := strconv.Atoi(m[1])
+			return nil, []Diagnostic{{Line: line, Message: m[2]}}
+		}
+		return nil, []Diagnostic{{Message: msg}}
+	}
+
+	f := &File{Config: &Config{}}
+	if len(doc.Content) == 0 {
+		return f, nil
+	}
+	f.root = doc.Content[0]
+
+	var diags []Diagnostic
+	checkNode(f.root, reflect.TypeFor[Config](), "", &diags)
+	// Type errors were reported above; decode whatever is valid.
+	_ = f.root.Decode(f.Config)
+	diags = append(diags, f.checkValues()...)
+	SortDiagnostics(diags)
+	return f, diags
+}
+
+// SortDiagnostics sorts diags by position.
+func SortDiagnostics(diags []Diagnostic) {
+	slices.SortStableFunc(diags, func(a, b Diagnostic) int {
+		if a.Line != b.Line {
+			return a.Line - b.Line
+		}
+		return a.Column - b.Column
+	})
+}
+
+// Errorf returns a diagnostic positioned at the value at path, a dotted path
+// with [i] for list indices, e.g. "interactive.commands[2].command". When the
+// path doesn't exist, the closest existing parent is used.
+func (f *File) Errorf(path, format string, args ...any) Diagnostic {
+	d := Diagnostic{Message: fmt.Sprintf(format, args...)}
+	if n := f.lookup(path); n != nil {
+		d.Line, d.Column = n.Line, n.Column
+	}
+	return d
+}
+
+// lookup returns the node at path, or its closest existing parent.
+func (f *File) lookup(path string) *yaml.Node {
+	n := f.root
+	if n == nil || path == "" {
+		return n
+	}
+	for _, part := range strings.Split(path, ".") {
+		key, rest, _ := strings.Cut(part, "[")
+		if key != "" {
+			i := -1
+			if n.Kind == yaml.MappingNode {
+				i = mappingIndex(n, key)
+			}
+			if i < 0 {
+				return n
+			}
+			n = n.Content[i+1]
+		}
+		for rest != "" {
+			idx, after, _ := strings.Cut(rest, "]")
+			rest = strings.TrimPrefix(after, "[")
+			i, err := strconv.Atoi(idx)
+			if err != nil || n.Kind != yaml.SequenceNode || i >= len(n.Content) {
+				return n
+			}
+			n = n.Content[i]
+		}
+	}
+	return n
+}
+
+// yamlField is a struct field and the key it has in the config file.
+type yamlField struct {
+	name  string
+	index int
+}
+
+// yamlFields returns the fields of struct type t that have a yaml key.
+func yamlFields(t reflect.Type) []yamlField {
+	var fields []yamlField
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("yaml"), ",")
+		if name != "" && name != "-" {
+			fields = append(fields, yamlField{name: name, index: i})
+		}
+	}
+	return fields
+}
+
+// checkNode reports the places where n doesn't match the Go type t.
+func checkNode(n *yaml.Node, t reflect.Type, path string, diags *[]Diagnostic) {
+	if n.Kind == yaml.AliasNode {
+		n = n.Alias
+	}
+	if n.Kind == yaml.ScalarNode && n.Tag == "!!null" {
+		return
+	}
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	report := func(format string, args ...any) {
+		*diags = append(*diags, Diagnostic{Line: n.Line, Column: n.Column, Message: fmt.Sprintf(format, args...)})
+	}
+	at := func(key string) string {
+		if path == "" {
+			return key
+		}
+		return path + "." + key
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if n.Kind != yaml.MappingNode {
+			report("%s: expected a mapping, got %s", describePath(path), nodeKind(n))
+			return
+		}
+		fields := yamlFields(t)
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key := n.Content[i]
+			j := slices.IndexFunc(fields, func(f yamlField) bool { return f.name == key.Value })
+			if j < 0 {
+				names := make([]string, len(fields))
+				for k, f := range fields {
+					names[k] = f.name
+				}
+				*diags = append(*diags, Diagnostic{
+					Line:    key.Line,
+					Column:  key.Column,
+					Message: fmt.Sprintf("unknown field %q in %s (valid: %s)", key.Value, describePath(path), strings.Join(names, ", ")),
+				})
+				continue
+			}
+			checkNode(n.Content[i+1], t.Field(fields[j].index).Type, at(key.Value), diags)
+		}
+	case reflect.Slice:
+		if n.Kind != yaml.SequenceNode {
+			report("%s: expected a list, got %s", describePath(path), nodeKind(n))
+			return
+		}
+		for i, item := range n.Content {
+			checkNode(item, t.Elem(), fmt.Sprintf("%s[%d]", path, i), diags)
+		}
+	case reflect.Map:
+		if n.Kind != yaml.MappingNode {
+			report("%s: expected a mapping, got %s", describePath(path), nodeKind(n))
+			return
+		}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			checkNode(n.Content[i+1], t.Elem(), at(n.Content[i].Value), diags)
+		}
+	default:
+		if n.Kind != yaml.ScalarNode {
+			report("%s: expected %s, got %s", describePath(path), scalarKind(t), nodeKind(n))
+			return
+		}
+		if err := n.Decode(reflect.New(t).Interface()); err != nil {
+			report("%s: expected %s, got %q", describePath(path), scalarKind(t), n.Value)
+		}
+	}
+}
+
+func describePath(path string) string {
+	if path == "" {
+		return "the top level"
+	}
+	return path
+}
+
+func nodeKind(n *yaml.Node) string {
+	switch n.Kind {
+	case yaml.MappingNode:
+		return "a mapping"
+	case yaml.SequenceNode:
+		return "a list"
+	default:
+		return fmt.Sprintf("%q", n.Value)
+	}
+}
+
+func scalarKind(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return "true or false"
+	case reflect.Int, reflect.Int64:
+		return "an integer"
+	default:
+		return "a string"
+	}
+}
+
+// checkValues reports problems that need more than the field types: missing
+// or duplicate names and values outside a fixed set.
+func (f *File) checkValues() []Diagnostic {
+	var diags []Diagnostic
+	report := func(path, format string, args ...any) {
+		diags = append(diags, f.Errorf(path, format, args...))
+	}
+	uniqueNames := func(section, kind string, names []string) {
+		seen := make(map[string]int)
+		for i, name := range names {
+			item := fmt.Sprintf("%s[%d]", section, i)
+			if name == "" {
+				report(item, "%s: name is required", item)
+				continue
+			}
+			if first, ok := seen[name]; ok {
+				report(item+".name", "duplicate %s name %q (first used in %s[%d])", kind, name, section, first)
+				continue
+			}
+			seen[name] = i
+		}
+	}
+	enum := func(path, value string, valid ...string) {
+		if value != "" && !slices.Contains(valid, value) {
+			report(path, "%s: unknown value %q (valid: %s)", path, value, strings.Join(valid, ", "))
+		}
+	}
+	cfg := f.Config
+
+	enum("interactive.backend", cfg.Interactive.Backend, BackendFzf, BackendBuiltin)
+	var names []string
+	for i, c := range cfg.Interactive.Commands {
+		names = append(names, c.Name)
+		path := fmt.Sprintf("interactive.commands[%d]", i)
+		if c.Command == "" {
+			report(path, "%s: command is required", path)
+		}
+		enum(path+".output", c.Output, OutputTerminal, OutputPreview)
+		switch {
+		case c.Exec && c.Silent:
+			report(path, "%s: exec and silent can't be combined", path)
+		case c.Exec && c.Output == OutputPreview:
+			report(path, "%s: exec and output: preview can't be combined", path)
+		case c.Silent && c.Confirm:
+			report(path, "%s: confirm needs the terminal, so it can't be silent", path)
+		}
+	}
+	uniqueNames("interactive.commands", "command", names)
+
+	for i, h := range cfg.Worktree.PostCreate {
+		path := fmt.Sprintf("worktree.post_create[%d]", i)
+		switch {
+		case h.Builtin != "" && h.Command != "":
+			report(path, "%s: set either builtin or command, not both", path)
+		case h.Builtin == "" && h.Command == "":
+			report(path, "%s: command or builtin is required", path)
+		}
+		if h.Builtin != "" {
+			enum(path+".builtin", h.Builtin, slices.Sorted(maps.Keys(BuiltinHooks))...)
+		}
+		enum(path+".on_failure", h.OnFailure, HookAbort, HookWarn, HookIgnore)
+	}
+	enum("git.commit_msg.mode", cfg.Git.CommitMsg.Mode, CommitMsgPrefix, CommitMsgAppend, CommitMsgNone)
+
+	names = names[:0]
+	for i, c := range cfg.Columns {
+		names = append(names, c.Name)
+		if c.Width < 0 {
+			report(fmt.Sprintf("columns[%d].width", i), "columns[%d].width: must not be negative", i)
+		}
+	}
+	uniqueNames("columns", "column", names)
+
+	names = names[:0]
+	for i, v := range cfg.Views {
+		names = append(names, v.Name)
+		if v.Limit < 0 {
+			report(fmt.Sprintf("views[%d].limit", i), "views[%d].limit: must not be negative", i)
+		}
+	}
+	uniqueNames("views", "view", names)
+
+	names = names[:0]
+	for i, p := range cfg.Prompts {
+		names = append(names, p.Name)
+		if p.Template == "" {
+			report(fmt.Sprintf("prompts[%d]", i), "prompts[%d]: template is required", i)
+		}
+	}
+	uniqueNames("prompts", "prompt", names)
+	return diags
+}
@@ -0,0 +1,127 @@
+package config
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []string
+	}{
+		{name: "empty", data: "", want: nil},
+		{
+			name: "valid",
+			data: "interactive:\n  commands:\n    - name: Open\n      command: xdg-open {{.Raw.URL}}\nworktree:\n  track: true\n",
+			want: nil,
+		},
+		{
+			name: "unknown fields",
+			data: "interactive:\n  comands: []\nviewz: []\n",
+			want: []string{
+				`2:3: unknown field "comands" in interactive (valid: commands, bindings, backend)`,
+				`3:1: unknown field "viewz" in the top level (valid: interactive, worktree, git, workflow, pr, columns, views, prompts, defaults, aliases)`,
+			},
+		},
+		{
+			name: "wrong types",
+			data: "worktree:\n  track: maybe\nviews:\n  - name: a\n    limit: ten\ncolumns: x\n",
+			want: []string{
+				`2:10: worktree.track: expected true or false, got "maybe"`,
+				`5:12: views[0].limit: expected an integer, got "ten"`,
+				`6:10: columns: expected a list, got "x"`,
+			},
+		},
+		{
+			name: "duplicate and missing names",
+			data: "interactive:\n  commands:\n    - name: A\n      command: a\n    - name: A\n      command: b\n    - command: c\n    - name: D\n",
+			want: []string{
+				`5:13: duplicate command name "A" (first used in interactive.commands[0])`,
+				`7:7: interactive.commands[2]: name is required`,
+				`8:7: interactive.commands[3]: command is required`,
+			},
+		},
+		{
+			name: "enums",
+			data: "git:\n  commit_msg:\n    mode: suffix\nworktree:\n  post_create:\n    - builtin: nope\n      command: x\ninteractive:\n  backend: tui\n",
+			want: []string{
+				`3:11: git.commit_msg.mode: unknown value "suffix" (valid: prefix, append, none)`,
+				`6:7: worktree.post_create[0]: set either builtin or command, not both`,
+				`6:16: worktree.post_create[0].builtin: unknown value "nope" (valid: mise-trust)`,
+				`9:12: interactive.backend: unknown value "tui" (valid: fzf, builtin)`,
+			},
+		},
+		{
+			name: "command options",
+			data: "interactive:\n  commands:\n    - name: A\n      command: a\n      exec: true\n      silent: true\n    - name: B\n      command: b\n      output: pane\n    - name: C\n      command: c\n      silent: true\n      confirm: true\n",
+			want: []string{
+				`3:7: interactive.commands[0]: exec and silent can't be combined`,
+				`9:15: interactive.commands[1].output: unknown value "pane" (valid: terminal, preview)`,
+				`10:7: interactive.commands[2]: confirm needs the terminal, so it can't be silent`,
+			},
+		},
+		{
+			name: "prompts",
+			data: "prompts:\n  - name: claude\n    template: a\n  - name: claude\n    template: b\n  - name: review\n",
+			want: []string{
+				`4:11: duplicate prompt name "claude" (first used in prompts[0])`,
+				`6:5: prompts[2]: template is required`,
+			},
+		},
+		{
+			name: "syntax error",
+			data: "pr:\n  title: x\n\tbody: y\n",
+			want: []string{`2: found a tab character that violates indentation`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, diags := Parse([]byte(tt.data))
+			var got []string
+			for _, d := range diags {
+				got = append(got, d.String())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Parse() diagnostics =\n%q\nwant:\n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_Decodes(t *testing.T) {
+	f, diags := Parse([]byte("pr:\n  draft: true\n  title: x\nworktree:\n  track: maybe\n"))
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %v", diags)
+	}
+	if !f.Config.PR.Draft || f.Config.PR.Title != "x" {
+		t.Errorf("valid values should be decoded, got %+v", f.Config.PR)
+	}
+}
+
+func TestParse_ExampleIsValid(t *testing.T) {
+	if _, diags := Parse(ExampleContent); len(diags) > 0 {
+		t.Errorf("example config has problems: %v", diags)
+	}
+}
+
+func TestFile_Errorf(t *testing.T) {
+	f, _ := Parse([]byte("views:\n  - name: a\n  - name: b\n    status: x\n"))
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "views[1].status", want: "4:13: m"},
+		{path: "views[1].label", want: "3:5: m"},
+		{path: "views[5]", want: "2:3: m"},
+		{path: "nope", want: "1:1: m"},
+	}
+	for _, tt := range tests {
+		if got := f.Errorf(tt.path, "m").String(); got != tt.want {
+			t.Errorf("Errorf(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
@@ -19,6 +19,23 @@ const (
 	Gray   = "\033[90m"
 )
 
+// _colorNames maps the color names accepted in config and templates to ANSI
+// codes.
+var _colorNames = map[string]string{
+	"bold":   Bold,
+	"red":    Red,
+	"green":  Green,
+	"yellow": Yellow,
+	"cyan":   Cyan,
+	"gray":   Gray,
+}
+
+// ColorCode returns the ANSI code for a color name such as "red" or "gray".
+func ColorCode(name string) (string, bool) {
+	code, ok := _colorNames[name]
+	return code, ok
+}
+
 // fdWriter is an interface for writers that expose a file descriptor.
 type fdWriter interface {
 	Fd() uintptr
@@ -13,18 +13,27 @@ import (
 type issueListNode = api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue
 
 // ColumnDef defines how to render a single column in the issue list table.
+// Raw returns the machine-readable value used by structured output formats
+// (csv, json, ...): ISO timestamps, numeric priority, label lists, and nil for
+// missing optional fields. Flexible columns are truncated with an ellipsis
+// when the table is wider than the terminal. Width truncates longer values in
+// tables, whatever the terminal width; 0 means no limit.
 type ColumnDef struct {
-	Header string
-	Value  func(issue *issueListNode) string
-	Color  func(issue *issueListNode) string
+	Header   string
+	Value    func(issue *issueListNode) string
+	Color    func(issue *issueListNode) string
+	Raw      func(issue *issueListNode) any
+	Flexible bool
+	Width    int
 }
 
-// _columnRegistry maps column names to their definitions.
+// _columnRegistry maps built-in column names to their definitions.
 var _columnRegistry = map[string]ColumnDef{
 	"id": {
 		Header: "IDENTIFIER",
 		Value:  func(issue *issueListNode) string { return issue.Identifier },
 		Color:  func(_ *issueListNode) string { return "" },
+		Raw:    func(issue *issueListNode) any { return issue.Identifier },
 	},
 	"status": {
 		Header: "STATUS",
@@ -40,21 +49,40 @@ var _columnRegistry = map[string]ColumnDef{
 			}
 			return ""
 		},
+		Raw: func(issue *issueListNode) any {
+			if issue.State != nil {
+				return issue.State.Name
+			}
+			return nil
+		},
 	},
 	"priority": {
 		Header: "PRIORITY",
 		Value:  func(issue *issueListNode) string { return PriorityLabel(issue.Priority) },
 		Color:  func(issue *issueListNode) string { return PriorityColor(issue.Priority) },
+		Raw:    func(issue *issueListNode) any { return int(issue.Priority) },
 	},
 	"labels": {
 		Header: "LABELS",
 		Value:  func(issue *issueListNode) string { return issueLabels(issue) },
 		Color:  func(_ *issueListNode) string { return Cyan },
+		Raw: func(issue *issueListNode) any {
+			names := []string{}
+			if issue.Labels != nil {
+				for _, l := range issue.Labels.Nodes {
+					names = append(names, l.Name)
+				}
+			}
+			return names
+		},
+		Flexible: true,
 	},
 	"title": {
-		Header: "TITLE",
-		Value:  func(issue *issueListNode) string { return issue.Title },
-		Color:  func(_ *issueListNode) string { return "" },
+		Header:   "TITLE",
+		Value:    func(issue *issueListNode) string { return issue.Title },
+		Color:    func(_ *issueListNode) string { return "" },
+		Raw:      func(issue *issueListNode) any { return issue.Title },
+		Flexible: true,
 	},
 	"updated": {
 		Header: "UPDATED",
@@ -66,6 +94,7 @@ var _columnRegistry = map[string]ColumnDef{
 			return t.Format("2006-01-02")
 		},
 		Color: func(_ *issueListNode) string { return Gray },
+		Raw:   func(issue *issueListNode) any { return issue.UpdatedAt },
 	},
 	"created": {
 		Header: "CREATED",
@@ -77,6 +106,7 @@ var _columnRegistry = map[string]ColumnDef{
 			return t.Format("2006-01-02")
 		},
 		Color: func(_ *issueListNode) string { return Gray },
+		Raw:   func(issue *issueListNode) any { return issue.CreatedAt },
 	},
 	"cycle": {
 		Header: "CYCLE",
@@ -87,6 +117,12 @@ var _columnRegistry = map[string]ColumnDef{
 			return fmt.Sprintf("%.0f", issue.Cycle.Number)
 		},
 		Color: func(_ *issueListNode) string { return "" },
+		Raw: func(issue *issueListNode) any {
+			if issue.Cycle == nil {
+				return nil
+			}
+			return int(issue.Cycle.Number)
+		},
 	},
 	"assignee": {
 		Header: "ASSIGNEE",
@@ -97,6 +133,12 @@ var _columnRegistry = map[string]ColumnDef{
 			return issue.Assignee.Name
 		},
 		Color: func(_ *issueListNode) string { return "" },
+		Raw: func(issue *issueListNode) any {
+			if issue.Assignee == nil {
+				return nil
+			}
+			return issue.Assignee.Name
+		},
 	},
 	"project": {
 		Header: "PROJECT",
@@ -107,6 +149,12 @@ var _columnRegistry = map[string]ColumnDef{
 			return issue.Project.Name
 		},
 		Color: func(_ *issueListNode) string { return "" },
+		Raw: func(issue *issueListNode) any {
+			if issue.Project == nil {
+				return nil
+			}
+			return issue.Project.Name
+		},
 	},
 	"estimate": {
 		Header: "ESTIMATE",
@@ -117,6 +165,12 @@ var _columnRegistry = map[string]ColumnDef{
 			return fmt.Sprintf("%.0f", *issue.Estimate)
 		},
 		Color: func(_ *issueListNode) string { return "" },
+		Raw: func(issue *issueListNode) any {
+			if issue.Estimate == nil {
+				return nil
+			}
+			return *issue.Estimate
+		},
 	},
 	"duedate": {
 		Header: "DUE DATE",
@@ -127,10 +181,17 @@ var _columnRegistry = map[string]ColumnDef{
 			return *issue.DueDate
 		},
 		Color: func(_ *issueListNode) string { return Gray },
+		Raw: func(issue *issueListNode) any {
+			if issue.DueDate == nil {
+				return nil
+			}
+			return *issue.DueDate
+		},
 	},
 }
 
-// ColumnNames lists all known column names in canonical order.
+// ColumnNames lists the built-in column names in canonical order. See
+// Columns.Names for the list including user-defined columns.
 var ColumnNames = []string{"id", "status", "priority", "labels", "title", "updated", "created", "cycle", "assignee", "project", "estimate", "duedate"}
 
 // _defaultColumnNames is the full default column set (base for additive mode).
@@ -147,7 +208,8 @@ func DefaultColumns(issues []*issueListNode) []string {
 	return []string{"id", "status", "priority", "title"}
 }
 
-// ParseColumns parses a --column flag value into a list of column names.
+// ParseColumns parses a --column flag value into a list of names of columns
+// in set.
 //
 // Syntax:
 //   - "id,status,title"  replacement: show exactly these columns
@@ -155,7 +217,7 @@ func DefaultColumns(issues []*issueListNode) []string {
 //   - "+updated:2"       additive: insert at position 2 (1-based)
 //
 // All entries must be either additive (+) or replacement (no +), not mixed.
-func ParseColumns(spec string) ([]string, error) {
+func ParseColumns(set *Columns, spec string) ([]string, error) {
 	parts := strings.Split(spec, ",")
 
 	hasAdditive := false
@@ -179,12 +241,12 @@ func ParseColumns(spec string) ([]string, error) {
 	}
 
 	if hasReplacement {
-		return parseReplacementColumns(parts)
+		return parseReplacementColumns(set, parts)
 	}
-	return parseAdditiveColumns(parts)
+	return parseAdditiveColumns(set, parts)
 }
 
-func parseReplacementColumns(parts []string) ([]string, error) {
+func parseReplacementColumns(set *Columns, parts []string) ([]string, error) {
 	var columns []string
 	seen := make(map[string]bool)
 	for _, p := range parts {
@@ -192,8 +254,8 @@ func parseReplacementColumns(parts []string) ([]string, error) {
 		if name == "" {
 			continue
 		}
-		if _, ok := _columnRegistry[name]; !ok {
-			return nil, fmt.Errorf("unknown column %q (available: %s)", name, strings.Join(ColumnNames, ", "))
+		if _, ok := set.lookup(name); !ok {
+			return nil, fmt.Errorf("unknown column %q (available: %s)", name, strings.Join(set.Names(), ", "))
 		}
 		if seen[name] {
 			return nil, fmt.Errorf("duplicate column %q", name)
@@ -209,69 +271,43 @@ func parseReplacementColumns(parts []string) ([]string, error) {
 
 // FormatFzfLines formats issues into aligned, ANSI-colored lines for fzf,
 // including a header line. Returns the header and the data lines separately.
-// This reuses the column registry so interactive mode supports the same columns
-// as non-interactive mode.
-func FormatFzfLines(issues []*issueListNode, columns []string) (header string, lines []string) {
+// This reuses the column set so interactive mode supports the same columns
+// as non-interactive mode. Lines are not truncated; fzf clips them itself.
+func FormatFzfLines(issues []*issueListNode, set *Columns, columns []string) (header string, lines []string) {
 	if len(issues) == 0 {
 		return "", nil
 	}
 
-	const gap = "  "
-
-	cols := make([]ColumnDef, len(columns))
-	for i, name := range columns {
-		cols[i] = _columnRegistry[name]
-	}
-
-	// Compute max visible widths per column.
-	widths := make([]int, len(cols))
+	cols, rows := issueTable(issues, set, columns)
+	// Render the header as an uncolored row so it shares the column widths.
+	headerRow := make([]TableCell, len(cols))
 	for i, col := range cols {
-		widths[i] = len(col.Header)
-	}
-	for _, issue := range issues {
-		for i, col := range cols {
-			if l := len(col.Value(issue)); l > widths[i] {
-				widths[i] = l
-			}
-		}
+		headerRow[i] = TableCell{Text: col.Header}
 	}
+	out := RenderTable(cols, append([][]TableCell{headerRow}, rows...), true, false, 0)
+	all := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	return all[0], all[1:]
+}
 
-	// Header
-	var hdr strings.Builder
-	for i, col := range cols {
-		if i > 0 {
-			hdr.WriteString(gap)
-		}
-		if i == len(cols)-1 {
-			hdr.WriteString(col.Header)
-		} else {
-			fmt.Fprintf(&hdr, "%-*s", widths[i], col.Header)
-		}
+// issueTable builds the table columns and cells for issues.
+func issueTable(issues []*issueListNode, set *Columns, columns []string) ([]TableColumn, [][]TableCell) {
+	defs := set.lookupAll(columns)
+	cols := make([]TableColumn, len(defs))
+	for i, def := range defs {
+		cols[i] = TableColumn{Header: def.Header, Flexible: def.Flexible}
 	}
-	header = hdr.String()
-
-	// Rows
-	lines = make([]string, len(issues))
+	rows := make([][]TableCell, len(issues))
 	for r, issue := range issues {
-		var buf strings.Builder
-		for i, col := range cols {
-			if i > 0 {
-				buf.WriteString(gap)
-			}
-			value := col.Value(issue)
-			colorCode := col.Color(issue)
-			if i == len(cols)-1 {
-				buf.WriteString(Colorize(true, colorCode, value))
-			} else {
-				buf.WriteString(PadColor(true, colorCode, value, widths[i]))
-			}
+		row := make([]TableCell, len(defs))
+		for i, def := range defs {
+			row[i] = TableCell{Text: truncate(def.Value(issue), def.Width), Color: def.Color(issue)}
 		}
-		lines[r] = buf.String()
+		rows[r] = row
 	}
-	return header, lines
+	return cols, rows
 }
 
-func parseAdditiveColumns(parts []string) ([]string, error) {
+func parseAdditiveColumns(set *Columns, parts []string) ([]string, error) {
 	columns := make([]string, len(_defaultColumnNames))
 	copy(columns, _defaultColumnNames)
 
@@ -302,8 +338,8 @@ func parseAdditiveColumns(parts []string) ([]string, error) {
 		}
 
 		name = strings.ToLower(name)
-		if _, ok := _columnRegistry[name]; !ok {
-			return nil, fmt.Errorf("unknown column %q (available: %s)", name, strings.Join(ColumnNames, ", "))
+		if _, ok := set.lookup(name); !ok {
+			return nil, fmt.Errorf("unknown column %q (available: %s)", name, strings.Join(set.Names(), ", "))
 		}
 		if seen[name] {
 			continue // already in defaults, skip silently
@@ -99,7 +99,7 @@ func TestParseColumns_Replacement(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got, err := format.ParseColumns(tt.spec)
+			got, err := format.ParseColumns(nil, tt.spec)
 			if err != nil {
 				t.Fatalf("ParseColumns(%q) returned error: %v", tt.spec, err)
 			}
@@ -153,7 +153,7 @@ func TestParseColumns_Additive(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got, err := format.ParseColumns(tt.spec)
+			got, err := format.ParseColumns(nil, tt.spec)
 			if err != nil {
 				t.Fatalf("ParseColumns(%q) returned error: %v", tt.spec, err)
 			}
@@ -212,7 +212,7 @@ func TestParseColumns_Errors(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			_, err := format.ParseColumns(tt.spec)
+			_, err := format.ParseColumns(nil, tt.spec)
 			if err == nil {
 				t.Fatalf("ParseColumns(%q) expected error containing %q", tt.spec, tt.wantErr)
 			}
@@ -241,7 +241,7 @@ func TestFormatIssueList_CustomColumns(t *testing.T) {
 
 	t.Run("id and title only", func(t *testing.T) {
 		t.Parallel()
-		got := format.FormatIssueList(issues, false, []string{"id", "title"})
+		got := format.FormatIssueList(issues, false, nil, []string{"id", "title"}, 0)
 		if !strings.Contains(got, "IDENTIFIER") {
 			t.Error("expected IDENTIFIER header")
 		}
@@ -264,7 +264,7 @@ func TestFormatIssueList_CustomColumns(t *testing.T) {
 
 	t.Run("updated column", func(t *testing.T) {
 		t.Parallel()
-		got := format.FormatIssueList(issues, false, []string{"id", "updated", "title"})
+		got := format.FormatIssueList(issues, false, nil, []string{"id", "updated", "title"}, 0)
 		if !strings.Contains(got, "UPDATED") {
 			t.Error("expected UPDATED header")
 		}
@@ -275,7 +275,7 @@ func TestFormatIssueList_CustomColumns(t *testing.T) {
 
 	t.Run("reversed order", func(t *testing.T) {
 		t.Parallel()
-		got := format.FormatIssueList(issues, false, []string{"title", "status", "id"})
+		got := format.FormatIssueList(issues, false, nil, []string{"title", "status", "id"}, 0)
 		lines := strings.Split(strings.TrimRight(got, "\n"), "\n")
 		if len(lines) < 1 {
 			t.Fatal("expected at least header line")
@@ -326,7 +326,7 @@ func TestFormatIssueList_NewColumns(t *testing.T) {
 
 	t.Run("cycle column", func(t *testing.T) {
 		t.Parallel()
-		got := format.FormatIssueList(issues, false, []string{"id", "cycle", "title"})
+		got := format.FormatIssueList(issues, false, nil, []string{"id", "cycle", "title"}, 0)
 		if !strings.Contains(got, "CYCLE") {
 			t.Error("expected CYCLE header")
 		}
@@ -337,7 +337,7 @@ func TestFormatIssueList_NewColumns(t *testing.T) {
 
 	t.Run("assignee column", func(t *testing.T) {
 		t.Parallel()
-		got := format.FormatIssueList(issues, false, []string{"id", "assignee", "title"})
+		got := format.FormatIssueList(issues, false, nil, []string{"id", "assignee", "title"}, 0)
 		if !strings.Contains(got, "ASSIGNEE") {
 			t.Error("expected ASSIGNEE header")
 		}
@@ -348,7 +348,7 @@ func TestFormatIssueList_NewColumns(t *testing.T) {
 
 	t.Run("project column", func(t *testing.T) {
 		t.Parallel()
-		got := format.FormatIssueList(issues, false, []string{"id", "project", "title"})
+		got := format.FormatIssueList(issues, false, nil, []string{"id", "project", "title"}, 0)
 		if !strings.Contains(got, "PROJECT") {
 			t.Error("expected PROJECT header")
 		}
@@ -359,7 +359,7 @@ func TestFormatIssueList_NewColumns(t *testing.T) {
 
 	t.Run("estimate column", func(t *testing.T) {
 		t.Parallel()
-		got := format.FormatIssueList(issues, false, []string{"id", "estimate", "title"})
+		got := format.FormatIssueList(issues, false, nil, []string{"id", "estimate", "title"}, 0)
 		if !strings.Contains(got, "ESTIMATE") {
 			t.Error("expected ESTIMATE header")
 		}
@@ -370,7 +370,7 @@ func TestFormatIssueList_NewColumns(t *testing.T) {
 
 	t.Run("duedate column", func(t *testing.T) {
 		t.Parallel()
-		got := format.FormatIssueList(issues, false, []string{"id", "duedate", "title"})
+		got := format.FormatIssueList(issues, false, nil, []string{"id", "duedate", "title"}, 0)
 		if !strings.Contains(got, "DUE DATE") {
 			t.Error("expected DUE DATE header")
 		}
@@ -381,7 +381,7 @@ func TestFormatIssueList_NewColumns(t *testing.T) {
 
 	t.Run("created column", func(t *testing.T) {
 		t.Parallel()
-		got := format.FormatIssueList(issues, false, []string{"id", "created", "title"})
+		got := format.FormatIssueList(issues, false, nil, []string{"id", "created", "title"}, 0)
 		if !strings.Contains(got, "CREATED") {
 			t.Error("expected CREATED header")
 		}
@@ -405,7 +405,7 @@ func TestFormatIssueList_NilOptionalColumns(t *testing.T) {
 	}
 
 	// All optional columns should render empty without panicking.
-	got := format.FormatIssueList(issues, false, []string{"id", "cycle", "assignee", "project", "estimate", "duedate", "title"})
+	got := format.FormatIssueList(issues, false, nil, []string{"id", "cycle", "assignee", "project", "estimate", "duedate", "title"}, 0)
 	if !strings.Contains(got, "CYCLE") {
 		t.Error("expected CYCLE header")
 	}
@@ -462,7 +462,7 @@ func TestParseColumns_NewColumns(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got, err := format.ParseColumns(tt.spec)
+			got, err := format.ParseColumns(nil, tt.spec)
 			if err != nil {
 				t.Fatalf("ParseColumns(%q) returned error: %v", tt.spec, err)
 			}
@@ -476,7 +476,7 @@ func TestParseColumns_NewColumns(t *testing.T) {
 func TestFormatFzfLines_Empty(t *testing.T) {
 	t.Parallel()
 
-	header, lines := format.FormatFzfLines(nil, []string{"id", "status", "title"})
+	header, lines := format.FormatFzfLines(nil, nil, []string{"id", "status", "title"})
 	if header != "" {
 		t.Errorf("expected empty header for nil input, got %q", header)
 	}
@@ -509,7 +509,7 @@ func TestFormatFzfLines_DefaultColumns(t *testing.T) {
 		},
 	}
 
-	header, lines := format.FormatFzfLines(issues, []string{"id", "status", "priority", "title"})
+	header, lines := format.FormatFzfLines(issues, nil, []string{"id", "status", "priority", "title"})
 
 	for _, col := range []string{"IDENTIFIER", "STATUS", "PRIORITY", "TITLE"} {
 		if !strings.Contains(header, col) {
@@ -551,7 +551,7 @@ func TestFormatFzfLines_CustomColumns(t *testing.T) {
 		},
 	}
 
-	header, lines := format.FormatFzfLines(issues, []string{"id", "assignee", "updated", "title"})
+	header, lines := format.FormatFzfLines(issues, nil, []string{"id", "assignee", "updated", "title"})
 
 	if !strings.Contains(header, "ASSIGNEE") {
 		t.Errorf("header should contain ASSIGNEE, got %q", header)
@@ -598,7 +598,7 @@ func TestFormatFzfLines_Alignment(t *testing.T) {
 		},
 	}
 
-	_, lines := format.FormatFzfLines(issues, []string{"id", "status", "priority", "title"})
+	_, lines := format.FormatFzfLines(issues, nil, []string{"id", "status", "priority", "title"})
 
 	plain0 := stripANSI(lines[0])
 	plain1 := stripANSI(lines[1])
@@ -628,7 +628,7 @@ func TestFormatFzfLines_Labels(t *testing.T) {
 		},
 	}
 
-	header, lines := format.FormatFzfLines(issues, []string{"id", "labels", "title"})
+	header, lines := format.FormatFzfLines(issues, nil, []string{"id", "labels", "title"})
 
 	if !strings.Contains(header, "LABELS") {
 		t.Errorf("header should contain LABELS, got %q", header)
@@ -0,0 +1,87 @@
+package format
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// Columns is the set of columns an issue list can show: the built-in columns
+// plus user-defined ones added with Add. Commands build one per invocation
+// from the config and pass it to ParseColumns and the renderers. A nil
+// *Columns has only the built-in columns.
+type Columns struct {
+	names []string
+	defs  map[string]ColumnDef
+}
+
+var _columnNameRe = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// ValidateColumnName returns an error if name can't be used for a
+// user-defined column: it must be a valid --column name that isn't built in.
+func ValidateColumnName(name string) error {
+	if !_columnNameRe.MatchString(name) {
+		return fmt.Errorf("column %q: name must match %s", name, _columnNameRe)
+	}
+	if slices.Contains(ColumnNames, name) {
+		return fmt.Errorf("column %q: conflicts with a built-in column", name)
+	}
+	return nil
+}
+
+// Add adds a user-defined column, which then works with ParseColumns,
+// FormatIssueList, FormatFzfLines and IssueRecords like built-in columns.
+// Adding a name again replaces its definition.
+func (c *Columns) Add(name string, def ColumnDef) error {
+	if err := ValidateColumnName(name); err != nil {
+		return err
+	}
+	if c.defs == nil {
+		c.defs = make(map[string]ColumnDef)
+	}
+	if _, ok := c.defs[name]; !ok {
+		c.names = append(c.names, name)
+	}
+	c.defs[name] = def
+	return nil
+}
+
+// Names returns the built-in column names followed by the user-defined ones.
+func (c *Columns) Names() []string {
+	if c == nil {
+		return slices.Clone(ColumnNames)
+	}
+	return append(slices.Clone(ColumnNames), c.names...)
+}
+
+// lookup returns the definition of a built-in or user-defined column.
+func (c *Columns) lookup(name string) (ColumnDef, bool) {
+	if def, ok := _columnRegistry[name]; ok {
+		return def, true
+	}
+	if c == nil {
+		return ColumnDef{}, false
+	}
+	def, ok := c.defs[name]
+	return def, ok
+}
+
+// lookupAll returns the definitions for names, which must be valid.
+func (c *Columns) lookupAll(names []string) []ColumnDef {
+	defs := make([]ColumnDef, len(names))
+	for i, name := range names {
+		defs[i], _ = c.lookup(name)
+	}
+	return defs
+}
+
+// truncate shortens s to at most width display cells, ending with an
+// ellipsis.
+func truncate(s string, width int) string {
+	if width <= 0 {
+		return s
+	}
+	return runewidth.Truncate(s, width, _ellipsis)
+}
@@ -0,0 +1,114 @@
+package format_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/internal/api"
+	"github.com/duboisf/linear/internal/format"
+)
+
+func TestColumns(t *testing.T) {
+	t.Parallel()
+
+	set := &format.Columns{}
+	err := set.Add("parent", format.ColumnDef{
+		Header: "PARENT",
+		Value: func(issue *api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue) string {
+			if issue.Parent == nil {
+				return ""
+			}
+			return issue.Parent.Identifier
+		},
+		Color: func(*api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue) string { return format.Gray },
+		Raw:   func(*api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue) any { return "raw" },
+	})
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	short := format.ColumnDef{
+		Header: "SHORT",
+		Value: func(issue *api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue) string {
+			return issue.Title
+		},
+		Color: func(*api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue) string { return "" },
+		Raw:   func(issue *api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue) any { return issue.Title },
+		Width: 6,
+	}
+	if err := set.Add("short", short); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	if names := set.Names(); !slices.Equal(names[len(names)-2:], []string{"parent", "short"}) || names[0] != "id" {
+		t.Errorf("Names = %v, want built-ins followed by custom columns", names)
+	}
+	var builtins *format.Columns
+	if names := builtins.Names(); slices.Contains(names, "parent") {
+		t.Errorf("nil Columns should only have built-ins, got %v", names)
+	}
+	if _, err := format.ParseColumns(nil, "parent"); err == nil {
+		t.Error("custom columns must not leak into other column sets")
+	}
+
+	columns, err := format.ParseColumns(set, "id,parent,short")
+	if err != nil {
+		t.Fatalf("ParseColumns: %v", err)
+	}
+	issues := []*api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue{
+		{
+			Identifier: "ENG-1",
+			Title:      "A long title",
+			Parent:     &api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueParentIssue{Identifier: "ENG-0"},
+		},
+		{Identifier: "ENG-2", Title: "Short"},
+	}
+
+	out := format.FormatIssueList(issues, true, set, columns, 0)
+	lines := strings.Split(strings.TrimRight(stripANSI(out), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header and 2 rows, got:\n%s", stripANSI(out))
+	}
+	if !strings.Contains(lines[0], "PARENT") || !strings.Contains(lines[0], "SHORT") {
+		t.Errorf("unexpected header %q", lines[0])
+	}
+	if !strings.Contains(lines[1], "ENG-0") || !strings.Contains(lines[1], "A lon…") {
+		t.Errorf("unexpected first row %q", lines[1])
+	}
+	if !strings.Contains(out, format.Gray+"ENG-0") {
+		t.Errorf("expected parent to be gray, got %q", out)
+	}
+
+	records := format.IssueRecords(issues, set, []string{"short"})
+	if got := records.Rows[0][0]; got != "A long title" {
+		t.Errorf("raw value = %v, want untruncated title", got)
+	}
+
+	_, fzfLines := format.FormatFzfLines(issues, set, columns)
+	if !strings.Contains(stripANSI(fzfLines[0]), "ENG-0") {
+		t.Errorf("fzf line missing custom column: %q", fzfLines[0])
+	}
+}
+
+func TestColumns_AddErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		column  string
+		wantErr string
+	}{
+		{"builtin name", "title", "conflicts with a built-in"},
+		{"invalid name", "Bad Name", "name must match"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := (&format.Columns{}).Add(tt.column, format.ColumnDef{})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Add error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
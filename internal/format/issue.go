@@ -178,62 +178,12 @@ func issueLabels(issue *api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionN
 }
 
 // FormatIssueList formats a slice of issues as an aligned table for terminal output.
-// The columns parameter specifies which columns to display and in what order.
-func FormatIssueList(issues []*api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue, color bool, columns []string) string {
-	const gap = "  "
-
-	// Look up column definitions.
-	cols := make([]ColumnDef, len(columns))
-	for i, name := range columns {
-		cols[i] = _columnRegistry[name]
-	}
-
-	// Compute max visible widths per column.
-	widths := make([]int, len(cols))
-	for i, col := range cols {
-		widths[i] = len(col.Header)
-	}
-	for _, issue := range issues {
-		for i, col := range cols {
-			if l := len(col.Value(issue)); l > widths[i] {
-				widths[i] = l
-			}
-		}
-	}
-
-	var buf strings.Builder
-
-	// Header
-	for i, col := range cols {
-		if i > 0 {
-			buf.WriteString(gap)
-		}
-		if i == len(cols)-1 {
-			buf.WriteString(Colorize(color, Bold, col.Header))
-		} else {
-			buf.WriteString(PadColor(color, Bold, col.Header, widths[i]))
-		}
-	}
-	buf.WriteByte('\n')
-
-	// Rows
-	for _, issue := range issues {
-		for i, col := range cols {
-			if i > 0 {
-				buf.WriteString(gap)
-			}
-			value := col.Value(issue)
-			colorCode := col.Color(issue)
-			if i == len(cols)-1 {
-				buf.WriteString(Colorize(color, colorCode, value))
-			} else {
-				buf.WriteString(PadColor(color, colorCode, value, widths[i]))
-			}
-		}
-		buf.WriteByte('\n')
-	}
-
-	return buf.String()
+// The columns parameter specifies which columns of set to display and in what
+// order. When width is positive, flexible columns (title, labels) are truncated so
+// rows fit in width cells; 0 disables truncation.
+func FormatIssueList(issues []*api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue, color bool, set *Columns, columns []string, width int) string {
+	cols, rows := issueTable(issues, set, columns)
+	return RenderTable(cols, rows, color, true, width)
 }
 
 // FormatIssueDetail formats a single issue as aligned key-value plaintext.
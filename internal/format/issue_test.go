@@ -328,7 +328,7 @@ func TestFormatIssueList(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := format.FormatIssueList(tt.issues, tt.color, tt.columns)
+			got := format.FormatIssueList(tt.issues, tt.color, nil, tt.columns, 0)
 			tt.checks(t, got)
 		})
 	}
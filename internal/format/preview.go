@@ -0,0 +1,226 @@
+package format
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/duboisf/linear/internal/api"
+)
+
+// formatShortTime extracts "Jan _2 15:04" in local time from an ISO
+// timestamp.
+func formatShortTime(ts string) string {
+	t, err := time.Parse(time.RFC3339, ts)
+	if err != nil {
+		return ""
+	}
+	return t.Local().Format("Jan _2 15:04")
+}
+
+// FormatIssueCommentsMarkdown formats an issue's comments as markdown,
+// newest first.
+func FormatIssueCommentsMarkdown(comments []*api.IssueCommentsIssueCommentsCommentConnectionNodesComment) string {
+	if len(comments) == 0 {
+		return "_No comments._\n"
+	}
+	comments = slices.Clone(comments)
+	slices.SortStableFunc(comments, func(a, b *api.IssueCommentsIssueCommentsCommentConnectionNodesComment) int {
+		return strings.Compare(b.CreatedAt, a.CreatedAt)
+	})
+
+	var buf strings.Builder
+	for i, c := range comments {
+		if i > 0 {
+			buf.WriteString("\n---\n\n")
+		}
+		author := "Unknown"
+		if c.User != nil {
+			author = c.User.Name
+		}
+		fmt.Fprintf(&buf, "**%s** · %s\n\n", author, formatShortTime(c.CreatedAt))
+		buf.WriteString(strings.TrimSpace(c.Body))
+		buf.WriteByte('\n')
+	}
+	return buf.String()
+}
+
+// FormatIssueHistoryMarkdown formats an issue's history as a markdown list
+// of changes, newest first. Entries without a change worth showing (e.g.
+// the issue's creation) are left out.
+func FormatIssueHistoryMarkdown(history []*api.IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory) string {
+	history = slices.Clone(history)
+	slices.SortStableFunc(history, func(a, b *api.IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory) int {
+		return strings.Compare(b.CreatedAt, a.CreatedAt)
+	})
+
+	var buf strings.Builder
+	for _, h := range history {
+		changes := historyChanges(h)
+		if len(changes) == 0 {
+			continue
+		}
+		actor := "Linear"
+		if h.Actor != nil {
+			actor = h.Actor.Name
+		}
+		fmt.Fprintf(&buf, "- **%s** · %s: %s\n", actor, formatShortTime(h.CreatedAt), strings.Join(changes, "; "))
+	}
+	if buf.Len() == 0 {
+		return "_No history._\n"
+	}
+	return buf.String()
+}
+
+// historyChanges describes the changes of a history entry, e.g.
+// "status: Todo → In Progress".
+func historyChanges(h *api.IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory) []string {
+	var changes []string
+	if h.FromState != nil || h.ToState != nil {
+		var from, to string
+		if h.FromState != nil {
+			from = h.FromState.Name
+		}
+		if h.ToState != nil {
+			to = h.ToState.Name
+		}
+		changes = append(changes, historyChange("status", from, to))
+	}
+	if h.FromAssignee != nil || h.ToAssignee != nil {
+		from, to := "Unassigned", "Unassigned"
+		if h.FromAssignee != nil {
+			from = h.FromAssignee.Name
+		}
+		if h.ToAssignee != nil {
+			to = h.ToAssignee.Name
+		}
+		changes = append(changes, historyChange("assignee", from, to))
+	}
+	if h.FromPriority != nil && h.ToPriority != nil && *h.FromPriority != *h.ToPriority {
+		changes = append(changes, historyChange("priority", PriorityLabel(*h.FromPriority), PriorityLabel(*h.ToPriority)))
+	}
+	if h.FromTitle != nil && h.ToTitle != nil {
+		changes = append(changes, historyChange("title", fmt.Sprintf("%q", *h.FromTitle), fmt.Sprintf("%q", *h.ToTitle)))
+	}
+	var labels []string
+	for _, l := range h.AddedLabels {
+		labels = append(labels, "+"+l.Name)
+	}
+	for _, l := range h.RemovedLabels {
+		labels = append(labels, "-"+l.Name)
+	}
+	if len(labels) > 0 {
+		changes = append(changes, "labels: "+strings.Join(labels, " "))
+	}
+	if h.UpdatedDescription != nil && *h.UpdatedDescription {
+		changes = append(changes, "updated the description")
+	}
+	return changes
+}
+
+// historyChange formats a field changing from one value to another. An
+// empty from means the field was set.
+func historyChange(field, from, to string) string {
+	if from == "" {
+		return fmt.Sprintf("%s → %s", field, to)
+	}
+	return fmt.Sprintf("%s: %s → %s", field, from, to)
+}
+
+// _relationHeadings are the headings of relation types, by direction.
+var _relationHeadings = map[string][2]string{
+	"blocks":    {"Blocks", "Blocked by"},
+	"duplicate": {"Duplicate of", "Duplicated by"},
+	"related":   {"Related", "Related"},
+	"similar":   {"Similar", "Similar"},
+}
+
+// _relationOrder is the order of the relation sections.
+var _relationOrder = []string{"Parent", "Sub-issues", "Blocked by", "Blocks", "Related", "Similar", "Duplicate of", "Duplicated by"}
+
+// relatedIssue is an issue listed in a relations section.
+type relatedIssue struct {
+	identifier, title, state string
+}
+
+// FormatIssueRelationsMarkdown formats an issue's parent, sub-issues and
+// relations as markdown sections.
+func FormatIssueRelationsMarkdown(issue *api.IssueRelationsIssue) string {
+	// The states of the nested issues are distinct generated types with the
+	// same fields.
+	type stateFields = api.IssueRelationsIssueParentIssueStateWorkflowState
+
+	sections := make(map[string][]relatedIssue)
+	add := func(heading, identifier, title string, state *stateFields) {
+		r := relatedIssue{identifier: identifier, title: title}
+		if state != nil {
+			r.state = state.Name
+		}
+		sections[heading] = append(sections[heading], r)
+	}
+	if p := issue.Parent; p != nil {
+		add("Parent", p.Identifier, p.Title, p.State)
+	}
+	if issue.Children != nil {
+		for _, c := range issue.Children.Nodes {
+			add("Sub-issues", c.Identifier, c.Title, (*stateFields)(c.State))
+		}
+	}
+	if issue.Relations != nil {
+		for _, r := range issue.Relations.Nodes {
+			if r.RelatedIssue != nil {
+				add(relationHeading(r.Type, 0), r.RelatedIssue.Identifier, r.RelatedIssue.Title, (*stateFields)(r.RelatedIssue.State))
+			}
+		}
+	}
+	if issue.InverseRelations != nil {
+		for _, r := range issue.InverseRelations.Nodes {
+			if r.Issue != nil {
+				add(relationHeading(r.Type, 1), r.Issue.Identifier, r.Issue.Title, (*stateFields)(r.Issue.State))
+			}
+		}
+	}
+	if len(sections) == 0 {
+		return "_No related issues._\n"
+	}
+
+	headings := slices.Clone(_relationOrder)
+	for _, h := range slices.Sorted(maps.Keys(sections)) {
+		if !slices.Contains(headings, h) {
+			headings = append(headings, h)
+		}
+	}
+	var buf strings.Builder
+	for _, h := range headings {
+		issues, ok := sections[h]
+		if !ok {
+			continue
+		}
+		if buf.Len() > 0 {
+			buf.WriteByte('\n')
+		}
+		fmt.Fprintf(&buf, "## %s\n\n", h)
+		for _, r := range issues {
+			fmt.Fprintf(&buf, "- **%s** %s", r.identifier, r.title)
+			if r.state != "" {
+				fmt.Fprintf(&buf, " _(%s)_", r.state)
+			}
+			buf.WriteByte('\n')
+		}
+	}
+	return buf.String()
+}
+
+// relationHeading returns the heading of relation type t, from the issue's
+// side (dir 0) or the related issue's (dir 1).
+func relationHeading(t string, dir int) string {
+	if h, ok := _relationHeadings[t]; ok {
+		return h[dir]
+	}
+	if t == "" {
+		return "Related"
+	}
+	return strings.ToUpper(t[:1]) + t[1:]
+}
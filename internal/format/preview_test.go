@@ -0,0 +1,122 @@
+package format_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/internal/api"
+	"github.com/duboisf/linear/internal/format"
+)
+
+func TestFormatIssueHistoryMarkdown(t *testing.T) {
+	t.Parallel()
+
+	type entry = api.IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistory
+	ptr := func(f float64) *float64 { return &f }
+	title := func(s string) *string { return &s }
+	yes := true
+	history := []*entry{
+		{
+			CreatedAt: "2026-01-01T10:00:00Z",
+			Actor:     &api.IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryActorUser{Name: "Alice"},
+		},
+		{
+			CreatedAt: "2026-01-03T10:00:00Z",
+			Actor:     &api.IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryActorUser{Name: "Bob"},
+			FromState: &api.IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryFromStateWorkflowState{Name: "Todo"},
+			ToState:   &api.IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryToStateWorkflowState{Name: "In Progress"},
+			ToAssignee: &api.IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryToAssigneeUser{
+				Name: "Bob",
+			},
+		},
+		{
+			CreatedAt:    "2026-01-02T10:00:00Z",
+			FromPriority: ptr(3),
+			ToPriority:   ptr(1),
+			FromTitle:    title("Fix login"),
+			ToTitle:      title("Fix SSO login"),
+			AddedLabels: []*api.IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryAddedLabelsIssueLabel{
+				{Name: "bug"},
+			},
+			RemovedLabels: []*api.IssueHistoryIssueHistoryIssueHistoryConnectionNodesIssueHistoryRemovedLabelsIssueLabel{
+				{Name: "ui"},
+			},
+			UpdatedDescription: &yes,
+		},
+	}
+
+	got := strings.Split(strings.TrimSpace(format.FormatIssueHistoryMarkdown(history)), "\n")
+	if len(got) != 2 {
+		t.Fatalf("expected 2 entries (creation left out), got %d:\n%s", len(got), strings.Join(got, "\n"))
+	}
+	for _, want := range []string{"**Bob**", "status: Todo → In Progress; assignee: Unassigned → Bob"} {
+		if !strings.Contains(got[0], want) {
+			t.Errorf("newest entry %q should contain %q", got[0], want)
+		}
+	}
+	for _, want := range []string{
+		"**Linear**",
+		"priority: Normal → Urgent",
+		`title: "Fix login" → "Fix SSO login"`,
+		"labels: +bug -ui",
+		"updated the description",
+	} {
+		if !strings.Contains(got[1], want) {
+			t.Errorf("older entry %q should contain %q", got[1], want)
+		}
+	}
+
+	if got := format.FormatIssueHistoryMarkdown(history[:1]); got != "_No history._\n" {
+		t.Errorf("history without changes = %q", got)
+	}
+}
+
+func TestFormatIssueRelationsMarkdown(t *testing.T) {
+	t.Parallel()
+
+	issue := &api.IssueRelationsIssue{
+		Parent: &api.IssueRelationsIssueParentIssue{
+			Identifier: "ENG-1",
+			Title:      "Auth revamp",
+			State:      &api.IssueRelationsIssueParentIssueStateWorkflowState{Name: "In Progress"},
+		},
+		Children: &api.IssueRelationsIssueChildrenIssueConnection{
+			Nodes: []*api.IssueRelationsIssueChildrenIssueConnectionNodesIssue{
+				{Identifier: "ENG-43", Title: "Add tests"},
+			},
+		},
+		Relations: &api.IssueRelationsIssueRelationsIssueRelationConnection{
+			Nodes: []*api.IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelation{
+				{Type: "blocks", RelatedIssue: &api.IssueRelationsIssueRelationsIssueRelationConnectionNodesIssueRelationRelatedIssue{Identifier: "ENG-50", Title: "Release"}},
+			},
+		},
+		InverseRelations: &api.IssueRelationsIssueInverseRelationsIssueRelationConnection{
+			Nodes: []*api.IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelation{
+				{Type: "blocks", Issue: &api.IssueRelationsIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue{Identifier: "ENG-40", Title: "SSO config"}},
+			},
+		},
+	}
+
+	want := `## Parent
+
+- **ENG-1** Auth revamp _(In Progress)_
+
+## Sub-issues
+
+- **ENG-43** Add tests
+
+## Blocked by
+
+- **ENG-40** SSO config
+
+## Blocks
+
+- **ENG-50** Release
+`
+	if got := format.FormatIssueRelationsMarkdown(issue); got != want {
+		t.Errorf("FormatIssueRelationsMarkdown() =\n%s\nwant\n%s", got, want)
+	}
+	if got := format.FormatIssueRelationsMarkdown(&api.IssueRelationsIssue{}); got != "_No related issues._\n" {
+		t.Errorf("without relations = %q", got)
+	}
+}
@@ -0,0 +1,240 @@
+package format
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/duboisf/linear/internal/api"
+)
+
+// OutputFormats lists the values accepted by --output on list commands.
+// "table" is the default aligned, colored terminal output; the others emit
+// raw values for spreadsheets and tools like jq.
+var OutputFormats = []string{"table", "csv", "tsv", "json", "yaml", "ndjson"}
+
+// ValidateOutputFormat returns an error if name is not one of OutputFormats.
+func ValidateOutputFormat(name string) error {
+	for _, f := range OutputFormats {
+		if name == f {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown output format %q (available: %s)", name, strings.Join(OutputFormats, ", "))
+}
+
+// Records is a column-ordered table of raw values for structured output.
+// Keys name the columns; each row holds one value per key. Values are
+// strings, numbers, bools, string slices, or nil for missing fields.
+type Records struct {
+	Keys []string
+	Rows [][]any
+}
+
+// IssueRecords extracts the raw values of the given columns of set for each
+// issue.
+// Column names are used as keys so --column selects the exported fields.
+func IssueRecords(issues []*issueListNode, set *Columns, columns []string) Records {
+	cols := set.lookupAll(columns)
+	r := Records{Keys: columns, Rows: make([][]any, len(issues))}
+	for i, issue := range issues {
+		row := make([]any, len(cols))
+		for j, col := range cols {
+			row[j] = col.Raw(issue)
+		}
+		r.Rows[i] = row
+	}
+	return r
+}
+
+// UserRecords extracts the raw values shown by FormatUserList for each user.
+func UserRecords(users []*api.ListUsersUsersUserConnectionNodesUser) Records {
+	r := Records{
+		Keys: []string{"name", "display_name", "email", "admin", "active"},
+		Rows: make([][]any, len(users)),
+	}
+	for i, u := range users {
+		r.Rows[i] = []any{u.Name, u.DisplayName, u.Email, u.Admin, u.Active}
+	}
+	return r
+}
+
+// WriteRecords writes r to w in the given structured format (csv, tsv, json,
+// yaml or ndjson).
+func WriteRecords(w io.Writer, r Records, format string) error {
+	switch format {
+	case "csv":
+		return writeCSV(w, r)
+	case "tsv":
+		return writeTSV(w, r)
+	case "json":
+		return writeJSON(w, r)
+	case "ndjson":
+		return writeNDJSON(w, r)
+	case "yaml":
+		return writeYAML(w, r)
+	default:
+		return fmt.Errorf("unsupported structured output format %q", format)
+	}
+}
+
+// cellString renders a raw value as a single delimited-text cell.
+func cellString(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []string:
+		return strings.Join(v, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func writeCSV(w io.Writer, r Records) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(r.Keys); err != nil {
+		return err
+	}
+	record := make([]string, len(r.Keys))
+	for _, row := range r.Rows {
+		for i, v := range row {
+			record[i] = cellString(v)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// _tsvEscaper escapes characters that would break a TSV row, using the same
+// backslash sequences as PostgreSQL's text COPY format.
+var _tsvEscaper = strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\n", `\n`, "\r", `\r`)
+
+func writeTSV(w io.Writer, r Records) error {
+	var buf strings.Builder
+	buf.WriteString(strings.Join(r.Keys, "\t"))
+	buf.WriteByte('\n')
+	for _, row := range r.Rows {
+		for i, v := range row {
+			if i > 0 {
+				buf.WriteByte('\t')
+			}
+			buf.WriteString(_tsvEscaper.Replace(cellString(v)))
+		}
+		buf.WriteByte('\n')
+	}
+	_, err := io.WriteString(w, buf.String())
+	return err
+}
+
+// marshalRow encodes a row as a JSON object whose keys keep column order.
+// HTML characters are kept as is, so URLs with "&" stay readable.
+func marshalRow(keys []string, row []any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	// encode writes v without the newline Encode appends.
+	encode := func(v any) error {
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+		buf.Truncate(buf.Len() - 1)
+		return nil
+	}
+	buf.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := encode(key); err != nil {
+			return nil, err
+		}
+		buf.WriteByte(':')
+		if err := encode(row[i]); err != nil {
+			return nil, fmt.Errorf("marshaling %s: %w", key, err)
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func writeJSON(w io.Writer, r Records) error {
+	var compact bytes.Buffer
+	compact.WriteByte('[')
+	for i, row := range r.Rows {
+		if i > 0 {
+			compact.WriteByte(',')
+		}
+		b, err := marshalRow(r.Keys, row)
+		if err != nil {
+			return err
+		}
+		compact.Write(b)
+	}
+	compact.WriteByte(']')
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, compact.Bytes(), "", "  "); err != nil {
+		return fmt.Errorf("indenting JSON: %w", err)
+	}
+	out.WriteByte('\n')
+	_, err := w.Write(out.Bytes())
+	return err
+}
+
+func writeNDJSON(w io.Writer, r Records) error {
+	var buf bytes.Buffer
+	for _, row := range r.Rows {
+		b, err := marshalRow(r.Keys, row)
+		if err != nil {
+			return err
+		}
+		buf.Write(b)
+		buf.WriteByte('\n')
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// writeYAML emits a sequence of mappings, built as yaml.Nodes so keys keep
+// column order.
+func writeYAML(w io.Writer, r Records) error {
+	seq := &yaml.Node{Kind: yaml.SequenceNode}
+	if len(r.Rows) == 0 {
+		seq.Style = yaml.FlowStyle
+	}
+	for _, row := range r.Rows {
+		m := &yaml.Node{Kind: yaml.MappingNode}
+		for i, key := range r.Keys {
+			var v yaml.Node
+			if err := v.Encode(row[i]); err != nil {
+				return fmt.Errorf("encoding %s: %w", key, err)
+			}
+			m.Content = append(m.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, &v)
+		}
+		seq.Content = append(seq.Content, m)
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(seq); err != nil {
+		return fmt.Errorf("encoding YAML: %w", err)
+	}
+	return enc.Close()
+}
@@ -0,0 +1,173 @@
+package format_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/internal/api"
+	"github.com/duboisf/linear/internal/format"
+)
+
+func recordsTestIssues() []*api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue {
+	estimate := 3.5
+	due := "2025-03-01"
+	return []*api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue{
+		{
+			Identifier: "ENG-1",
+			Title:      "Tabs\tand \"quotes\"",
+			Priority:   2,
+			UpdatedAt:  "2025-01-15T10:30:00Z",
+			State: &api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueStateWorkflowState{
+				Name: "In Progress",
+				Type: "started",
+			},
+			Labels: &api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueLabelsIssueLabelConnection{
+				Nodes: []*api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueLabelsIssueLabelConnectionNodesIssueLabel{
+					{Name: "bug"},
+					{Name: "frontend"},
+				},
+			},
+			Estimate: &estimate,
+			DueDate:  &due,
+		},
+		{
+			Identifier: "ENG-2",
+			Title:      "Plain",
+		},
+	}
+}
+
+func TestWriteRecords_Issues(t *testing.T) {
+	t.Parallel()
+
+	columns := []string{"id", "title", "priority", "labels", "updated", "estimate", "duedate", "assignee"}
+	records := format.IssueRecords(recordsTestIssues(), nil, columns)
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{
+			format: "csv",
+			want: "id,title,priority,labels,updated,estimate,duedate,assignee\n" +
+				"ENG-1,\"Tabs\tand \"\"quotes\"\"\",2,\"bug,frontend\",2025-01-15T10:30:00Z,3.5,2025-03-01,\n" +
+				"ENG-2,Plain,0,,,,,\n",
+		},
+		{
+			format: "tsv",
+			want: "id\ttitle\tpriority\tlabels\tupdated\testimate\tduedate\tassignee\n" +
+				"ENG-1\tTabs\\tand \"quotes\"\t2\tbug,frontend\t2025-01-15T10:30:00Z\t3.5\t2025-03-01\t\n" +
+				"ENG-2\tPlain\t0\t\t\t\t\t\n",
+		},
+		{
+			format: "ndjson",
+			want: `{"id":"ENG-1","title":"Tabs\tand \"quotes\"","priority":2,"labels":["bug","frontend"],"updated":"2025-01-15T10:30:00Z","estimate":3.5,"duedate":"2025-03-01","assignee":null}` + "\n" +
+				`{"id":"ENG-2","title":"Plain","priority":0,"labels":[],"updated":"","estimate":null,"duedate":null,"assignee":null}` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			t.Parallel()
+			var buf bytes.Buffer
+			if err := format.WriteRecords(&buf, records, tt.format); err != nil {
+				t.Fatalf("WriteRecords: %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteRecords_JSONKeepsColumnOrder(t *testing.T) {
+	t.Parallel()
+
+	records := format.IssueRecords(recordsTestIssues()[1:], nil, []string{"title", "id"})
+	var buf bytes.Buffer
+	if err := format.WriteRecords(&buf, records, "json"); err != nil {
+		t.Fatalf("WriteRecords: %v", err)
+	}
+
+	want := "[\n  {\n    \"title\": \"Plain\",\n    \"id\": \"ENG-2\"\n  }\n]\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestWriteRecords_JSONKeepsHTML(t *testing.T) {
+	t.Parallel()
+
+	records := format.Records{Keys: []string{"title"}, Rows: [][]any{{"Fix <b> & more"}}}
+	var buf bytes.Buffer
+	if err := format.WriteRecords(&buf, records, "ndjson"); err != nil {
+		t.Fatalf("WriteRecords: %v", err)
+	}
+
+	want := `{"title":"Fix <b> & more"}` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestWriteRecords_YAML(t *testing.T) {
+	t.Parallel()
+
+	records := format.IssueRecords(recordsTestIssues(), nil, []string{"id", "priority", "labels", "assignee"})
+	var buf bytes.Buffer
+	if err := format.WriteRecords(&buf, records, "yaml"); err != nil {
+		t.Fatalf("WriteRecords: %v", err)
+	}
+
+	want := `- id: ENG-1
+  priority: 2
+  labels:
+    - bug
+    - frontend
+  assignee: null
+- id: ENG-2
+  priority: 0
+  labels: []
+  assignee: null
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestWriteRecords_Empty(t *testing.T) {
+	t.Parallel()
+
+	records := format.IssueRecords(nil, nil, []string{"id", "title"})
+	tests := map[string]string{
+		"csv":    "id,title\n",
+		"tsv":    "id\ttitle\n",
+		"json":   "[]\n",
+		"ndjson": "",
+		"yaml":   "[]\n",
+	}
+	for f, want := range tests {
+		var buf bytes.Buffer
+		if err := format.WriteRecords(&buf, records, f); err != nil {
+			t.Fatalf("WriteRecords(%s): %v", f, err)
+		}
+		if got := buf.String(); got != want {
+			t.Errorf("%s: got %q, want %q", f, got, want)
+		}
+	}
+}
+
+func TestValidateOutputFormat(t *testing.T) {
+	t.Parallel()
+
+	for _, f := range format.OutputFormats {
+		if err := format.ValidateOutputFormat(f); err != nil {
+			t.Errorf("ValidateOutputFormat(%q) = %v, want nil", f, err)
+		}
+	}
+	err := format.ValidateOutputFormat("xml")
+	if err == nil || !strings.Contains(err.Error(), "available: table, csv") {
+		t.Errorf("ValidateOutputFormat(xml) = %v, want unknown format error", err)
+	}
+}
@@ -0,0 +1,136 @@
+package format
+
+import (
+	"io"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+	"golang.org/x/term"
+)
+
+// _tableGap separates table columns.
+const _tableGap = "  "
+
+// _minFlexWidth is the narrowest a flexible column is shrunk to (unless its
+// content is already narrower).
+const _minFlexWidth = 10
+
+// _ellipsis marks truncated cell values.
+const _ellipsis = "…"
+
+// TableColumn describes one column of a table.
+type TableColumn struct {
+	Header string
+	// Flexible columns are truncated with an ellipsis when the table is
+	// wider than the available width.
+	Flexible bool
+}
+
+// TableCell is one value in a table row with its ANSI color code ("" for none).
+type TableCell struct {
+	Text  string
+	Color string
+}
+
+// TerminalWidth returns the width of w if it is a terminal, or 0 otherwise.
+// A width of 0 disables truncation in RenderTable.
+func TerminalWidth(w io.Writer) int {
+	f, ok := w.(fdWriter)
+	if !ok {
+		return 0
+	}
+	width, _, err := term.GetSize(int(f.Fd()))
+	if err != nil {
+		return 0
+	}
+	return width
+}
+
+// DisplayWidth returns the number of terminal cells s occupies, accounting
+// for wide (e.g. CJK) and zero-width characters.
+func DisplayWidth(s string) int {
+	return runewidth.StringWidth(s)
+}
+
+// RenderTable lays out rows under cols with aligned columns separated by two
+// spaces, sizing columns by display width. When maxWidth is positive and the
+// table is wider, flexible columns are shrunk (widest first, down to
+// _minFlexWidth) and their values truncated with an ellipsis. The last column
+// is not padded. When header is true the first line holds the bold headers.
+func RenderTable(cols []TableColumn, rows [][]TableCell, color, header bool, maxWidth int) string {
+	widths := columnWidths(cols, rows, header)
+	shrinkFlexible(cols, widths, maxWidth)
+
+	var buf strings.Builder
+	if header {
+		cells := make([]TableCell, len(cols))
+		for i, col := range cols {
+			cells[i] = TableCell{Text: col.Header, Color: Bold}
+		}
+		writeTableRow(&buf, cells, widths, color)
+	}
+	for _, row := range rows {
+		writeTableRow(&buf, row, widths, color)
+	}
+	return buf.String()
+}
+
+// columnWidths returns the natural display width of each column.
+func columnWidths(cols []TableColumn, rows [][]TableCell, header bool) []int {
+	widths := make([]int, len(cols))
+	if header {
+		for i, col := range cols {
+			widths[i] = DisplayWidth(col.Header)
+		}
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			if w := DisplayWidth(cell.Text); w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+	return widths
+}
+
+// shrinkFlexible narrows flexible columns in place until the table fits in
+// maxWidth or no flexible column can shrink further. The widest flexible
+// column gives up one cell at a time, so space is taken evenly.
+func shrinkFlexible(cols []TableColumn, widths []int, maxWidth int) {
+	if maxWidth <= 0 {
+		return
+	}
+	total := len(_tableGap) * (len(widths) - 1)
+	for _, w := range widths {
+		total += w
+	}
+	for ; total > maxWidth; total-- {
+		widest := -1
+		for i, col := range cols {
+			if col.Flexible && widths[i] > _minFlexWidth && (widest < 0 || widths[i] > widths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			return
+		}
+		widths[widest]--
+	}
+}
+
+func writeTableRow(buf *strings.Builder, cells []TableCell, widths []int, color bool) {
+	for i, cell := range cells {
+		if i > 0 {
+			buf.WriteString(_tableGap)
+		}
+		text := cell.Text
+		if DisplayWidth(text) > widths[i] {
+			text = runewidth.Truncate(text, widths[i], _ellipsis)
+		}
+		buf.WriteString(Colorize(color && cell.Color != "", cell.Color, text))
+		if pad := widths[i] - DisplayWidth(text); pad > 0 && i < len(cells)-1 {
+			buf.WriteString(strings.Repeat(" ", pad))
+		}
+	}
+	buf.WriteByte('\n')
+}
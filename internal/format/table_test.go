@@ -0,0 +1,142 @@
+package format_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/internal/format"
+)
+
+func TestRenderTable(t *testing.T) {
+	t.Parallel()
+
+	cols := []format.TableColumn{
+		{Header: "ID"},
+		{Header: "TITLE", Flexible: true},
+		{Header: "STATUS"},
+	}
+	rows := [][]format.TableCell{
+		{{Text: "ENG-1"}, {Text: "A rather long issue title"}, {Text: "Todo"}},
+		{{Text: "ENG-22"}, {Text: "Short"}, {Text: "Done"}},
+	}
+
+	tests := []struct {
+		name     string
+		maxWidth int
+		want     string
+	}{
+		{
+			name:     "no limit",
+			maxWidth: 0,
+			want: "ID      TITLE                      STATUS\n" +
+				"ENG-1   A rather long issue title  Todo\n" +
+				"ENG-22  Short                      Done\n",
+		},
+		{
+			name:     "fits",
+			maxWidth: 80,
+			want: "ID      TITLE                      STATUS\n" +
+				"ENG-1   A rather long issue title  Todo\n" +
+				"ENG-22  Short                      Done\n",
+		},
+		{
+			name:     "truncates flexible column",
+			maxWidth: 30,
+			want: "ID      TITLE           STATUS\n" +
+				"ENG-1   A rather long…  Todo\n" +
+				"ENG-22  Short           Done\n",
+		},
+		{
+			name:     "stops at minimum flexible width",
+			maxWidth: 10,
+			want: "ID      TITLE       STATUS\n" +
+				"ENG-1   A rather …  Todo\n" +
+				"ENG-22  Short       Done\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := format.RenderTable(cols, rows, false, true, tt.maxWidth)
+			if got != tt.want {
+				t.Errorf("RenderTable() =\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderTable_ShrinksWidestFlexibleColumnFirst(t *testing.T) {
+	t.Parallel()
+
+	cols := []format.TableColumn{
+		{Header: "A", Flexible: true},
+		{Header: "B", Flexible: true},
+	}
+	rows := [][]format.TableCell{
+		{{Text: strings.Repeat("a", 40)}, {Text: strings.Repeat("b", 20)}},
+	}
+
+	got := format.RenderTable(cols, rows, false, false, 32)
+	want := strings.Repeat("a", 14) + "…  " + strings.Repeat("b", 14) + "…\n"
+	if got != want {
+		t.Errorf("RenderTable() = %q, want %q", got, want)
+	}
+}
+
+func TestRenderTable_WideCharacters(t *testing.T) {
+	t.Parallel()
+
+	cols := []format.TableColumn{{Header: "TITLE", Flexible: true}, {Header: "ID"}}
+	rows := [][]format.TableCell{
+		{{Text: "日本語のタイトルです"}, {Text: "ENG-1"}},
+		{{Text: "abc"}, {Text: "ENG-2"}},
+	}
+
+	got := format.RenderTable(cols, rows, false, true, 0)
+	lines := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+	// Each wide character takes two cells, so the ID column starts at 22.
+	if w := format.DisplayWidth(lines[1][:strings.Index(lines[1], "ENG-1")]); w != 22 {
+		t.Errorf("ID column starts at cell %d, want 22:\n%s", w, got)
+	}
+	if w := format.DisplayWidth(lines[2][:strings.Index(lines[2], "ENG-2")]); w != 22 {
+		t.Errorf("ID column starts at cell %d, want 22:\n%s", w, got)
+	}
+
+	got = format.RenderTable(cols, rows, false, false, 19)
+	if want := "日本語のタ…   ENG-1\n"; !strings.HasPrefix(got, want) {
+		t.Errorf("truncated row = %q, want prefix %q", got, want)
+	}
+}
+
+func TestRenderTable_Color(t *testing.T) {
+	t.Parallel()
+
+	cols := []format.TableColumn{{Header: "NAME"}, {Header: "STATUS"}}
+	rows := [][]format.TableCell{{{Text: "Jane"}, {Text: "Active", Color: format.Green}}}
+
+	got := format.RenderTable(cols, rows, true, true, 0)
+	want := format.Bold + "NAME" + format.Reset + "  " + format.Bold + "STATUS" + format.Reset + "\n" +
+		"Jane  " + format.Green + "Active" + format.Reset + "\n"
+	if got != want {
+		t.Errorf("RenderTable() = %q, want %q", got, want)
+	}
+}
+
+func TestDisplayWidth(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]int{
+		"":     0,
+		"abc":  3,
+		"日本":   4,
+		"café": 4,
+		"a…":   2,
+		"🙂 ok": 5,
+	}
+	for s, want := range tests {
+		if got := format.DisplayWidth(s); got != want {
+			t.Errorf("DisplayWidth(%q) = %d, want %d", s, got, want)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package format
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -32,63 +33,27 @@ func StatusColor(active bool) string {
 }
 
 // FormatUserList formats a slice of users as an aligned table for terminal output.
-func FormatUserList(users []*api.ListUsersUsersUserConnectionNodesUser, color bool) string {
-	const gap = "  "
-
-	// Compute max visible widths per column.
-	maxName := len("NAME")
-	maxDisplay := len("DISPLAY NAME")
-	maxEmail := len("EMAIL")
-	maxRole := len("ROLE")
-	maxStatus := len("STATUS")
-	for _, u := range users {
-		if len(u.Name) > maxName {
-			maxName = len(u.Name)
-		}
-		if len(u.DisplayName) > maxDisplay {
-			maxDisplay = len(u.DisplayName)
-		}
-		if len(u.Email) > maxEmail {
-			maxEmail = len(u.Email)
-		}
-		if l := len(RoleLabel(u.Admin)); l > maxRole {
-			maxRole = l
-		}
-		if l := len(StatusLabel(u.Active)); l > maxStatus {
-			maxStatus = l
-		}
+// When width is positive, the name columns are truncated so rows fit in
+// width cells; 0 disables truncation.
+func FormatUserList(users []*api.ListUsersUsersUserConnectionNodesUser, color bool, width int) string {
+	cols := []TableColumn{
+		{Header: "NAME", Flexible: true},
+		{Header: "DISPLAY NAME", Flexible: true},
+		{Header: "EMAIL"},
+		{Header: "ROLE"},
+		{Header: "STATUS"},
 	}
-
-	var buf strings.Builder
-
-	// Header
-	buf.WriteString(PadColor(color, Bold, "NAME", maxName))
-	buf.WriteString(gap)
-	buf.WriteString(PadColor(color, Bold, "DISPLAY NAME", maxDisplay))
-	buf.WriteString(gap)
-	buf.WriteString(PadColor(color, Bold, "EMAIL", maxEmail))
-	buf.WriteString(gap)
-	buf.WriteString(PadColor(color, Bold, "ROLE", maxRole))
-	buf.WriteString(gap)
-	buf.WriteString(Colorize(color, Bold, "STATUS"))
-	buf.WriteByte('\n')
-
-	// Rows
-	for _, u := range users {
-		buf.WriteString(fmt.Sprintf("%-*s", maxName, u.Name))
-		buf.WriteString(gap)
-		buf.WriteString(fmt.Sprintf("%-*s", maxDisplay, u.DisplayName))
-		buf.WriteString(gap)
-		buf.WriteString(fmt.Sprintf("%-*s", maxEmail, u.Email))
-		buf.WriteString(gap)
-		buf.WriteString(fmt.Sprintf("%-*s", maxRole, RoleLabel(u.Admin)))
-		buf.WriteString(gap)
-
-		buf.WriteString(Colorize(color, StatusColor(u.Active), StatusLabel(u.Active)))
-		buf.WriteByte('\n')
+	rows := make([][]TableCell, len(users))
+	for i, u := range users {
+		rows[i] = []TableCell{
+			{Text: u.Name},
+			{Text: u.DisplayName},
+			{Text: u.Email},
+			{Text: RoleLabel(u.Admin)},
+			{Text: StatusLabel(u.Active), Color: StatusColor(u.Active)},
+		}
 	}
-
-	return buf.String()
+	return RenderTable(cols, rows, color, true, width)
 }
 
 // FormatUserDetail formats a single user in a detailed key-value format.
@@ -112,3 +77,31 @@ func FormatUserDetail(user *api.GetUserByDisplayNameUsersUserConnectionNodesUser
 
 	return buf.String()
 }
+
+// userDetailJSON is the serialization struct for JSON output. Keys match the
+// fields of UserRecords.
+type userDetailJSON struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	Email       string `json:"email"`
+	Admin       bool   `json:"admin"`
+	Active      bool   `json:"active"`
+	IsMe        bool   `json:"is_me"`
+}
+
+// FormatUserDetailJSON formats a single user as indented JSON.
+func FormatUserDetailJSON(user *api.GetUserByDisplayNameUsersUserConnectionNodesUser) (string, error) {
+	data := userDetailJSON{
+		Name:        user.Name,
+		DisplayName: user.DisplayName,
+		Email:       user.Email,
+		Admin:       user.Admin,
+		Active:      user.Active,
+		IsMe:        user.IsMe,
+	}
+	b, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling user to JSON: %w", err)
+	}
+	return string(b) + "\n", nil
+}
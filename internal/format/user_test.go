@@ -212,12 +212,35 @@ func TestFormatUserList(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := format.FormatUserList(tt.users, tt.color)
+			got := format.FormatUserList(tt.users, tt.color, 0)
 			tt.checks(t, got)
 		})
 	}
 }
 
+func TestFormatUserList_Width(t *testing.T) {
+	t.Parallel()
+
+	users := []*api.ListUsersUsersUserConnectionNodesUser{
+		{Name: "Maximilian Alexander Featherstonehaugh", DisplayName: "max", Email: "max@example.com", Active: true},
+	}
+
+	got := format.FormatUserList(users, false, 60)
+	lines := strings.Split(strings.TrimRight(got, "\n"), "\n")
+	for _, line := range lines {
+		if w := format.DisplayWidth(line); w > 60 {
+			t.Errorf("line %q is %d cells wide, want at most 60", line, w)
+		}
+	}
+	if !strings.Contains(lines[1], "Maximilian A…") || !strings.Contains(lines[1], "max@example.com") {
+		t.Errorf("expected truncated name and full email, got %q", lines[1])
+	}
+
+	if got := format.FormatUserList(users, false, 0); !strings.Contains(got, users[0].Name) {
+		t.Errorf("expected full name without a width limit, got:\n%s", got)
+	}
+}
+
 func TestFormatUserDetail(t *testing.T) {
 	t.Parallel()
 
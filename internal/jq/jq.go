@@ -0,0 +1,85 @@
+// Package jq filters the CLI's JSON output with jq expressions, using gojq so
+// that no external jq binary is needed. The whole jq language is supported,
+// see https://github.com/itchyny/gojq for the few differences with jq.
+//
+// Values follow encoding/json's generic representation: nil, bool, float64,
+// string, []any and map[string]any.
+package jq
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/itchyny/gojq"
+)
+
+// Query is a compiled jq expression.
+type Query struct {
+	expr string
+	code *gojq.Code
+}
+
+// Parse compiles a jq expression. Unknown functions are reported here, not
+// when the query runs.
+func Parse(expr string) (*Query, error) {
+	parsed, err := gojq.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("parsing jq expression: %w", err)
+	}
+	code, err := gojq.Compile(parsed)
+	if err != nil {
+		return nil, fmt.Errorf("parsing jq expression: %w", err)
+	}
+	return &Query{expr: expr, code: code}, nil
+}
+
+// Run evaluates the query against input and returns every output value.
+func (q *Query) Run(input any) ([]any, error) {
+	var out []any
+	iter := q.code.Run(input)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			return out, nil
+		}
+		if err, ok := v.(error); ok {
+			return nil, fmt.Errorf("jq %q: %w", q.expr, err)
+		}
+		out = append(out, v)
+	}
+}
+
+// Filter decodes the JSON document data, runs expr over it and writes each
+// output on its own line. Strings are written raw, like gh's --jq and
+// jq -r; other values are written as compact JSON.
+func Filter(w io.Writer, expr string, data []byte) error {
+	q, err := Parse(expr)
+	if err != nil {
+		return err
+	}
+	var input any
+	if err := json.Unmarshal(data, &input); err != nil {
+		return fmt.Errorf("decoding JSON for jq: %w", err)
+	}
+	out, err := q.Run(input)
+	if err != nil {
+		return err
+	}
+	var buf strings.Builder
+	for _, v := range out {
+		if s, ok := v.(string); ok {
+			buf.WriteString(s)
+		} else {
+			b, err := gojq.Marshal(v)
+			if err != nil {
+				return fmt.Errorf("encoding jq output: %w", err)
+			}
+			buf.Write(b)
+		}
+		buf.WriteByte('\n')
+	}
+	_, err = io.WriteString(w, buf.String())
+	return err
+}
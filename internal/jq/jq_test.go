@@ -0,0 +1,112 @@
+package jq_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/duboisf/linear/internal/jq"
+)
+
+const _issuesJSON = `[
+  {"id": "ENG-1", "title": "Fix login", "priority": 1, "labels": ["bug", "auth"], "assignee": "Alice"},
+  {"id": "ENG-2", "title": "Dark mode", "priority": 3, "labels": [], "assignee": null},
+  {"id": "ENG-3", "title": "Docs", "priority": 0, "labels": ["docs"], "assignee": "Bob"}
+]`
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{"identity length", "length", "3\n"},
+		{"iterate field", ".[].id", "ENG-1\nENG-2\nENG-3\n"},
+		{"pipe field", ".[] | .title", "Fix login\nDark mode\nDocs\n"},
+		{"index", ".[0].id", "ENG-1\n"},
+		{"negative index", ".[-1].id", "ENG-3\n"},
+		{"bracket string key", `.[1]["title"]`, "Dark mode\n"},
+		{"select equality", `.[] | select(.priority == 1) | .id`, "ENG-1\n"},
+		{"select and", `.[] | select(.priority > 0 and .assignee != null) | .id`, "ENG-1\n"},
+		{"select or", `.[] | select(.priority == 0 or .priority == 3) | .id`, "ENG-2\nENG-3\n"},
+		{"not", `.[] | select(.assignee | not) | .id`, "ENG-2\n"},
+		{"alternative", `.[] | .assignee // "unassigned"`, "Alice\nunassigned\nBob\n"},
+		{"comma", `.[0] | .id, .title`, "ENG-1\nFix login\n"},
+		{"map", `map(.priority)`, "[1,3,0]\n"},
+		{"array construction", `[.[] | .id]`, `["ENG-1","ENG-2","ENG-3"]` + "\n"},
+		{"object construction", `.[0] | {id, name: .title}`, `{"id":"ENG-1","name":"Fix login"}` + "\n"},
+		{"join", `.[0].labels | join(", ")`, "bug, auth\n"},
+		{"contains", `.[] | select(.labels | contains(["bug"])) | .id`, "ENG-1\n"},
+		{"test", `.[] | select(.title | test("^D")) | .id`, "ENG-2\nENG-3\n"},
+		{"startswith", `.[] | select(.id | startswith("ENG-2")) | .title`, "Dark mode\n"},
+		{"sort_by", `sort_by(.priority) | map(.id) | join(" ")`, "ENG-3 ENG-1 ENG-2\n"},
+		{"keys", `.[0] | keys`, `["assignee","id","labels","priority","title"]` + "\n"},
+		{"first last", `(first | .id), (last | .id)`, "ENG-1\nENG-3\n"},
+		{"unique", `[.[].labels[]] | unique`, `["auth","bug","docs"]` + "\n"},
+		{"type", `.[1].assignee | type`, "null\n"},
+		{"tostring", `.[0].priority | tostring`, "1\n"},
+		{"empty", `.[] | empty`, ""},
+		{"has", `.[0] | has("labels")`, "true\n"},
+		{"downcase", `.[0].id | ascii_downcase`, "eng-1\n"},
+		{"null index", `.[1].assignee.name`, "null\n"},
+		{"string interpolation", `.[0] | "\(.id): \(.title)"`, "ENG-1: Fix login\n"},
+		{"group_by", `group_by(.priority > 0) | map(length)`, "[1,2]\n"},
+		{"reduce", `reduce .[] as $i (0; . + $i.priority)`, "4\n"},
+		{"csv", `.[] | [.id, .title] | @csv`, "\"ENG-1\",\"Fix login\"\n\"ENG-2\",\"Dark mode\"\n\"ENG-3\",\"Docs\"\n"},
+		{"html is not escaped", `"<a & b>"`, "<a & b>\n"},
+		{"object keys are sorted", `{b: 1, a: "<x>"}`, `{"a":"<x>","b":1}` + "\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var buf bytes.Buffer
+			if err := jq.Filter(&buf, tt.expr, []byte(_issuesJSON)); err != nil {
+				t.Fatalf("Filter(%q): %v", tt.expr, err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Filter(%q) = %q, want %q", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilter_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{"unknown function", "frobnicate", "function not defined: frobnicate/0"},
+		{"wrong arity", "select", "function not defined: select/0"},
+		{"unterminated string", `.["foo]`, "unterminated string"},
+		{"trailing token", ".id )", `unexpected token ")"`},
+		{"missing bracket", ".[0", "unexpected EOF"},
+		{"index string with key", `.[0].id.name`, `expected an object but got: string ("ENG-1")`},
+		{"iterate number", `.[0].priority[]`, "cannot iterate over: number (1)"},
+		{"undefined variable", ".id | $x", "variable not defined: $x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := jq.Filter(&bytes.Buffer{}, tt.expr, []byte(_issuesJSON))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Filter(%q) error = %v, want containing %q", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFilter_InvalidJSON(t *testing.T) {
+	t.Parallel()
+
+	err := jq.Filter(&bytes.Buffer{}, ".", []byte("{"))
+	if err == nil || !strings.Contains(err.Error(), "decoding JSON") {
+		t.Errorf("expected decoding error, got %v", err)
+	}
+}
@@ -0,0 +1,171 @@
+package prompt
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/duboisf/linear/internal/api"
+	"github.com/duboisf/linear/internal/format"
+)
+
+type issueListNode = api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue
+
+// Column is a user-defined issue list column. Template and Color are Go
+// templates executed with the issue's IssueData, built with
+// NewIssueDataFromList.
+type Column struct {
+	// Name is used with --column. Lowercase letters, digits, '-' and '_'.
+	Name string
+	// Header is the table header. Empty uses the upper-cased name.
+	Header string
+	// Template renders the cell value, e.g. "{{daysSince .UpdatedAt}}d".
+	Template string
+	// Color is a color name ("red", "gray", ...), "state" or "priority" for
+	// the built-in rules, or a template rendering one of those names.
+	Color string
+	// Width truncates longer values; 0 means no limit.
+	Width int
+}
+
+// NewColumns returns the issue list columns: the built-in ones plus cols.
+// now is the clock of the daysSince template func.
+func NewColumns(cols []Column, now func() time.Time) (*format.Columns, error) {
+	set := &format.Columns{}
+	funcs := columnFuncs(now)
+	for _, c := range cols {
+		def, err := newColumnDef(c, funcs)
+		if err != nil {
+			return nil, err
+		}
+		if err := set.Add(c.Name, def); err != nil {
+			return nil, err
+		}
+	}
+	return set, nil
+}
+
+// ValidateColumn reports the error NewColumns would return for c, and
+// whether its templates fail on sample, such as for an unknown field.
+func ValidateColumn(c Column, sample IssueData) error {
+	funcs := columnFuncs(time.Now)
+	if _, err := newColumnDef(c, funcs); err != nil {
+		return err
+	}
+	// newColumnDef parsed both templates, so Must can't panic.
+	for _, t := range []struct{ field, text string }{{"template", c.Template}, {"color", c.Color}} {
+		tmpl := template.Must(template.New(c.Name).Funcs(funcs).Parse(t.text))
+		if err := tmpl.Execute(&bytes.Buffer{}, sample); err != nil {
+			return fmt.Errorf("column %q: executing %s: %w", c.Name, t.field, err)
+		}
+	}
+	return nil
+}
+
+// columnFuncs returns the funcs of column templates: the --template funcs
+// plus daysSince, which counts the days from an RFC 3339 timestamp or
+// YYYY-MM-DD date to now.
+func columnFuncs(now func() time.Time) template.FuncMap {
+	funcs := outputFuncs(false)
+	funcs["daysSince"] = func(value string) int {
+		t, ok := parseDate(value)
+		if !ok {
+			return 0
+		}
+		return int(now().Sub(t).Hours() / 24)
+	}
+	return funcs
+}
+
+func newColumnDef(c Column, funcs template.FuncMap) (format.ColumnDef, error) {
+	if err := format.ValidateColumnName(c.Name); err != nil {
+		return format.ColumnDef{}, err
+	}
+	if c.Template == "" {
+		return format.ColumnDef{}, fmt.Errorf("column %q: template is required", c.Name)
+	}
+	if c.Width < 0 {
+		return format.ColumnDef{}, fmt.Errorf("column %q: width must not be negative", c.Name)
+	}
+	valueTmpl, err := template.New(c.Name).Funcs(funcs).Parse(c.Template)
+	if err != nil {
+		return format.ColumnDef{}, fmt.Errorf("column %q: parsing template: %w", c.Name, err)
+	}
+	color, err := columnColor(c, funcs)
+	if err != nil {
+		return format.ColumnDef{}, err
+	}
+
+	header := c.Header
+	if header == "" {
+		header = strings.ToUpper(c.Name)
+	}
+	value := func(issue *issueListNode) string { return executeColumnTemplate(valueTmpl, issue) }
+	return format.ColumnDef{
+		Header: header,
+		Value:  value,
+		Color:  color,
+		Raw:    func(issue *issueListNode) any { return value(issue) },
+		Width:  c.Width,
+	}, nil
+}
+
+// columnColor compiles the Color rule of c.
+func columnColor(c Column, funcs template.FuncMap) (func(*issueListNode) string, error) {
+	if c.Color == "" {
+		return func(*issueListNode) string { return "" }, nil
+	}
+	if !strings.Contains(c.Color, "{{") {
+		if _, err := colorRule(c.Color, nil); err != nil {
+			return nil, fmt.Errorf("column %q: %w", c.Name, err)
+		}
+		return func(issue *issueListNode) string {
+			code, _ := colorRule(c.Color, issue)
+			return code
+		}, nil
+	}
+	tmpl, err := template.New(c.Name + "-color").Funcs(funcs).Parse(c.Color)
+	if err != nil {
+		return nil, fmt.Errorf("column %q: parsing color template: %w", c.Name, err)
+	}
+	return func(issue *issueListNode) string {
+		code, _ := colorRule(strings.TrimSpace(executeColumnTemplate(tmpl, issue)), issue)
+		return code
+	}, nil
+}
+
+// colorRule resolves a color name for issue. "state" and "priority" use the
+// built-in status and priority palettes; an empty name means no color.
+func colorRule(name string, issue *issueListNode) (string, error) {
+	switch name {
+	case "":
+		return "", nil
+	case "state":
+		if issue != nil && issue.State != nil {
+			return format.StateColor(issue.State.Type), nil
+		}
+		return "", nil
+	case "priority":
+		if issue != nil {
+			return format.PriorityColor(issue.Priority), nil
+		}
+		return "", nil
+	}
+	code, ok := format.ColorCode(name)
+	if !ok {
+		return "", fmt.Errorf("unknown color %q", name)
+	}
+	return code, nil
+}
+
+// executeColumnTemplate renders tmpl for issue. Execution errors render as
+// an empty cell.
+func executeColumnTemplate(tmpl *template.Template, issue *issueListNode) string {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, NewIssueDataFromList(issue)); err != nil {
+		return ""
+	}
+	return buf.String()
+}
@@ -0,0 +1,91 @@
+package prompt
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/duboisf/linear/internal/api"
+	"github.com/duboisf/linear/internal/format"
+)
+
+func TestNewColumns(t *testing.T) {
+	now := func() time.Time { return time.Date(2025, 6, 18, 12, 0, 0, 0, time.UTC) }
+	set, err := NewColumns([]Column{
+		{Name: "age", Template: "{{daysSince .UpdatedAt}}d", Color: `{{if gt (daysSince .UpdatedAt) 1}}red{{end}}`},
+		{Name: "parent", Header: "PARENT", Template: "{{.Parent}}", Color: "gray"},
+		{Name: "state", Template: "{{.State | lower}}", Color: "state"},
+		{Name: "short", Template: "{{.Title}}", Width: 6},
+	}, now)
+	if err != nil {
+		t.Fatalf("NewColumns: %v", err)
+	}
+
+	issues := []*api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue{
+		{
+			Identifier: "ENG-1",
+			Title:      "A long title",
+			UpdatedAt:  "2025-06-15T10:00:00Z",
+			State:      &api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueStateWorkflowState{Name: "In Progress", Type: "started"},
+			Parent:     &api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueParentIssue{Identifier: "ENG-0"},
+		},
+	}
+	out := format.FormatIssueList(issues, true, set, []string{"id", "age", "parent", "state", "short"}, 0)
+	for _, want := range []string{
+		format.Red + "3d",
+		format.Gray + "ENG-0",
+		format.StateColor("started") + "in progress",
+		"A lon…",
+		"PARENT",
+		"AGE",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output should contain %q, got %q", want, out)
+		}
+	}
+
+	records := format.IssueRecords(issues, set, []string{"short", "age"})
+	if got := records.Rows[0]; got[0] != "A long title" || got[1] != "3d" {
+		t.Errorf("raw values = %v, want untruncated title and age", got)
+	}
+}
+
+func TestNewColumns_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		col     Column
+		wantErr string
+	}{
+		{"builtin name", Column{Name: "title", Template: "x"}, "conflicts with a built-in"},
+		{"invalid name", Column{Name: "Bad Name", Template: "x"}, "name must match"},
+		{"missing template", Column{Name: "empty"}, "template is required"},
+		{"bad template", Column{Name: "bad", Template: "{{.Title"}, "parsing template"},
+		{"unknown color", Column{Name: "color", Template: "x", Color: "mauve"}, `unknown color "mauve"`},
+		{"negative width", Column{Name: "width", Template: "x", Width: -1}, "width must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewColumns([]Column{tt.col}, time.Now)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("NewColumns error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateColumn(t *testing.T) {
+	sample := IssueData{Identifier: "ENG-1", UpdatedAt: "2025-06-15T10:00:00Z"}
+
+	if err := ValidateColumn(Column{Name: "age", Template: "{{daysSince .UpdatedAt}}d"}, sample); err != nil {
+		t.Errorf("valid column: %v", err)
+	}
+	err := ValidateColumn(Column{Name: "stage", Template: "{{.State.Name}}"}, sample)
+	if err == nil || !strings.Contains(err.Error(), `column "stage": executing template`) {
+		t.Errorf("expected an execution error for a node field, got %v", err)
+	}
+	err = ValidateColumn(Column{Name: "hot", Template: "x", Color: "{{.Nope}}"}, sample)
+	if err == nil || !strings.Contains(err.Error(), "executing color") {
+		t.Errorf("expected an execution error for the color, got %v", err)
+	}
+}
@@ -0,0 +1,179 @@
+package prompt
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/duboisf/linear/internal/api"
+)
+
+// ContextData is the data of the Markdown context documents rendered by
+// "issue prompt" for coding assistants: the issue's fields, unquoted, plus
+// its sub-issues, comments and acceptance criteria.
+type ContextData struct {
+	IssueData
+	SubIssues []ContextIssue
+	// Comments are in the order they were written.
+	Comments []ContextComment
+	// CommentsTruncated is set when the issue has older comments than
+	// those in Comments.
+	CommentsTruncated bool
+	// AcceptanceCriteria are the list items of the description's
+	// "Acceptance criteria" section, see AcceptanceCriteria.
+	AcceptanceCriteria []string
+}
+
+// ContextIssue is a sub-issue in ContextData.
+type ContextIssue struct {
+	Identifier string
+	Title      string
+	State      string
+}
+
+// ContextComment is a comment in ContextData.
+type ContextComment struct {
+	Author    string
+	CreatedAt string
+	Body      string
+}
+
+// NewContextData constructs a ContextData from an IssueContext response:
+// the issue and its sub-issues and latest comments.
+func NewContextData(issue *api.GetIssueIssue, related *api.IssueContextRelatedIssue) ContextData {
+	d := ContextData{IssueData: NewIssueData(issue)}
+	d.AcceptanceCriteria = AcceptanceCriteria(d.Description)
+	var children []*api.IssueContextRelatedIssueChildrenIssueConnectionNodesIssue
+	var comments []*api.IssueContextRelatedIssueCommentsCommentConnectionNodesComment
+	if related != nil && related.Children != nil {
+		children = related.Children.Nodes
+	}
+	if related != nil && related.Comments != nil {
+		comments = related.Comments.Nodes
+		d.CommentsTruncated = related.Comments.PageInfo != nil && related.Comments.PageInfo.HasNextPage
+	}
+	for _, c := range children {
+		sub := ContextIssue{Identifier: c.Identifier, Title: c.Title}
+		if c.State != nil {
+			sub.State = c.State.Name
+		}
+		d.SubIssues = append(d.SubIssues, sub)
+		d.Children = append(d.Children, c.Identifier)
+	}
+	d.CommentCount = len(comments)
+	for _, c := range comments {
+		comment := ContextComment{CreatedAt: c.CreatedAt, Body: c.Body}
+		if c.User != nil {
+			comment.Author = c.User.Name
+		}
+		d.Comments = append(d.Comments, comment)
+	}
+	// RFC 3339 timestamps of the API sort as strings.
+	slices.SortStableFunc(d.Comments, func(a, b ContextComment) int {
+		return strings.Compare(a.CreatedAt, b.CreatedAt)
+	})
+	return d
+}
+
+// _listItemRe matches a Markdown list item, with an optional task checkbox,
+// capturing its text.
+var _listItemRe = regexp.MustCompile(`^\s*(?:[-*+]|\d+[.)])\s+(?:\[[ xX]\]\s+)?(.*)$`)
+
+// AcceptanceCriteria returns the list items following a line of description
+// that reads "Acceptance criteria", as a heading ("## Acceptance Criteria"),
+// in bold or followed by a colon. The section ends at the next heading and,
+// once it has items, at the next label line (in bold or ending with a colon)
+// or at the first paragraph after the list.
+func AcceptanceCriteria(description string) []string {
+	var criteria []string
+	in, blank := false, false
+	for _, line := range strings.Split(description, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !in {
+			title := strings.ToLower(strings.Trim(trimmed, "#*_: "))
+			in = strings.HasPrefix(title, "acceptance criteri")
+			continue
+		}
+		if strings.HasPrefix(trimmed, "#") {
+			break
+		}
+		m := _listItemRe.FindStringSubmatch(line)
+		if m == nil && len(criteria) > 0 {
+			// Indented lines continue the last item.
+			paragraph := blank && trimmed != "" && trimmed == line
+			if paragraph || isLabel(trimmed) {
+				break
+			}
+		}
+		blank = trimmed == ""
+		if m != nil {
+			criteria = append(criteria, strings.TrimSpace(m[1]))
+		}
+	}
+	return criteria
+}
+
+// isLabel reports whether a trimmed line labels a section, like "**Notes**"
+// or "Out of scope:".
+func isLabel(line string) bool {
+	if strings.HasSuffix(line, ":") {
+		return true
+	}
+	for _, mark := range []string{"**", "__"} {
+		if len(line) > 2*len(mark) && strings.HasPrefix(line, mark) && strings.HasSuffix(line, mark) {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultContextTemplate is the template of "issue prompt" when no prompt
+// is named and the config has none named "default".
+const DefaultContextTemplate = `# {{.Identifier}}: {{.Title}}
+
+- URL: {{.URL}}
+- Branch: ` + "`{{.BranchName}}`" + `
+- State: {{.State}}
+- Priority: {{.Priority}}
+{{- with .Labels}}
+- Labels: {{join . ", "}}
+{{- end}}
+{{- with .Project}}
+- Project: {{.}}
+{{- end}}
+{{- if .Parent}}
+- Parent: {{.Parent}} {{.ParentTitle}}
+{{- end}}
+
+## Description
+
+{{with .Description}}{{.}}{{else}}No description.{{end}}
+{{- with .AcceptanceCriteria}}
+
+## Acceptance Criteria
+{{range .}}
+- [ ] {{.}}
+{{- end}}
+{{- end}}
+{{- with .SubIssues}}
+
+## Sub-issues
+{{range .}}
+- {{.Identifier}}: {{.Title}}{{with .State}} ({{.}}){{end}}
+{{- end}}
+{{- end}}
+{{- with .Comments}}
+
+## Comments
+{{- if $.CommentsTruncated}}
+
+Older comments are left out; these are the latest {{len .}}.
+{{- end}}
+{{- range .}}
+
+### {{.Author | default "Unknown"}}, {{date "2006-01-02" .CreatedAt}}
+
+{{.Body}}
+{{- end}}
+{{- end}}
+`
@@ -0,0 +1,197 @@
+package prompt
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/duboisf/linear/internal/api"
+)
+
+func TestAcceptanceCriteria(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		description string
+		want        []string
+	}{
+		{
+			name:        "heading",
+			description: "Users can't log in.\n\n## Acceptance Criteria\n\n- Login works\n- [ ] Errors are shown\n* [x] Tested on Safari\n\n## Notes\n\n- Not a criterion",
+			want:        []string{"Login works", "Errors are shown", "Tested on Safari"},
+		},
+		{
+			name:        "bold with colon",
+			description: "**Acceptance criteria:**\n1. First\n2) Second",
+			want:        []string{"First", "Second"},
+		},
+		{
+			name:        "plain with colon",
+			description: "Acceptance criterion:\n  - Nested item",
+			want:        []string{"Nested item"},
+		},
+		{
+			name:        "ends at a bold label",
+			description: "**Acceptance criteria**\n- a\n\n**Out of scope**\n- b",
+			want:        []string{"a"},
+		},
+		{
+			name:        "ends at a colon label",
+			description: "Acceptance criteria:\n- a\n\nNotes:\n- b",
+			want:        []string{"a"},
+		},
+		{
+			name:        "ends at a paragraph",
+			description: "Acceptance criteria:\n- a\n  continued\n\n  More on a.\n- b\n\nThanks!\n- c",
+			want:        []string{"a", "b"},
+		},
+		{
+			name:        "none",
+			description: "Just a description.\n\n- A list item",
+		},
+		{
+			name: "empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := AcceptanceCriteria(tt.description); !slices.Equal(got, tt.want) {
+				t.Errorf("AcceptanceCriteria() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewContextData(t *testing.T) {
+	t.Parallel()
+
+	desc := "Fix it.\n\n## Acceptance criteria\n- Works"
+	issue := &api.GetIssueIssue{Identifier: "AIS-1", Title: "Fix it", Description: &desc}
+	related := &api.IssueContextRelatedIssue{
+		Children: &api.IssueContextRelatedIssueChildrenIssueConnection{
+			Nodes: []*api.IssueContextRelatedIssueChildrenIssueConnectionNodesIssue{
+				{Identifier: "AIS-2", Title: "Part one", State: &api.IssueContextRelatedIssueChildrenIssueConnectionNodesIssueStateWorkflowState{Name: "Done"}},
+				{Identifier: "AIS-3", Title: "Part two"},
+			},
+		},
+		Comments: &api.IssueContextRelatedIssueCommentsCommentConnection{
+			Nodes: []*api.IssueContextRelatedIssueCommentsCommentConnectionNodesComment{
+				{Body: "Second", CreatedAt: "2026-02-02T10:00:00.000Z"},
+				{Body: "First", CreatedAt: "2026-02-01T10:00:00.000Z", User: &api.IssueContextRelatedIssueCommentsCommentConnectionNodesCommentUser{Name: "Alice"}},
+			},
+			PageInfo: &api.IssueContextRelatedIssueCommentsCommentConnectionPageInfo{HasNextPage: true},
+		},
+	}
+
+	got := NewContextData(issue, related)
+	if got.Identifier != "AIS-1" || got.Description != desc {
+		t.Errorf("issue fields = %q, %q", got.Identifier, got.Description)
+	}
+	wantSubs := []ContextIssue{{"AIS-2", "Part one", "Done"}, {"AIS-3", "Part two", ""}}
+	if !slices.Equal(got.SubIssues, wantSubs) {
+		t.Errorf("SubIssues = %+v, want %+v", got.SubIssues, wantSubs)
+	}
+	if !slices.Equal(got.Children, []string{"AIS-2", "AIS-3"}) || got.CommentCount != 2 {
+		t.Errorf("Children, CommentCount = %v, %d", got.Children, got.CommentCount)
+	}
+	wantComments := []ContextComment{
+		{Author: "Alice", CreatedAt: "2026-02-01T10:00:00.000Z", Body: "First"},
+		{CreatedAt: "2026-02-02T10:00:00.000Z", Body: "Second"},
+	}
+	if !slices.Equal(got.Comments, wantComments) {
+		t.Errorf("Comments = %+v, want %+v", got.Comments, wantComments)
+	}
+	if !got.CommentsTruncated {
+		t.Error("CommentsTruncated should be set when there are more comments")
+	}
+	if !slices.Equal(got.AcceptanceCriteria, []string{"Works"}) {
+		t.Errorf("AcceptanceCriteria = %q", got.AcceptanceCriteria)
+	}
+}
+
+func TestRenderRaw_DefaultContextTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data ContextData
+		want string
+	}{
+		{
+			name: "full",
+			data: ContextData{
+				IssueData: IssueData{
+					Identifier:  "AIS-1",
+					Title:       "Fix login",
+					Description: "Users can't log in.",
+					URL:         "https://linear.app/t/AIS-1",
+					BranchName:  "fred/ais-1-fix-login",
+					State:       "Todo",
+					Priority:    "High",
+					Labels:      []string{"bug", "auth"},
+					Project:     "Auth",
+					Parent:      "AIS-0",
+					ParentTitle: "Login revamp",
+				},
+				AcceptanceCriteria: []string{"Login works"},
+				SubIssues:          []ContextIssue{{"AIS-2", "Part one", "Done"}},
+				Comments: []ContextComment{
+					{Author: "Alice", CreatedAt: "2026-02-01T10:00:00.000Z", Body: "Seen on Safari."},
+					{CreatedAt: "2026-02-02T10:00:00.000Z", Body: "Fixed?"},
+				},
+			},
+			want: "# AIS-1: Fix login\n\n" +
+				"- URL: https://linear.app/t/AIS-1\n" +
+				"- Branch: `fred/ais-1-fix-login`\n" +
+				"- State: Todo\n" +
+				"- Priority: High\n" +
+				"- Labels: bug, auth\n" +
+				"- Project: Auth\n" +
+				"- Parent: AIS-0 Login revamp\n\n" +
+				"## Description\n\nUsers can't log in.\n\n" +
+				"## Acceptance Criteria\n\n- [ ] Login works\n\n" +
+				"## Sub-issues\n\n- AIS-2: Part one (Done)\n\n" +
+				"## Comments\n\n### Alice, 2026-02-01\n\nSeen on Safari.\n\n" +
+				"### Unknown, 2026-02-02\n\nFixed?\n",
+		},
+		{
+			name: "truncated comments",
+			data: ContextData{
+				IssueData:         IssueData{Identifier: "AIS-1", Title: "Fix login", State: "Todo", Priority: "None"},
+				Comments:          []ContextComment{{Author: "Alice", CreatedAt: "2026-02-01T10:00:00.000Z", Body: "Seen on Safari."}},
+				CommentsTruncated: true,
+			},
+			want: "# AIS-1: Fix login\n\n" +
+				"- URL: \n" +
+				"- Branch: ``\n" +
+				"- State: Todo\n" +
+				"- Priority: None\n\n" +
+				"## Description\n\nNo description.\n\n" +
+				"## Comments\n\nOlder comments are left out; these are the latest 1.\n\n" +
+				"### Alice, 2026-02-01\n\nSeen on Safari.\n",
+		},
+		{
+			name: "minimal",
+			data: ContextData{IssueData: IssueData{Identifier: "AIS-1", Title: "Fix login", State: "Todo", Priority: "None"}},
+			want: "# AIS-1: Fix login\n\n" +
+				"- URL: \n" +
+				"- Branch: ``\n" +
+				"- State: Todo\n" +
+				"- Priority: None\n\n" +
+				"## Description\n\nNo description.\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := RenderRaw(DefaultContextTemplate, tt.data)
+			if err != nil {
+				t.Fatalf("RenderRaw() error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RenderRaw() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
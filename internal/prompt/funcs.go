@@ -0,0 +1,118 @@
+package prompt
+
+import (
+	"os"
+	"strings"
+	"text/template"
+	"time"
+	"unicode"
+)
+
+// templateFuncs are the helper functions of templates whose fields are not
+// shell-quoted: RenderRaw and --template output.
+var templateFuncs = template.FuncMap{
+	"raw":        func(s string) string { return s },
+	"lower":      strings.ToLower,
+	"join":       strings.Join,
+	"slug":       slug,
+	"trunc":      trunc,
+	"default":    defaultValue,
+	"date":       formatDate,
+	"env":        os.Getenv,
+	"shellquote": ShellQuote,
+}
+
+// shellTemplateFuncs are the helper functions of command templates, whose
+// fields are shell-quoted. A func given a quoted field unquotes it and
+// quotes its result again, so {{.Title | trunc 20}} stays safe; given a
+// .Raw field, its result is raw too. Environment variables are quoted, and
+// shellquote leaves quoted fields as they are.
+var shellTemplateFuncs = template.FuncMap{
+	"raw":   func(s string) string { return s },
+	"lower": requoting(strings.ToLower),
+	"join":  strings.Join,
+	"slug":  requoting(slug),
+	"trunc": func(n int, s string) string {
+		return requoting(func(v string) string { return trunc(n, v) })(s)
+	},
+	"default": func(def, s string) string {
+		return requoting(func(v string) string { return defaultValue(def, v) })(s)
+	},
+	"date": func(layout, s string) string {
+		return requoting(func(v string) string { return formatDate(layout, v) })(s)
+	},
+	"env": func(name string) string { return ShellQuote(os.Getenv(name)) },
+	"shellquote": func(s string) string {
+		if _, ok := shellUnquote(s); ok {
+			return s
+		}
+		return ShellQuote(s)
+	},
+}
+
+// requoting wraps f so that a shell-quoted argument is unquoted before
+// calling f, and the result quoted again.
+func requoting(f func(string) string) func(string) string {
+	return func(s string) string {
+		if v, ok := shellUnquote(s); ok {
+			return ShellQuote(f(v))
+		}
+		return f(s)
+	}
+}
+
+// slug lowercases s and replaces each run of characters other than
+// letters and digits with a dash, e.g. "Fix: login (Safari)" becomes
+// "fix-login-safari".
+func slug(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	return b.String()
+}
+
+// trunc shortens s to at most n runes.
+func trunc(n int, s string) string {
+	runes := []rune(s)
+	if n < 0 || len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
+
+// defaultValue returns s, or def when s is empty.
+func defaultValue(def, s string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+// formatDate reformats an RFC 3339 timestamp or YYYY-MM-DD date with a Go
+// time layout. Values that don't parse are returned unchanged.
+func formatDate(layout, value string) string {
+	if t, ok := parseDate(value); ok {
+		return t.Format(layout)
+	}
+	return value
+}
+
+// parseDate parses an RFC 3339 timestamp or a YYYY-MM-DD date.
+func parseDate(value string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
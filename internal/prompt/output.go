@@ -0,0 +1,122 @@
+package prompt
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/duboisf/linear/internal/api"
+	"github.com/duboisf/linear/internal/format"
+)
+
+// NewIssueDataFromList constructs an IssueData from an issue list node. List
+// queries fetch fewer fields than GetIssue, so Description, URL, Team, the
+// assignee's email, cycle dates, parent title, sub-issues and comment count
+// are left empty.
+func NewIssueDataFromList(issue *api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue) IssueData {
+	d := IssueData{
+		Identifier: issue.Identifier,
+		Title:      issue.Title,
+		BranchName: issue.BranchName,
+		Priority:   format.PriorityLabel(issue.Priority),
+		CreatedAt:  issue.CreatedAt,
+		UpdatedAt:  issue.UpdatedAt,
+	}
+	if issue.Estimate != nil {
+		d.Estimate = strconv.FormatFloat(*issue.Estimate, 'f', -1, 64)
+	}
+	if issue.State != nil {
+		d.State = issue.State.Name
+	}
+	if issue.Assignee != nil {
+		d.Assignee = issue.Assignee.Name
+	}
+	if issue.Cycle != nil {
+		if issue.Cycle.Name != nil {
+			d.Cycle = *issue.Cycle.Name
+		}
+		d.CycleNumber = int(issue.Cycle.Number)
+	}
+	if issue.Project != nil {
+		d.Project = issue.Project.Name
+	}
+	if issue.Labels != nil {
+		for _, l := range issue.Labels.Nodes {
+			d.Labels = append(d.Labels, l.Name)
+		}
+	}
+	if issue.DueDate != nil {
+		d.DueDate = *issue.DueDate
+	}
+	if issue.Parent != nil {
+		d.Parent = issue.Parent.Identifier
+	}
+	return d
+}
+
+// outputFuncs returns the template funcs for --template output: the
+// helper funcs plus priority and color. color only emits ANSI codes when
+// enabled is true.
+func outputFuncs(enabled bool) template.FuncMap {
+	funcs := template.FuncMap{
+		"priority": priorityLabel,
+		"color": func(name string, v any) (string, error) {
+			code, ok := format.ColorCode(name)
+			if !ok {
+				return "", fmt.Errorf("unknown color %q", name)
+			}
+			return format.Colorize(enabled, code, fmt.Sprint(v)), nil
+		},
+	}
+	for name, fn := range templateFuncs {
+		funcs[name] = fn
+	}
+	return funcs
+}
+
+// priorityLabel converts a numeric priority to its label. Strings (such as
+// IssueData.Priority, which is already a label) are returned as-is.
+func priorityLabel(v any) string {
+	switch p := v.(type) {
+	case float64:
+		return format.PriorityLabel(p)
+	case int:
+		return format.PriorityLabel(float64(p))
+	}
+	return fmt.Sprint(v)
+}
+
+// OutputTemplate renders --template output, one execution per item.
+type OutputTemplate struct {
+	tmpl *template.Template
+}
+
+// ParseOutput parses a --template value. Fields are not shell-quoted; color
+// controls whether the color func emits ANSI codes.
+func ParseOutput(tmpl string, color bool) (*OutputTemplate, error) {
+	t, err := template.New("output").Funcs(outputFuncs(color)).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("parsing --template: %w", err)
+	}
+	return &OutputTemplate{tmpl: t}, nil
+}
+
+// Execute renders the template once per item and writes the results to w,
+// adding a trailing newline to each rendering that lacks one.
+func (o *OutputTemplate) Execute(w io.Writer, items ...any) error {
+	var buf bytes.Buffer
+	for _, item := range items {
+		start := buf.Len()
+		if err := o.tmpl.Execute(&buf, item); err != nil {
+			return fmt.Errorf("executing --template: %w", err)
+		}
+		if buf.Len() > start && !strings.HasSuffix(buf.String(), "\n") {
+			buf.WriteByte('\n')
+		}
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
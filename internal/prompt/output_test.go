@@ -0,0 +1,99 @@
+package prompt
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/duboisf/linear/internal/api"
+)
+
+func TestParseOutput_Funcs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		tmpl  string
+		data  any
+		color bool
+		want  string
+	}{
+		{"date from timestamp", `{{date "2006-01-02" .}}`, "2025-01-15T10:30:00Z", false, "2025-01-15\n"},
+		{"date from day", `{{date "Jan 2, 2006" .}}`, "2025-03-01", false, "Mar 1, 2025\n"},
+		{"date passthrough", `{{date "2006" .}}`, "soon", false, "soon\n"},
+		{"priority number", `{{priority .}}`, 1.0, false, "Urgent\n"},
+		{"priority label", `{{priority .}}`, "High", false, "High\n"},
+		{"color disabled", `{{color "red" .}}`, "x", false, "x\n"},
+		{"color enabled", `{{color "green" .}}`, "x", true, "\033[32mx\033[0m\n"},
+		{"join and lower", `{{join . "," | lower}}`, []string{"A", "B"}, false, "a,b\n"},
+		{"slug", `{{slug .}}`, "Fix: login (Safari)!", false, "fix-login-safari\n"},
+		{"trunc", `{{trunc 3 .}}`, "héllo", false, "hél\n"},
+		{"default empty", `{{default "none" .}}`, "", false, "none\n"},
+		{"default set", `{{. | default "none"}}`, "x", false, "x\n"},
+		{"shellquote", `{{shellquote .}}`, "it's", false, "'it'\\''s'\n"},
+		{"keeps trailing newline", "{{.}}\n", "x", false, "x\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tmpl, err := ParseOutput(tt.tmpl, tt.color)
+			if err != nil {
+				t.Fatalf("ParseOutput: %v", err)
+			}
+			var buf bytes.Buffer
+			if err := tmpl.Execute(&buf, tt.data); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseOutput_UnknownColor(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := ParseOutput(`{{color "mauve" .}}`, true)
+	if err != nil {
+		t.Fatalf("ParseOutput: %v", err)
+	}
+	if err := tmpl.Execute(&bytes.Buffer{}, "x"); err == nil {
+		t.Error("expected error for unknown color")
+	}
+}
+
+func TestNewIssueDataFromList(t *testing.T) {
+	t.Parallel()
+
+	cycleName := "Sprint 3"
+	due := "2025-02-01"
+	d := NewIssueDataFromList(&api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssue{
+		Identifier: "ENG-7",
+		Title:      "Thing",
+		BranchName: "eng-7-thing",
+		Priority:   2,
+		State:      &api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueStateWorkflowState{Name: "Todo"},
+		Cycle:      &api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueCycle{Number: 3, Name: &cycleName},
+		DueDate:    &due,
+		Labels: &api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueLabelsIssueLabelConnection{
+			Nodes: []*api.ListMyIssuesViewerUserAssignedIssuesIssueConnectionNodesIssueLabelsIssueLabelConnectionNodesIssueLabel{{Name: "bug"}},
+		},
+	})
+
+	want := IssueData{
+		Identifier:  "ENG-7",
+		Title:       "Thing",
+		BranchName:  "eng-7-thing",
+		Priority:    "High",
+		State:       "Todo",
+		Cycle:       "Sprint 3",
+		CycleNumber: 3,
+		DueDate:     "2025-02-01",
+		Labels:      []string{"bug"},
+	}
+	if !reflect.DeepEqual(d, want) {
+		t.Errorf("NewIssueDataFromList = %+v, want %+v", d, want)
+	}
+}
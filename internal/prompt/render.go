@@ -2,6 +2,7 @@ package prompt
 
 import (
 	"bytes"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -11,21 +12,38 @@ import (
 
 // IssueData holds flattened issue fields for Go template rendering.
 type IssueData struct {
-	Identifier  string
-	Title       string
-	Description string
-	URL         string
-	BranchName  string
-	State       string
-	Priority    string
-	Assignee    string
-	Team        string
-	TeamKey     string
-	Cycle       string
-	Project     string
-	Labels      []string
-	DueDate     string
-	Parent      string
+	Identifier    string
+	Title         string
+	Description   string
+	URL           string
+	BranchName    string
+	State         string
+	Priority      string
+	Estimate      string
+	Assignee      string
+	AssigneeEmail string
+	Team          string
+	TeamKey       string
+	Cycle         string
+	CycleNumber   int
+	CycleStartsAt string
+	CycleEndsAt   string
+	Project       string
+	Labels        []string
+	DueDate       string
+	CreatedAt     string
+	UpdatedAt     string
+	Parent        string
+	ParentTitle   string
+	// Children holds the identifiers of the sub-issues. Children and
+	// CommentCount are only set by SetChildrenAndComments.
+	Children     []string
+	CommentCount int
+	// RepoRoot and WorktreePath locate the current git repository and the
+	// issue's worktree. The API doesn't know them: commands fill them in
+	// when they apply.
+	RepoRoot     string
+	WorktreePath string
 }
 
 // NewIssueData constructs an IssueData from a GetIssueIssue response.
@@ -36,15 +54,21 @@ func NewIssueData(issue *api.GetIssueIssue) IssueData {
 		URL:        issue.Url,
 		BranchName: issue.BranchName,
 		Priority:   format.PriorityLabel(issue.Priority),
+		CreatedAt:  issue.CreatedAt,
+		UpdatedAt:  issue.UpdatedAt,
 	}
 	if issue.Description != nil {
 		d.Description = *issue.Description
 	}
+	if issue.Estimate != nil {
+		d.Estimate = strconv.FormatFloat(*issue.Estimate, 'f', -1, 64)
+	}
 	if issue.State != nil {
 		d.State = issue.State.Name
 	}
 	if issue.Assignee != nil {
 		d.Assignee = issue.Assignee.Name
+		d.AssigneeEmail = issue.Assignee.Email
 	}
 	if issue.Team != nil {
 		d.Team = issue.Team.Name
@@ -54,6 +78,9 @@ func NewIssueData(issue *api.GetIssueIssue) IssueData {
 		if issue.Cycle.Name != nil {
 			d.Cycle = *issue.Cycle.Name
 		}
+		d.CycleNumber = int(issue.Cycle.Number)
+		d.CycleStartsAt = issue.Cycle.StartsAt
+		d.CycleEndsAt = issue.Cycle.EndsAt
 	}
 	if issue.Project != nil {
 		d.Project = issue.Project.Name
@@ -68,64 +95,117 @@ func NewIssueData(issue *api.GetIssueIssue) IssueData {
 	}
 	if issue.Parent != nil {
 		d.Parent = issue.Parent.Identifier
+		d.ParentTitle = issue.Parent.Title
 	}
 	return d
 }
 
+// SetChildrenAndComments fills in Children and CommentCount, which GetIssue
+// leaves out, from an IssueChildrenAndComments response. The query fetches
+// the first 50 sub-issues and 250 comments, so both are capped.
+func (d *IssueData) SetChildrenAndComments(issue *api.IssueChildrenAndCommentsIssue) {
+	d.Children = nil
+	if issue.Children != nil {
+		for _, c := range issue.Children.Nodes {
+			d.Children = append(d.Children, c.Identifier)
+		}
+	}
+	d.CommentCount = 0
+	if issue.Comments != nil {
+		d.CommentCount = len(issue.Comments.Nodes)
+	}
+}
+
 // ShellQuote wraps s in single quotes with embedded single quotes escaped.
 // Use this to safely interpolate untrusted strings into shell commands.
 func ShellQuote(s string) string {
 	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
 }
 
+// shellUnquote reverses ShellQuote, reporting whether s is a value it
+// quoted.
+func shellUnquote(s string) (string, bool) {
+	if len(s) < 2 || s[0] != '\'' || s[len(s)-1] != '\'' {
+		return "", false
+	}
+	v := strings.ReplaceAll(s[1:len(s)-1], `'\''`, "'")
+	return v, ShellQuote(v) == s
+}
+
 // shellSafeData wraps IssueData with all string fields pre-quoted for safe
-// shell interpolation. Access unquoted values via the Raw field.
+// shell interpolation. Access unquoted values via the Raw field. Numbers
+// are safe as they are.
 type shellSafeData struct {
-	Identifier  string
-	Title       string
-	Description string
-	URL         string
-	BranchName  string
-	State       string
-	Priority    string
-	Assignee    string
-	Team        string
-	TeamKey     string
-	Cycle       string
-	Project     string
-	Labels      string
-	DueDate     string
-	Parent      string
-	Raw         IssueData
+	Identifier    string
+	Title         string
+	Description   string
+	URL           string
+	BranchName    string
+	State         string
+	Priority      string
+	Estimate      string
+	Assignee      string
+	AssigneeEmail string
+	Team          string
+	TeamKey       string
+	Cycle         string
+	CycleNumber   int
+	CycleStartsAt string
+	CycleEndsAt   string
+	Project       string
+	Labels        string
+	DueDate       string
+	CreatedAt     string
+	UpdatedAt     string
+	Parent        string
+	ParentTitle   string
+	// Children holds the shell-quoted identifiers of the sub-issues,
+	// space-separated.
+	Children     string
+	CommentCount int
+	RepoRoot     string
+	WorktreePath string
+	Raw          IssueData
 }
 
 func newShellSafeData(d IssueData) shellSafeData {
 	labels := strings.Join(d.Labels, ",")
+	children := make([]string, len(d.Children))
+	for i, c := range d.Children {
+		children[i] = ShellQuote(c)
+	}
 	return shellSafeData{
-		Identifier:  ShellQuote(d.Identifier),
-		Title:       ShellQuote(d.Title),
-		Description: ShellQuote(d.Description),
-		URL:         ShellQuote(d.URL),
-		BranchName:  ShellQuote(d.BranchName),
-		State:       ShellQuote(d.State),
-		Priority:    ShellQuote(d.Priority),
-		Assignee:    ShellQuote(d.Assignee),
-		Team:        ShellQuote(d.Team),
-		TeamKey:     ShellQuote(d.TeamKey),
-		Cycle:       ShellQuote(d.Cycle),
-		Project:     ShellQuote(d.Project),
-		Labels:      ShellQuote(labels),
-		DueDate:     ShellQuote(d.DueDate),
-		Parent:      ShellQuote(d.Parent),
-		Raw:         d,
+		Identifier:    ShellQuote(d.Identifier),
+		Title:         ShellQuote(d.Title),
+		Description:   ShellQuote(d.Description),
+		URL:           ShellQuote(d.URL),
+		BranchName:    ShellQuote(d.BranchName),
+		State:         ShellQuote(d.State),
+		Priority:      ShellQuote(d.Priority),
+		Estimate:      ShellQuote(d.Estimate),
+		Assignee:      ShellQuote(d.Assignee),
+		AssigneeEmail: ShellQuote(d.AssigneeEmail),
+		Team:          ShellQuote(d.Team),
+		TeamKey:       ShellQuote(d.TeamKey),
+		Cycle:         ShellQuote(d.Cycle),
+		CycleNumber:   d.CycleNumber,
+		CycleStartsAt: ShellQuote(d.CycleStartsAt),
+		CycleEndsAt:   ShellQuote(d.CycleEndsAt),
+		Project:       ShellQuote(d.Project),
+		Labels:        ShellQuote(labels),
+		DueDate:       ShellQuote(d.DueDate),
+		CreatedAt:     ShellQuote(d.CreatedAt),
+		UpdatedAt:     ShellQuote(d.UpdatedAt),
+		Parent:        ShellQuote(d.Parent),
+		ParentTitle:   ShellQuote(d.ParentTitle),
+		Children:      strings.Join(children, " "),
+		CommentCount:  d.CommentCount,
+		RepoRoot:      ShellQuote(d.RepoRoot),
+		WorktreePath:  ShellQuote(d.WorktreePath),
+		Raw:           d,
 	}
 }
 
-// templateFuncs are custom functions available in command templates.
-var templateFuncs = template.FuncMap{
-	"raw": func(s string) string { return s },
-}
-
 // IsTemplate reports whether the prompt string uses Go template syntax.
 func IsTemplate(s string) bool {
 	return strings.Contains(s, "{{")
@@ -139,7 +219,7 @@ func Render(tmpl string, data IssueData) (string, error) {
 	if !IsTemplate(tmpl) {
 		return strings.ReplaceAll(tmpl, "{identifier}", data.Identifier), nil
 	}
-	t, err := template.New("prompt").Funcs(templateFuncs).Parse(tmpl)
+	t, err := template.New("prompt").Funcs(shellTemplateFuncs).Parse(tmpl)
 	if err != nil {
 		return "", err
 	}
@@ -149,3 +229,61 @@ func Render(tmpl string, data IssueData) (string, error) {
 	}
 	return buf.String(), nil
 }
+
+// shellSafeListData is the data of a command run once for several issues:
+// the fields of the first issue, plus every issue and their identifiers.
+type shellSafeListData struct {
+	shellSafeData
+	// Identifiers holds the shell-quoted identifiers, space-separated.
+	Identifiers string
+	Issues      []shellSafeData
+}
+
+// RenderList renders a prompt template once for several issues. Besides the
+// fields of the first issue, {{.Identifiers}} expands to all identifiers
+// (shell-quoted, space-separated) and {{range .Issues}} iterates over the
+// issues. The legacy {identifier} placeholder expands to all identifiers.
+func RenderList(tmpl string, issues []IssueData) (string, error) {
+	ids := make([]string, len(issues))
+	data := shellSafeListData{Issues: make([]shellSafeData, len(issues))}
+	for i, d := range issues {
+		ids[i] = d.Identifier
+		data.Issues[i] = newShellSafeData(d)
+	}
+	if !IsTemplate(tmpl) {
+		return strings.ReplaceAll(tmpl, "{identifier}", strings.Join(ids, " ")), nil
+	}
+	if len(issues) > 0 {
+		data.shellSafeData = data.Issues[0]
+	}
+	quoted := make([]string, len(ids))
+	for i, id := range ids {
+		quoted[i] = ShellQuote(id)
+	}
+	data.Identifiers = strings.Join(quoted, " ")
+
+	t, err := template.New("prompt").Funcs(shellTemplateFuncs).Parse(tmpl)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// RenderRaw renders tmpl with data as-is, without shell quoting. Use it for
+// non-shell contexts such as file paths. data may be any value; structs that
+// embed IssueData expose the issue fields directly.
+func RenderRaw(tmpl string, data any) (string, error) {
+	t, err := template.New("raw").Funcs(templateFuncs).Parse(tmpl)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -170,6 +170,7 @@ func TestNewIssueData_FullIssue(t *testing.T) {
 	desc := "A description"
 	dueDate := "2026-03-01"
 	cycleName := "Sprint 12"
+	estimate := 2.5
 	issue := &api.GetIssueIssue{
 		Identifier:  "AIS-42",
 		Title:       "Test issue",
@@ -177,19 +178,26 @@ func TestNewIssueData_FullIssue(t *testing.T) {
 		Url:         "https://linear.app/team/AIS-42",
 		BranchName:  "fred/ais-42-test-issue",
 		Priority:    2,
+		Estimate:    &estimate,
 		DueDate:     &dueDate,
+		CreatedAt:   "2026-02-01T09:00:00.000Z",
+		UpdatedAt:   "2026-02-20T17:30:00.000Z",
 		State: &api.GetIssueIssueStateWorkflowState{
 			Name: "In Progress",
 		},
 		Assignee: &api.GetIssueIssueAssigneeUser{
-			Name: "Fred",
+			Name:  "Fred",
+			Email: "fred@example.com",
 		},
 		Team: &api.GetIssueIssueTeam{
 			Name: "Aisystems",
 			Key:  "AIS",
 		},
 		Cycle: &api.GetIssueIssueCycle{
-			Name: &cycleName,
+			Number:   12,
+			Name:     &cycleName,
+			StartsAt: "2026-02-16T00:00:00.000Z",
+			EndsAt:   "2026-03-02T00:00:00.000Z",
 		},
 		Project: &api.GetIssueIssueProject{
 			Name: "My Project",
@@ -202,6 +210,7 @@ func TestNewIssueData_FullIssue(t *testing.T) {
 		},
 		Parent: &api.GetIssueIssueParentIssue{
 			Identifier: "AIS-10",
+			Title:      "Parent issue",
 		},
 	}
 	d := NewIssueData(issue)
@@ -251,6 +260,49 @@ func TestNewIssueData_FullIssue(t *testing.T) {
 	if d.Parent != "AIS-10" {
 		t.Errorf("Parent = %q, want AIS-10", d.Parent)
 	}
+	if d.ParentTitle != "Parent issue" {
+		t.Errorf("ParentTitle = %q, want Parent issue", d.ParentTitle)
+	}
+	if d.Estimate != "2.5" {
+		t.Errorf("Estimate = %q, want 2.5", d.Estimate)
+	}
+	if d.AssigneeEmail != "fred@example.com" {
+		t.Errorf("AssigneeEmail = %q, want fred@example.com", d.AssigneeEmail)
+	}
+	if d.CycleNumber != 12 || d.CycleStartsAt != "2026-02-16T00:00:00.000Z" || d.CycleEndsAt != "2026-03-02T00:00:00.000Z" {
+		t.Errorf("cycle = %d %q %q, want 12 and its dates", d.CycleNumber, d.CycleStartsAt, d.CycleEndsAt)
+	}
+	if d.CreatedAt != "2026-02-01T09:00:00.000Z" || d.UpdatedAt != "2026-02-20T17:30:00.000Z" {
+		t.Errorf("CreatedAt, UpdatedAt = %q, %q", d.CreatedAt, d.UpdatedAt)
+	}
+	if d.Children != nil || d.CommentCount != 0 {
+		t.Errorf("Children, CommentCount = %v, %d, want them unset", d.Children, d.CommentCount)
+	}
+}
+
+func TestIssueData_SetChildrenAndComments(t *testing.T) {
+	t.Parallel()
+	d := IssueData{Identifier: "AIS-42"}
+	d.SetChildrenAndComments(&api.IssueChildrenAndCommentsIssue{
+		Children: &api.IssueChildrenAndCommentsIssueChildrenIssueConnection{
+			Nodes: []*api.IssueChildrenAndCommentsIssueChildrenIssueConnectionNodesIssue{{Identifier: "AIS-43"}, {Identifier: "AIS-44"}},
+		},
+		Comments: &api.IssueChildrenAndCommentsIssueCommentsCommentConnection{
+			Nodes: []*api.IssueChildrenAndCommentsIssueCommentsCommentConnectionNodesComment{{Id: "c1"}, {Id: "c2"}, {Id: "c3"}},
+		},
+	})
+
+	if len(d.Children) != 2 || d.Children[0] != "AIS-43" || d.Children[1] != "AIS-44" {
+		t.Errorf("Children = %v, want [AIS-43 AIS-44]", d.Children)
+	}
+	if d.CommentCount != 3 {
+		t.Errorf("CommentCount = %d, want 3", d.CommentCount)
+	}
+
+	d.SetChildrenAndComments(&api.IssueChildrenAndCommentsIssue{})
+	if d.Children != nil || d.CommentCount != 0 {
+		t.Errorf("Children, CommentCount = %v, %d, want them reset", d.Children, d.CommentCount)
+	}
 }
 
 func TestNewIssueData_NilFields(t *testing.T) {
@@ -281,3 +333,98 @@ func TestNewIssueData_NilFields(t *testing.T) {
 		t.Errorf("Labels = %v, want nil", d.Labels)
 	}
 }
+
+func TestRender_Funcs(t *testing.T) {
+	t.Setenv("LINEAR_TEST_DIR", "/tmp/it's here")
+	data := IssueData{
+		Identifier:   "AIS-42",
+		Title:        "Fix: it's $(broken)",
+		CreatedAt:    "2026-02-01T09:00:00Z",
+		Children:     []string{"AIS-43", "AIS-44"},
+		CommentCount: 3,
+	}
+
+	tests := []struct {
+		name string
+		tmpl string
+		want string
+	}{
+		{name: "slug requotes", tmpl: "{{slug .Title}}", want: "'fix-it-s-broken'"},
+		{name: "trunc requotes", tmpl: "{{.Title | trunc 6}}", want: `'Fix: i'`},
+		{name: "trunc keeps quotes inside", tmpl: "{{.Title | trunc 9}}", want: `'Fix: it'\''s'`},
+		{name: "trunc on raw", tmpl: "{{.Raw.Title | trunc 3}}", want: "Fix"},
+		{name: "default on empty field", tmpl: `{{.Assignee | default "nobody"}}`, want: "'nobody'"},
+		{name: "default on set field", tmpl: `{{.Identifier | default "nobody"}}`, want: "'AIS-42'"},
+		{name: "date", tmpl: `{{date "2006-01-02" .CreatedAt}}`, want: "'2026-02-01'"},
+		{name: "lower", tmpl: "{{lower .Identifier}}", want: "'ais-42'"},
+		{name: "env is quoted", tmpl: `cd {{env "LINEAR_TEST_DIR"}}`, want: `cd '/tmp/it'\''s here'`},
+		{name: "shellquote raw", tmpl: "{{shellquote .Raw.Title}}", want: `'Fix: it'\''s $(broken)'`},
+		{name: "shellquote quoted", tmpl: "{{shellquote .Identifier}}", want: "'AIS-42'"},
+		{name: "children", tmpl: "linear issue get {{.Children}}", want: "linear issue get 'AIS-43' 'AIS-44'"},
+		{name: "numbers", tmpl: "{{.CommentCount}} {{.CycleNumber}}", want: "3 0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render(tt.tmpl, data)
+			if err != nil {
+				t.Fatalf("Render() error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderRaw_NoQuoting(t *testing.T) {
+	data := struct {
+		IssueData
+		RepoName string
+	}{
+		IssueData: IssueData{Identifier: "AIS-42", Title: "it's raw"},
+		RepoName:  "repo",
+	}
+	got, err := RenderRaw("/wt/{{.RepoName}}/{{lower .Identifier}} {{.Title}}", data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "/wt/repo/ais-42 it's raw"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestRenderRaw_InvalidTemplate(t *testing.T) {
+	if _, err := RenderRaw("{{.Identifier", IssueData{}); err == nil {
+		t.Fatal("expected error for invalid template")
+	}
+}
+
+func TestRenderList(t *testing.T) {
+	issues := []IssueData{
+		{Identifier: "ENG-1", Title: "First"},
+		{Identifier: "ENG-2", Title: "It's second"},
+	}
+
+	tests := []struct {
+		name string
+		tmpl string
+		want string
+	}{
+		{name: "identifiers", tmpl: "open {{.Identifiers}}", want: "open 'ENG-1' 'ENG-2'"},
+		{name: "range", tmpl: "{{range .Issues}}{{.Title}};{{end}}", want: `'First';'It'\''s second';`},
+		{name: "first issue fields", tmpl: "{{.Identifier}} {{.Raw.Title}}", want: "'ENG-1' First"},
+		{name: "legacy placeholder", tmpl: "echo {identifier}", want: "echo ENG-1 ENG-2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenderList(tt.tmpl, issues)
+			if err != nil {
+				t.Fatalf("RenderList() error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RenderList() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package tui
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// _previewWidth is the width of the preview pane beside the list, and
+// _sideBySideWidth the terminal width from which it goes there rather than
+// below the list, like fzf's --preview-window right,86,<166(bottom,60%).
+const (
+	_previewWidth    = 86
+	_sideBySideWidth = 166
+)
+
+// Browser is the state of a list shown next to a preview pane, with header
+// lines and a status message above the list.
+type Browser struct {
+	List *List
+	// Header holds the lines shown above the list.
+	Header []string
+	// Status is a message shown below the header, e.g. an error.
+	Status string
+
+	preview scrollText
+}
+
+// SetPreview changes the preview's content, scrolling back to its top
+// when it differs from the current one.
+func (b *Browser) SetPreview(content string) {
+	b.preview.set(content)
+}
+
+// ScrollPreview scrolls the preview by n lines, stopping at either end.
+// The end is checked when rendering, since it depends on the width.
+func (b *Browser) ScrollPreview(n int) {
+	b.preview.scroll(n)
+}
+
+// Frame returns the browser's lines for a width x height terminal. The
+// preview is beside the list on wide terminals and below it otherwise;
+// halfPage is the number of lines it shows, for scrolling by half pages.
+func (b *Browser) Frame(width, height int) (lines []string, halfPage int) {
+	top := slices.Clone(b.Header)
+	if b.Status != "" {
+		top = append(top, "\033[31m"+b.Status+"\033[0m")
+	}
+
+	if width >= _sideBySideWidth {
+		listWidth := width - _previewWidth - 1
+		left := b.leftPane(top, listWidth, height)
+		right := b.preview.lines(_previewWidth-1, height)
+		lines = make([]string, height)
+		for i := range lines {
+			var l, r string
+			if i < len(left) {
+				l = left[i]
+			}
+			if i < len(right) {
+				r = right[i]
+			}
+			pad := max(listWidth-ansi.StringWidth(l), 0)
+			lines[i] = l + "\033[0m" + strings.Repeat(" ", pad) + "\033[2m│\033[0m " + r
+		}
+		return lines, height / 2
+	}
+
+	previewHeight := height * 6 / 10
+	lines = b.leftPane(top, width, height-previewHeight-1)
+	for len(lines) < height-previewHeight-1 {
+		lines = append(lines, "")
+	}
+	lines = append(lines, "\033[2m"+strings.Repeat("─", width)+"\033[0m")
+	return append(lines, b.preview.lines(width, previewHeight)...), previewHeight / 2
+}
+
+// leftPane returns the header lines followed by the list.
+func (b *Browser) leftPane(top []string, width, height int) []string {
+	var lines []string
+	for _, h := range top {
+		if len(lines) == height {
+			return lines
+		}
+		lines = append(lines, ansi.Truncate(h, width, "…"))
+	}
+	return append(lines, b.List.Render(width, max(height-len(lines), 3))...)
+}
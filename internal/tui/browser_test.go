@@ -0,0 +1,53 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestBrowser_Frame(t *testing.T) {
+	t.Parallel()
+
+	var preview []string
+	for i := range 100 {
+		preview = append(preview, fmt.Sprintf("line %d", i))
+	}
+	b := &Browser{List: newTestList(true), Header: []string{"ctrl-e: edit"}, Status: "failed"}
+	b.SetPreview(strings.Join(preview, "\n"))
+
+	// Narrow terminals show the preview below the list.
+	lines, halfPage := b.Frame(80, 20)
+	if len(lines) != 20 || halfPage != 6 {
+		t.Fatalf("Frame(80, 20) = %d lines, half page %d, want 20 lines, half page 6", len(lines), halfPage)
+	}
+	for i, want := range map[int]string{0: "ctrl-e: edit", 1: "failed", 2: ">  ", 7: strings.Repeat("─", 80), 8: "line 0", 19: "line 11"} {
+		if got := ansi.Strip(lines[i]); got != want {
+			t.Errorf("narrow line %d = %q, want %q", i, got, want)
+		}
+	}
+
+	// Wide terminals show it beside the list, scrolled.
+	b.ScrollPreview(500)
+	lines, halfPage = b.Frame(200, 20)
+	if len(lines) != 20 || halfPage != 10 {
+		t.Fatalf("Frame(200, 20) = %d lines, half page %d, want 20 lines, half page 10", len(lines), halfPage)
+	}
+	for _, line := range lines {
+		if w := ansi.StringWidth(line); w > 200 {
+			t.Errorf("line %q is %d cells wide", ansi.Strip(line), w)
+		}
+	}
+	if got, want := ansi.Strip(lines[0]), "ctrl-e: edit"+strings.Repeat(" ", 101)+"│ line 80"; got != want {
+		t.Errorf("wide line 0 =\n%q\nwant\n%q", got, want)
+	}
+
+	// A new preview starts at the top.
+	b.SetPreview("other")
+	lines, _ = b.Frame(200, 20)
+	if got := ansi.Strip(lines[0]); !strings.HasSuffix(got, "│ other") {
+		t.Errorf("wide line 0 after SetPreview = %q", got)
+	}
+}
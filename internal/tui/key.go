@@ -0,0 +1,167 @@
+package tui
+
+import (
+	"bufio"
+	"strings"
+	"unicode/utf8"
+)
+
+// Key is a key press. Name is the key's fzf name (e.g. "ctrl-e", "alt-x",
+// "up" or "f5") so bindings are shared with the fzf backend; it is empty
+// for printable characters, which are in Rune.
+type Key struct {
+	Name string
+	Rune rune
+}
+
+// String returns the key's name, or the typed character.
+func (k Key) String() string {
+	if k.Name != "" {
+		return k.Name
+	}
+	return string(k.Rune)
+}
+
+// _csiKeys names the CSI sequences ("\x1b[" + params + final byte) sent by
+// common terminals, keyed by params and final byte.
+var _csiKeys = map[string]string{
+	"A": "up", "B": "down", "C": "right", "D": "left",
+	"H": "home", "F": "end", "Z": "btab",
+	"1~": "home", "7~": "home", "4~": "end", "8~": "end",
+	"2~": "insert", "3~": "del", "5~": "pgup", "6~": "pgdn",
+	"11~": "f1", "12~": "f2", "13~": "f3", "14~": "f4",
+	"15~": "f5", "17~": "f6", "18~": "f7", "19~": "f8",
+	"20~": "f9", "21~": "f10", "23~": "f11", "24~": "f12",
+}
+
+// _ss3Keys names the SS3 sequences ("\x1bO" + byte), sent for the arrows
+// in application mode and for f1-f4.
+var _ss3Keys = map[byte]string{
+	'A': "up", 'B': "down", 'C': "right", 'D': "left",
+	'H': "home", 'F': "end",
+	'P': "f1", 'Q': "f2", 'R': "f3", 'S': "f4",
+}
+
+// _csiModifiers are the xterm modifier parameters of CSI sequences such as
+// "\x1b[1;2A" (shift-up).
+var _csiModifiers = map[string]string{
+	"2": "shift-", "3": "alt-", "5": "ctrl-", "7": "ctrl-alt-",
+}
+
+// ReadKey reads one key press from r, which reads a terminal in raw mode.
+// A lone escape byte is the esc key when nothing follows it in the buffer:
+// terminals send escape sequences in one write, so they arrive together.
+func ReadKey(r *bufio.Reader) (Key, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return Key{}, err
+	}
+	if b != 0x1b {
+		return readPlainKey(r, b)
+	}
+	if r.Buffered() == 0 {
+		return Key{Name: "esc"}, nil
+	}
+
+	b, _ = r.ReadByte()
+	switch b {
+	case '[':
+		if r.Buffered() == 0 {
+			return Key{Name: "alt-["}, nil
+		}
+		return readCSIKey(r)
+	case 'O':
+		if r.Buffered() == 0 {
+			return Key{Name: "alt-O"}, nil
+		}
+		b, _ = r.ReadByte()
+		return Key{Name: _ss3Keys[b]}, nil
+	case 0x1b:
+		return Key{Name: "esc"}, nil
+	}
+	k, err := readPlainKey(r, b)
+	if err != nil {
+		return Key{}, err
+	}
+	switch {
+	case strings.HasPrefix(k.Name, "ctrl-"):
+		k.Name = "ctrl-alt-" + strings.TrimPrefix(k.Name, "ctrl-")
+	case k.Name == "enter" || k.Name == "bspace":
+		k.Name = "alt-" + k.Name
+	case k.Name == "":
+		if k.Rune == ' ' {
+			k = Key{Name: "alt-space"}
+		} else {
+			k = Key{Name: "alt-" + string(k.Rune)}
+		}
+	}
+	return k, nil
+}
+
+// readPlainKey decodes a key that isn't an escape sequence, starting with
+// byte b.
+func readPlainKey(r *bufio.Reader, b byte) (Key, error) {
+	switch {
+	case b == '\r' || b == '\n':
+		return Key{Name: "enter"}, nil
+	case b == '\t':
+		return Key{Name: "tab"}, nil
+	case b == 0x7f || b == 0x08:
+		return Key{Name: "bspace"}, nil
+	case b == 0:
+		return Key{Name: "ctrl-space"}, nil
+	case b < 0x1b:
+		return Key{Name: "ctrl-" + string(rune('a'+b-1))}, nil
+	case b < 0x20:
+		return Key{}, nil
+	case b < utf8.RuneSelf:
+		return Key{Rune: rune(b)}, nil
+	}
+	if err := r.UnreadByte(); err != nil {
+		return Key{}, err
+	}
+	c, _, err := r.ReadRune()
+	if err != nil {
+		return Key{}, err
+	}
+	return Key{Rune: c}, nil
+}
+
+// readCSIKey decodes the rest of a CSI sequence: parameter bytes followed
+// by a final byte. A truncated sequence is dropped rather than waiting for
+// more input.
+func readCSIKey(r *bufio.Reader) (Key, error) {
+	var seq []byte
+	for {
+		if r.Buffered() == 0 {
+			return Key{}, nil
+		}
+		b, err := r.ReadByte()
+		if err != nil {
+			return Key{}, err
+		}
+		seq = append(seq, b)
+		if b >= 0x40 && b <= 0x7e {
+			break
+		}
+	}
+	s := string(seq)
+	if name, ok := _csiKeys[s]; ok {
+		return Key{Name: name}, nil
+	}
+	// Modified keys, e.g. "1;2A" (shift-up) or "3;5~" (ctrl-del).
+	params, final := s[:len(s)-1], s[len(s)-1:]
+	if base, mod, ok := strings.Cut(params, ";"); ok {
+		prefix, known := _csiModifiers[mod]
+		if base == "1" {
+			base = ""
+		} else {
+			base += "~"
+			final = ""
+		}
+		if name, ok := _csiKeys[base+final]; ok && known {
+			return Key{Name: prefix + name}, nil
+		}
+	}
+	return Key{}, nil
+}
@@ -0,0 +1,51 @@
+package tui
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+)
+
+func TestReadKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		want []Key
+	}{
+		{"a", []Key{{Rune: 'a'}}},
+		{"é", []Key{{Rune: 'é'}}},
+		{"\x05", []Key{{Name: "ctrl-e"}}},
+		{"\r", []Key{{Name: "enter"}}},
+		{"\t", []Key{{Name: "tab"}}},
+		{"\x7f", []Key{{Name: "bspace"}}},
+		{"\x1b", []Key{{Name: "esc"}}},
+		{"\x1bo", []Key{{Name: "alt-o"}}},
+		{"\x1b\x05", []Key{{Name: "ctrl-alt-e"}}},
+		{"\x1b\r", []Key{{Name: "alt-enter"}}},
+		{"\x1b[A", []Key{{Name: "up"}}},
+		{"\x1b[Z", []Key{{Name: "btab"}}},
+		{"\x1bOP", []Key{{Name: "f1"}}},
+		{"\x1b[15~", []Key{{Name: "f5"}}},
+		{"\x1b[1;2B", []Key{{Name: "shift-down"}}},
+		{"\x1b[1;3A", []Key{{Name: "alt-up"}}},
+		{"\x1b[3~", []Key{{Name: "del"}}},
+		{"\x1b[6~", []Key{{Name: "pgdn"}}},
+		{"\x1b[99~", []Key{{}}},
+		{"\x1b[Ax", []Key{{Name: "up"}, {Rune: 'x'}}},
+	}
+	for _, tt := range tests {
+		r := bufio.NewReader(strings.NewReader(tt.in))
+		// Fill the buffer, as a terminal read would.
+		_, _ = r.Peek(len(tt.in))
+		for _, want := range tt.want {
+			got, err := ReadKey(r)
+			if err != nil {
+				t.Fatalf("ReadKey(%q): %v", tt.in, err)
+			}
+			if got != want {
+				t.Errorf("ReadKey(%q) = %+v, want %+v", tt.in, got, want)
+			}
+		}
+	}
+}
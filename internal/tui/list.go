@@ -0,0 +1,251 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Item is an entry of a List.
+type Item struct {
+	// Value is what picking the item returns.
+	Value string
+	// Display is the text shown, which may contain ANSI colors. Empty
+	// shows Value.
+	Display string
+}
+
+// text returns the item as shown, with tabs as spaces since their width
+// depends on the column they start at.
+func (it Item) text() string {
+	text := it.Value
+	if it.Display != "" {
+		text = it.Display
+	}
+	return strings.ReplaceAll(text, "\t", "  ")
+}
+
+// List is the state of a picker: items filtered by a query, a cursor and,
+// when multi is set, a selection. It does no terminal I/O, so it can be
+// driven by key presses in tests.
+type List struct {
+	multi bool
+	items []Item
+	// plain holds the items' text without colors, which the query matches.
+	plain []string
+	query []rune
+	// matches holds the indexes of the items matching the query.
+	matches []int
+	// cursor is the index in matches of the current item.
+	cursor int
+	// offset is the index in matches of the first item shown.
+	offset int
+	// page is the number of items shown by the last Render, used to move
+	// by pages.
+	page     int
+	selected map[string]bool
+	// header is shown above the items, aligned with them, like the lines
+	// of fzf's --header-lines.
+	header string
+}
+
+// NewList returns a list of items. With multi set, several items can be
+// selected with tab.
+func NewList(items []Item, multi bool) *List {
+	l := &List{multi: multi, selected: make(map[string]bool), page: 10}
+	l.SetItems(items)
+	return l
+}
+
+// SetItems replaces the items, keeping the query, the selection of items
+// still present and, when it is still there, the current item.
+func (l *List) SetItems(items []Item) {
+	current, hasCurrent := l.Current()
+	l.items = items
+	l.plain = make([]string, len(items))
+	values := make(map[string]bool, len(items))
+	for i, it := range items {
+		l.plain[i] = ansi.Strip(it.text())
+		values[it.Value] = true
+	}
+	for v := range l.selected {
+		if !values[v] {
+			delete(l.selected, v)
+		}
+	}
+	l.filter()
+	if hasCurrent {
+		for i, m := range l.matches {
+			if l.items[m].Value == current.Value {
+				l.cursor = i
+				break
+			}
+		}
+	}
+}
+
+// SetHeader sets the line shown above the items, aligned with them, e.g.
+// the column names of a table.
+func (l *List) SetHeader(header string) {
+	l.header = header
+}
+
+// Query returns the text typed to filter the items.
+func (l *List) Query() string {
+	return string(l.query)
+}
+
+// Counts returns the number of items matching the query and the total
+// number of items.
+func (l *List) Counts() (matched, total int) {
+	return len(l.matches), len(l.items)
+}
+
+// Current returns the item under the cursor, if any item matches.
+func (l *List) Current() (Item, bool) {
+	if l.cursor >= len(l.matches) {
+		return Item{}, false
+	}
+	return l.items[l.matches[l.cursor]], true
+}
+
+// Picked returns the selected items in list order or, when none are
+// selected, the current item.
+func (l *List) Picked() []Item {
+	var picked []Item
+	for _, it := range l.items {
+		if l.selected[it.Value] {
+			picked = append(picked, it)
+		}
+	}
+	if len(picked) == 0 {
+		if it, ok := l.Current(); ok {
+			picked = append(picked, it)
+		}
+	}
+	return picked
+}
+
+// DeselectAll clears the selection.
+func (l *List) DeselectAll() {
+	clear(l.selected)
+}
+
+// HandleKey applies a key press: typing edits the query, the arrows move
+// the cursor and tab toggles the selection. It reports whether the key was
+// used.
+func (l *List) HandleKey(k Key) bool {
+	switch k.Name {
+	case "":
+		if k.Rune == 0 {
+			return false
+		}
+		l.setQuery(append(l.query, k.Rune))
+	case "bspace", "ctrl-h":
+		if len(l.query) > 0 {
+			l.setQuery(l.query[:len(l.query)-1])
+		}
+	case "ctrl-w":
+		q := strings.TrimRight(string(l.query), " ")
+		l.setQuery([]rune(q[:strings.LastIndex(q, " ")+1]))
+	case "ctrl-u":
+		l.setQuery(nil)
+	case "up", "ctrl-p", "ctrl-k":
+		l.move(-1)
+	case "down", "ctrl-n", "ctrl-j":
+		l.move(1)
+	case "pgup":
+		l.move(-l.page)
+	case "pgdn":
+		l.move(l.page)
+	case "tab", "btab":
+		if !l.multi {
+			return false
+		}
+		if it, ok := l.Current(); ok {
+			if l.selected[it.Value] {
+				delete(l.selected, it.Value)
+			} else {
+				l.selected[it.Value] = true
+			}
+		}
+		if k.Name == "tab" {
+			l.move(1)
+		} else {
+			l.move(-1)
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// Render returns the list as at most height lines of at most width cells:
+// the query prompt, a line counting the matches, the header, then the
+// matching items.
+func (l *List) Render(width, height int) []string {
+	lines := make([]string, 0, height)
+	lines = append(lines, ansi.Truncate("> "+string(l.query)+"\033[7m \033[0m", width, ""))
+
+	matched, total := l.Counts()
+	info := fmt.Sprintf("  %d/%d", matched, total)
+	if n := len(l.selected); n > 0 {
+		info += fmt.Sprintf(" (%d)", n)
+	}
+	lines = append(lines, "\033[2m"+ansi.Truncate(info, width, "")+"\033[0m")
+	if l.header != "" {
+		lines = append(lines, "  "+ansi.Truncate(l.header, width-2, "…"))
+	}
+
+	l.page = max(height-len(lines), 1)
+	if l.cursor < l.offset {
+		l.offset = l.cursor
+	}
+	if l.cursor >= l.offset+l.page {
+		l.offset = l.cursor - l.page + 1
+	}
+	for i := l.offset; i < len(l.matches) && len(lines) < height; i++ {
+		it := l.items[l.matches[i]]
+		prefix := "  "
+		switch {
+		case i == l.cursor && l.selected[it.Value]:
+			prefix = "\033[1;31m>\033[35m+\033[0m"
+		case i == l.cursor:
+			prefix = "\033[1;31m>\033[0m "
+		case l.selected[it.Value]:
+			prefix = " \033[35m+\033[0m"
+		}
+		text := ansi.Truncate(it.text(), width-2, "…")
+		if i == l.cursor {
+			text = "\033[1m" + text + "\033[0m"
+		}
+		lines = append(lines, prefix+text)
+	}
+	return lines
+}
+
+// setQuery changes the query and filters the items again, moving the
+// cursor to the first match.
+func (l *List) setQuery(q []rune) {
+	l.query = q
+	l.filter()
+	l.cursor, l.offset = 0, 0
+}
+
+// filter recomputes the matches of the query, keeping the cursor in range.
+func (l *List) filter() {
+	l.matches = l.matches[:0]
+	query := string(l.query)
+	for i, text := range l.plain {
+		if Match(query, text) {
+			l.matches = append(l.matches, i)
+		}
+	}
+	l.cursor = min(l.cursor, max(len(l.matches)-1, 0))
+}
+
+// move moves the cursor by n items, stopping at either end.
+func (l *List) move(n int) {
+	l.cursor = min(max(l.cursor+n, 0), max(len(l.matches)-1, 0))
+}
@@ -0,0 +1,136 @@
+package tui
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func newTestList(multi bool) *List {
+	return NewList([]Item{
+		{Value: "ENG-1 Fix login"},
+		{Value: "ENG-2 Add logout", Display: "\033[32mENG-2\033[0m Add logout"},
+		{Value: "ENG-3 Update docs"},
+	}, multi)
+}
+
+// typeKeys sends the keys of s to l, with "<name>" for named keys.
+func typeKeys(l *List, s string) {
+	for s != "" {
+		if name, rest, ok := strings.Cut(s[1:], ">"); s[0] == '<' && ok {
+			l.HandleKey(Key{Name: name})
+			s = rest
+			continue
+		}
+		l.HandleKey(Key{Rune: rune(s[0])})
+		s = s[1:]
+	}
+}
+
+func values(items []Item) []string {
+	var vs []string
+	for _, it := range items {
+		vs = append(vs, it.Value)
+	}
+	return vs
+}
+
+func TestList_Filter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		keys        string
+		wantCurrent string
+		wantMatched int
+	}{
+		{"", "ENG-1 Fix login", 3},
+		{"log", "ENG-1 Fix login", 2},
+		{"log<down>", "ENG-2 Add logout", 2},
+		{"log<down><down><down>", "ENG-2 Add logout", 2},
+		{"<down>logo", "ENG-2 Add logout", 1},
+		{"docs<bspace><bspace><bspace><bspace>", "ENG-1 Fix login", 3},
+		{"fix doc<ctrl-w>", "ENG-1 Fix login", 1},
+		{"zzz", "", 0},
+		{"zzz<ctrl-u><up><pgdn>", "ENG-3 Update docs", 3},
+	}
+	for _, tt := range tests {
+		l := newTestList(false)
+		typeKeys(l, tt.keys)
+		current, _ := l.Current()
+		matched, total := l.Counts()
+		if current.Value != tt.wantCurrent || matched != tt.wantMatched || total != 3 {
+			t.Errorf("after %q: current %q, %d/%d matched, want %q, %d/3", tt.keys, current.Value, matched, total, tt.wantCurrent, tt.wantMatched)
+		}
+	}
+}
+
+func TestList_Select(t *testing.T) {
+	t.Parallel()
+
+	l := newTestList(true)
+	typeKeys(l, "<tab><tab>")
+	if got, want := values(l.Picked()), []string{"ENG-1 Fix login", "ENG-2 Add logout"}; !slices.Equal(got, want) {
+		t.Errorf("Picked() = %q, want %q", got, want)
+	}
+
+	// The selection survives filtering, and btab toggles going up.
+	typeKeys(l, "docs<ctrl-u><btab>")
+	if got, want := values(l.Picked()), []string{"ENG-2 Add logout"}; !slices.Equal(got, want) {
+		t.Errorf("Picked() after btab = %q, want %q", got, want)
+	}
+
+	l.DeselectAll()
+	if got, want := values(l.Picked()), []string{"ENG-1 Fix login"}; !slices.Equal(got, want) {
+		t.Errorf("Picked() without a selection = %q, want the current item %q", got, want)
+	}
+
+	single := newTestList(false)
+	if single.HandleKey(Key{Name: "tab"}) {
+		t.Error("tab should be ignored without multi")
+	}
+}
+
+func TestList_SetItems(t *testing.T) {
+	t.Parallel()
+
+	l := newTestList(true)
+	typeKeys(l, "<down><tab>")
+	l.SetItems([]Item{{Value: "ENG-0 New"}, {Value: "ENG-2 Add logout"}, {Value: "ENG-3 Update docs"}})
+
+	if current, _ := l.Current(); current.Value != "ENG-3 Update docs" {
+		t.Errorf("current = %q, want the same item as before", current.Value)
+	}
+	if got, want := values(l.Picked()), []string{"ENG-2 Add logout"}; !slices.Equal(got, want) {
+		t.Errorf("Picked() = %q, want %q", got, want)
+	}
+}
+
+func TestList_Render(t *testing.T) {
+	t.Parallel()
+
+	l := newTestList(true)
+	l.SetHeader("ID    TITLE")
+	typeKeys(l, "<tab>")
+	var got []string
+	for _, line := range l.Render(14, 5) {
+		got = append(got, ansi.Strip(line))
+	}
+	want := []string{
+		">  ",
+		"  3/3 (1)",
+		"  ID    TITLE",
+		" +ENG-1 Fix l…",
+		"> ENG-2 Add l…",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Render() =\n%q\nwant\n%q", got, want)
+	}
+
+	// The list scrolls to keep the cursor visible.
+	typeKeys(l, "<down>")
+	if last := ansi.Strip(l.Render(14, 5)[4]); last != "> ENG-3 Updat…" {
+		t.Errorf("last line after scrolling = %q", last)
+	}
+}
@@ -0,0 +1,58 @@
+package tui
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Match reports whether text matches query, using a subset of fzf's search
+// syntax: every space-separated term of the query must match. A term
+// matches when its characters appear in text in order, not necessarily
+// next to each other; a term starting with ' must appear as is, and a term
+// starting with ! must not match. Terms without uppercase letters ignore
+// case.
+func Match(query, text string) bool {
+	lower := strings.ToLower(text)
+	for _, term := range strings.Fields(query) {
+		negate := false
+		if rest, ok := strings.CutPrefix(term, "!"); ok {
+			term, negate = rest, true
+		}
+		exact := false
+		if rest, ok := strings.CutPrefix(term, "'"); ok {
+			term, exact = rest, true
+		}
+		if term == "" {
+			continue
+		}
+
+		haystack := text
+		if !strings.ContainsFunc(term, unicode.IsUpper) {
+			haystack = lower
+		}
+		var matched bool
+		if exact {
+			matched = strings.Contains(haystack, term)
+		} else {
+			matched = containsInOrder(haystack, term)
+		}
+		if matched == negate {
+			return false
+		}
+	}
+	return true
+}
+
+// containsInOrder reports whether the runes of sub appear in s in order.
+func containsInOrder(s, sub string) bool {
+	want := []rune(sub)
+	for _, r := range s {
+		if r == want[0] {
+			want = want[1:]
+			if len(want) == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
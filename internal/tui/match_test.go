@@ -0,0 +1,32 @@
+package tui
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	const text = "ENG-42  In Progress  Fix login bug"
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"", true},
+		{"fix", true},
+		{"flb", true},
+		{"blf", false},
+		{"eng42 login", true},
+		{"login nope", false},
+		{"'login", true},
+		{"'lgn", false},
+		{"Fix", true},
+		{"FIX", false},
+		{"!done", true},
+		{"!login", false},
+		{"!", true},
+	}
+	for _, tt := range tests {
+		if got := Match(tt.query, text); got != tt.want {
+			t.Errorf("Match(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
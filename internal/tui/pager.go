@@ -0,0 +1,55 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Pager is the state of a full-screen text that scrolls, with header lines
+// and a status message above it and footer lines below.
+type Pager struct {
+	// Header holds the lines shown above the text.
+	Header []string
+	// Status is a message shown below the header, e.g. an error.
+	Status string
+	// Footer holds the lines shown at the bottom, e.g. key help.
+	Footer []string
+
+	text scrollText
+}
+
+// SetText changes the text, scrolling back to its top when it differs
+// from the current one.
+func (p *Pager) SetText(text string) {
+	p.text.set(text)
+}
+
+// Scroll scrolls the text by n lines, stopping at either end.
+func (p *Pager) Scroll(n int) {
+	p.text.scroll(n)
+}
+
+// Frame returns the pager's lines for a width x height terminal; halfPage
+// is half the number of lines of text it shows, for scrolling by half
+// pages.
+func (p *Pager) Frame(width, height int) (lines []string, halfPage int) {
+	for _, h := range p.Header {
+		lines = append(lines, ansi.Truncate(h, width, "…"))
+	}
+	if p.Status != "" {
+		lines = append(lines, ansi.Truncate("\033[31m"+p.Status+"\033[0m", width, "…"))
+	}
+	lines = append(lines, "\033[2m"+strings.Repeat("─", width)+"\033[0m")
+
+	textHeight := max(height-len(lines)-len(p.Footer)-1, 1)
+	lines = append(lines, p.text.lines(width, textHeight)...)
+	for len(lines) < height-len(p.Footer)-1 {
+		lines = append(lines, "")
+	}
+	lines = append(lines, "\033[2m"+strings.Repeat("─", width)+"\033[0m")
+	for _, f := range p.Footer {
+		lines = append(lines, ansi.Truncate(f, width, "…"))
+	}
+	return lines, textHeight / 2
+}
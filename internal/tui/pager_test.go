@@ -0,0 +1,46 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestPager_Frame(t *testing.T) {
+	t.Parallel()
+
+	var text []string
+	for i := range 100 {
+		text = append(text, fmt.Sprintf("line %d", i))
+	}
+	p := &Pager{Header: []string{"Triage ENG-1 (1/3)"}, Status: "failed", Footer: []string{"a: accept"}}
+	p.SetText(strings.Join(text, "\n"))
+
+	lines, halfPage := p.Frame(40, 12)
+	if len(lines) != 12 || halfPage != 3 {
+		t.Fatalf("Frame(40, 12) = %d lines, half page %d, want 12 lines, half page 3", len(lines), halfPage)
+	}
+	rule := strings.Repeat("─", 40)
+	for i, want := range map[int]string{0: "Triage ENG-1 (1/3)", 1: "failed", 2: rule, 3: "line 0", 9: "line 6", 10: rule, 11: "a: accept"} {
+		if got := ansi.Strip(lines[i]); got != want {
+			t.Errorf("line %d = %q, want %q", i, got, want)
+		}
+	}
+
+	// Scrolling stops at the end of the text.
+	p.Scroll(500)
+	lines, _ = p.Frame(40, 12)
+	if got := ansi.Strip(lines[9]); got != "line 99" {
+		t.Errorf("last text line after scrolling = %q, want %q", got, "line 99")
+	}
+
+	// Short texts are padded so the footer stays at the bottom.
+	p.SetText("short")
+	p.Status = ""
+	lines, _ = p.Frame(40, 12)
+	if len(lines) != 12 || ansi.Strip(lines[2]) != "short" || ansi.Strip(lines[11]) != "a: accept" {
+		t.Errorf("Frame with a short text = %q", lines)
+	}
+}
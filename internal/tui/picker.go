@@ -0,0 +1,49 @@
+package tui
+
+import (
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Pick shows l in a fuzzy finder on the terminal, below header, and
+// returns the picked items (see List.Picked). It returns nil when
+// cancelled with esc or ctrl-c.
+func Pick(l *List, header []string) ([]Item, error) {
+	t, err := Open()
+	if err != nil {
+		return nil, err
+	}
+	defer t.Close()
+
+	for {
+		width, height := t.Size()
+		if err := t.Draw(PickerFrame(l, header, width, height)); err != nil {
+			return nil, err
+		}
+		k, ok, err := t.ReadKey(time.Second)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		switch k.Name {
+		case "enter":
+			return l.Picked(), nil
+		case "esc", "ctrl-c", "ctrl-g", "ctrl-q":
+			return nil, nil
+		}
+		l.HandleKey(k)
+	}
+}
+
+// PickerFrame returns the lines of a picker showing l below header, like
+// fzf --header-first --layout=reverse.
+func PickerFrame(l *List, header []string, width, height int) []string {
+	var lines []string
+	for _, h := range header {
+		lines = append(lines, ansi.Truncate(h, width, "…"))
+	}
+	return append(lines, l.Render(width, max(height-len(lines), 3))...)
+}
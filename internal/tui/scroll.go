@@ -0,0 +1,46 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// scrollText is a text shown in a pane that scrolls, such as the browser's
+// preview.
+type scrollText struct {
+	text   string
+	offset int
+	// wrapped caches text wrapped at wrappedWidth.
+	wrapped      []string
+	wrappedWidth int
+}
+
+// set changes the text, scrolling back to its top when it differs from the
+// current one.
+func (s *scrollText) set(text string) {
+	if text == s.text {
+		return
+	}
+	s.text = text
+	s.offset = 0
+	s.wrapped = nil
+}
+
+// scroll scrolls by n lines, stopping at either end. The end is checked
+// when rendering, since it depends on the width.
+func (s *scrollText) scroll(n int) {
+	s.offset = max(s.offset+n, 0)
+}
+
+// lines returns the lines of the text shown in a width x height pane,
+// wrapping long lines.
+func (s *scrollText) lines(width, height int) []string {
+	if s.wrapped == nil || s.wrappedWidth != width {
+		s.wrapped = strings.Split(ansi.Hardwrap(strings.TrimRight(s.text, "\n"), width, true), "\n")
+		s.wrappedWidth = width
+	}
+	s.offset = min(s.offset, max(len(s.wrapped)-height, 0))
+	end := min(s.offset+height, len(s.wrapped))
+	return s.wrapped[s.offset:end]
+}
@@ -0,0 +1,138 @@
+package tui
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"golang.org/x/sys/unix"
+	"golang.org/x/term"
+)
+
+// Terminal is the controlling terminal, in raw mode on the alternate
+// screen while open. It reads and draws on /dev/tty directly, so it works
+// with stdin and stdout redirected.
+type Terminal struct {
+	tty   *os.File
+	r     *bufio.Reader
+	state *term.State
+	// frame is the last drawn frame, to skip redrawing an unchanged one.
+	frame string
+}
+
+// Open opens /dev/tty and switches it to raw mode on the alternate screen.
+// Close restores it.
+func Open() (*Terminal, error) {
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return nil, fmt.Errorf("opening terminal: %w", err)
+	}
+	t := &Terminal{tty: tty, r: bufio.NewReader(tty)}
+	if err := t.Resume(); err != nil {
+		tty.Close()
+		return nil, err
+	}
+	return t, nil
+}
+
+// Close restores the terminal and closes it.
+func (t *Terminal) Close() error {
+	err := t.Suspend()
+	if cerr := t.tty.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// Suspend restores the terminal as it was before Open, e.g. to run a
+// command that uses it. Resume switches back.
+func (t *Terminal) Suspend() error {
+	if t.state == nil {
+		return nil
+	}
+	_, _ = t.tty.WriteString("\033[?25h\033[?1049l")
+	err := term.Restore(int(t.tty.Fd()), t.state)
+	t.state = nil
+	return err
+}
+
+// Resume switches the terminal to raw mode on the alternate screen, with
+// the cursor hidden. The next Draw redraws the whole frame.
+func (t *Terminal) Resume() error {
+	state, err := term.MakeRaw(int(t.tty.Fd()))
+	if err != nil {
+		return fmt.Errorf("setting terminal to raw mode: %w", err)
+	}
+	t.state = state
+	t.frame = ""
+	_, err = t.tty.WriteString("\033[?1049h\033[?25l")
+	return err
+}
+
+// Run runs cmd with the terminal as its stdin, stdout and stderr,
+// suspending the UI until it exits.
+func (t *Terminal) Run(cmd *exec.Cmd) error {
+	if err := t.Suspend(); err != nil {
+		return err
+	}
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = t.tty, t.tty, t.tty
+	err := cmd.Run()
+	if rerr := t.Resume(); err == nil {
+		err = rerr
+	}
+	return err
+}
+
+// Size returns the terminal's width and height, defaulting to 80x24.
+func (t *Terminal) Size() (width, height int) {
+	width, height, err := term.GetSize(int(t.tty.Fd()))
+	if err != nil || width <= 0 || height <= 0 {
+		return 80, 24
+	}
+	return width, height
+}
+
+// ReadKey waits up to timeout for a key press. It returns false when
+// there was none, so callers can refresh the screen in between.
+func (t *Terminal) ReadKey(timeout time.Duration) (Key, bool, error) {
+	if t.r.Buffered() == 0 {
+		fds := []unix.PollFd{{Fd: int32(t.tty.Fd()), Events: unix.POLLIN}}
+		n, err := unix.Poll(fds, int(timeout.Milliseconds()))
+		if err == unix.EINTR || (err == nil && n == 0) {
+			return Key{}, false, nil
+		}
+		if err != nil {
+			return Key{}, false, fmt.Errorf("waiting for input: %w", err)
+		}
+	}
+	k, err := ReadKey(t.r)
+	if err != nil {
+		return Key{}, false, fmt.Errorf("reading input: %w", err)
+	}
+	return k, true, nil
+}
+
+// Draw shows lines from the top of the screen, clearing what's left of
+// the previous frame. An unchanged frame isn't redrawn.
+func (t *Terminal) Draw(lines []string) error {
+	var b strings.Builder
+	b.WriteString("\033[H")
+	for i, line := range lines {
+		if i > 0 {
+			b.WriteString("\r\n")
+		}
+		b.WriteString(line)
+		b.WriteString("\033[0m\033[K")
+	}
+	b.WriteString("\033[J")
+	frame := b.String()
+	if frame == t.frame {
+		return nil
+	}
+	t.frame = frame
+	_, err := t.tty.WriteString(frame)
+	return err
+}